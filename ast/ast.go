@@ -0,0 +1,369 @@
+// Package ast declares the types used to represent syntax trees of ASCIIMath
+// expressions.  The tree follows the grammar given in ASCIIMathML.js:
+//
+//	S ::= c | lEr | uS | bSS      simple expression
+//	I ::= S_S | S^S | S_S^S | S   intermediate expression
+//	E ::= IE | I/I                expression
+package ast
+
+import "github.com/arnodel/asciimath/scanner"
+
+// Node is implemented by all nodes of the syntax tree.
+type Node interface {
+	Pos() int // offset of the first byte belonging to the node
+	End() int // offset of the first byte following the node
+}
+
+// A Row is a sequence of expressions, e.g. the whole input or the contents of
+// a pair of brackets.
+type Row struct {
+	Items []Node
+}
+
+// A Constant is a single symbol: a number, a letter, an operator, a greek
+// letter...
+type Constant struct {
+	Token scanner.Token
+}
+
+// A Text is a literal piece of text, written "..." or text(...).
+type Text struct {
+	Token  scanner.Token // the quote, "text" or "mbox"
+	Value  string
+	EndPos int
+}
+
+// A Unary is a unary symbol applied to its argument, e.g. sqrt x.  Font
+// symbols such as bb give a Style instead.
+type Unary struct {
+	Op  scanner.Token
+	Arg Node
+}
+
+// A Binary is a binary symbol applied to its arguments, e.g. frac(a)(b).
+type Binary struct {
+	Op   scanner.Token
+	Arg1 Node
+	Arg2 Node
+}
+
+// A Style is an expression with presentation attributes, given by a font
+// symbol such as bb or cc, or by color, id or class, e.g. color(red)(x).
+// Attrs maps the names of MathML attributes (mathvariant, mathcolor, id or
+// class) to their values.
+type Style struct {
+	Op    scanner.Token
+	Attrs map[string]string
+	Arg   Node
+}
+
+// A Frac is a fraction written with the infix "/", e.g. a/b.
+type Frac struct {
+	Num   Node
+	Slash scanner.Token
+	Den   Node
+}
+
+// A FunctionApplication is a function applied to its argument, e.g. sin x,
+// f(x) or log_2 x.  Func is a Constant for the function symbol or a Script
+// whose base is that Constant.
+type FunctionApplication struct {
+	Func Node
+	Arg  Node
+}
+
+// Name returns the token of the function symbol.
+func (n *FunctionApplication) Name() scanner.Token {
+	fn := n.Func
+	if script, ok := fn.(*Script); ok {
+		fn = script.Base
+	}
+	if c, ok := fn.(*Constant); ok {
+		return c.Token
+	}
+	return scanner.Token{}
+}
+
+// A Script is a base with a subscript, a superscript or both.  Sub or Sup is
+// nil when absent.
+type Script struct {
+	Base   Node
+	Sub    Node
+	Sup    Node
+	Limits Limits
+}
+
+// A Fenced is a Row between brackets.  Open and Close can be any left and
+// right brackets, including invisible ones, or both "|".  Close is the zero
+// Token if the bracket was not closed in the input.
+type Fenced struct {
+	Open  scanner.Token
+	Body  *Row
+	Close scanner.Token
+}
+
+// Invisible returns true if the brackets are {: and :}, which are not
+// rendered.
+func (n *Fenced) Invisible() bool {
+	return n.Open.Symbol.Invisible() && (!n.Close.IsValid() || n.Close.Symbol.Invisible())
+}
+
+// Removable returns true if the brackets are not rendered when n is a script,
+// an operand of "/" or the argument of a unary or binary symbol, as in
+// AMremoveBrackets in ASCIIMathML.js.  This is the case for (, [ and { closed
+// by ), ] or }.
+func (n *Fenced) Removable() bool {
+	switch n.Open.Input() {
+	case "(", "[", "{":
+	default:
+		return false
+	}
+	switch n.Close.Input() {
+	case ")", "]", "}":
+		return true
+	}
+	return false
+}
+
+// A Matrix is a pair of brackets around comma separated rows, e.g.
+// [(a,b),(c,d)].  It is the same as a Fenced node whose body is made of
+// bracketed rows as ASCIIMathML does not distinguish matrices from other
+// brackets syntactically.
+type Matrix struct {
+	Open  scanner.Token
+	Rows  []*MatrixRow
+	Close scanner.Token
+}
+
+// A MatrixRow is a row of a Matrix, made of comma separated cells between
+// brackets, e.g. (a,b).
+type MatrixRow struct {
+	Open  scanner.Token
+	Cells []*Row
+	Close scanner.Token
+}
+
+// Columns returns the number of cells in each row of n.
+func (n *Matrix) Columns() int {
+	if len(n.Rows) == 0 {
+		return 0
+	}
+	return len(n.Rows[0].Cells)
+}
+
+// An EquationArray is a block of expressions, one per line, aligned at a
+// common point, e.g. the steps of a derivation aligned at their "=".
+type EquationArray struct {
+	Lines []*EquationLine
+}
+
+// An EquationLine is a line of an EquationArray, split at its alignment
+// point.  Right starts with the relation symbol at the alignment point, or
+// follows Marker if the line has an explicit "&" marker.  Marker is the zero
+// Token otherwise.  Right is empty if the line has no alignment point.
+type EquationLine struct {
+	Left   *Row
+	Marker scanner.Token
+	Right  *Row
+}
+
+// An Error is a token that the parser did not expect, e.g. an unmatched
+// right bracket.
+type Error struct {
+	Token scanner.Token
+}
+
+// A Placeholder stands for a missing argument, e.g. the denominator in
+// frac(a).
+type Placeholder struct {
+	At int
+}
+
+// Limits says where the scripts of a Script node go.
+type Limits int
+
+const (
+	// NoLimits is for ordinary subscripts and superscripts.
+	NoLimits Limits = iota
+
+	// MovableLimits is for UNDEROVER symbols such as sum or lim, whose
+	// scripts go under and over the base in display style and beside it
+	// otherwise.
+	MovableLimits
+
+	// FixedLimits is for UNARYUNDEROVER symbols such as ubrace, whose
+	// scripts always go under and over the base.
+	FixedLimits
+)
+
+// LimitsFor returns the Limits of scripts attached to an expression starting
+// with tok.
+func LimitsFor(tok scanner.Token) Limits {
+	switch tok.Type() {
+	case scanner.UNDEROVER:
+		return MovableLimits
+	case scanner.UNARYUNDEROVER:
+		return FixedLimits
+	default:
+		return NoLimits
+	}
+}
+
+// StyleAttr returns the name of the attribute set by the symbol tok of a
+// Style: the attribute of a font symbol, mathcolor for color, id or class.
+// It returns "" if tok cannot start a Style.
+func StyleAttr(tok scanner.Token) string {
+	if !tok.IsValid() {
+		return ""
+	}
+	if name := tok.Symbol.AttrName(); name != "" {
+		return name
+	}
+	if tok.Type() != scanner.BINARY {
+		return ""
+	}
+	switch tok.Input() {
+	case "color":
+		return "mathcolor"
+	case "id", "class":
+		return tok.Input()
+	}
+	return ""
+}
+
+// IsRelation returns true if tok is = < > or one of the binary relation
+// symbols of AMsymbols, e.g. <= or in.
+func IsRelation(tok scanner.Token) bool {
+	return tok.IsValid() && relations[tok.Symbol.Output()]
+}
+
+// relations are the outputs of the symbols for which IsRelation is true.
+var relations = map[string]bool{
+	"=": true, "<": true, ">": true,
+	"≠": true, ":=": true, "≤": true, "≥": true, "≺": true, "≻": true,
+	"⪯": true, "⪰": true, "∈": true, "∉": true, "⊂": true, "⊃": true,
+	"⊆": true, "⊇": true, "≡": true, "≅": true, "≈": true, "∝": true,
+}
+
+// Value returns the value of the attribute set by the symbol of n, e.g. red
+// for color(red)(x) or bold for bb x.
+func (n *Style) Value() string {
+	return n.Attrs[StyleAttr(n.Op)]
+}
+
+// UnderOver returns true if the scripts should be placed under and over the
+// base rather than beside it.  The display argument says whether the
+// expression is rendered in display style.
+func (n *Script) UnderOver(display bool) bool {
+	switch n.Limits {
+	case MovableLimits:
+		return display
+	case FixedLimits:
+		return true
+	default:
+		return false
+	}
+}
+
+func (n *Row) Pos() int {
+	if len(n.Items) == 0 {
+		return 0
+	}
+	return n.Items[0].Pos()
+}
+
+func (n *Row) End() int {
+	if len(n.Items) == 0 {
+		return 0
+	}
+	return n.Items[len(n.Items)-1].End()
+}
+
+func (n *Constant) Pos() int { return n.Token.Pos }
+func (n *Constant) End() int { return n.Token.End }
+
+func (n *Text) Pos() int { return n.Token.Pos }
+func (n *Text) End() int { return n.EndPos }
+
+func (n *Error) Pos() int { return n.Token.Pos }
+func (n *Error) End() int { return n.Token.End }
+
+func (n *Placeholder) Pos() int { return n.At }
+func (n *Placeholder) End() int { return n.At }
+
+func (n *Unary) Pos() int { return n.Op.Pos }
+func (n *Unary) End() int { return n.Arg.End() }
+
+func (n *Binary) Pos() int { return n.Op.Pos }
+func (n *Binary) End() int { return n.Arg2.End() }
+
+func (n *Style) Pos() int { return n.Op.Pos }
+func (n *Style) End() int { return n.Arg.End() }
+
+func (n *Frac) Pos() int { return n.Num.Pos() }
+func (n *Frac) End() int { return n.Den.End() }
+
+func (n *FunctionApplication) Pos() int { return n.Func.Pos() }
+func (n *FunctionApplication) End() int { return n.Arg.End() }
+
+func (n *Script) Pos() int { return n.Base.Pos() }
+func (n *Script) End() int {
+	if n.Sup != nil {
+		return n.Sup.End()
+	}
+	if n.Sub != nil {
+		return n.Sub.End()
+	}
+	return n.Base.End()
+}
+
+func (n *Fenced) Pos() int { return n.Open.Pos }
+func (n *Fenced) End() int {
+	if n.Close.IsValid() {
+		return n.Close.End
+	}
+	if len(n.Body.Items) > 0 {
+		return n.Body.End()
+	}
+	return n.Open.End
+}
+
+func (n *Matrix) Pos() int { return n.Open.Pos }
+func (n *Matrix) End() int { return n.Close.End }
+
+func (n *MatrixRow) Pos() int { return n.Open.Pos }
+func (n *MatrixRow) End() int { return n.Close.End }
+
+func (n *EquationArray) Pos() int {
+	if len(n.Lines) == 0 {
+		return 0
+	}
+	return n.Lines[0].Pos()
+}
+
+func (n *EquationArray) End() int {
+	if len(n.Lines) == 0 {
+		return 0
+	}
+	return n.Lines[len(n.Lines)-1].End()
+}
+
+func (n *EquationLine) Pos() int {
+	switch {
+	case len(n.Left.Items) > 0:
+		return n.Left.Pos()
+	case n.Marker.IsValid():
+		return n.Marker.Pos
+	}
+	return n.Right.Pos()
+}
+
+func (n *EquationLine) End() int {
+	switch {
+	case len(n.Right.Items) > 0:
+		return n.Right.End()
+	case n.Marker.IsValid():
+		return n.Marker.End
+	}
+	return n.Left.End()
+}
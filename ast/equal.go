@@ -0,0 +1,364 @@
+package ast
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"sort"
+
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// EqualOptions control how syntax trees are compared by Equal and Hash.
+//
+// Trees are always compared structurally: spans are ignored, so whitespace
+// in the input does not matter, and symbols are compared by meaning rather
+// than spelling, so that e.g. <= and lt= are equal.  Texts are compared by
+// value, so "a" and text(a) are equal.
+type EqualOptions struct {
+	// IgnoreGrouping makes grouping that is not rendered irrelevant: {: :}
+	// brackets, rows of a single item, and brackets that ASCIIMathML removes
+	// around scripts, operands of "/" and arguments of unary and binary
+	// symbols.  So x^(2) and {:x:}^2 are equal to x^2, but (x) is not equal
+	// to x.
+	IgnoreGrouping bool
+}
+
+// Equal returns true if a and b are the same expression, ignoring whitespace
+// and symbol spelling.
+func Equal(a, b Node) bool {
+	return EqualOptions{}.Equal(a, b)
+}
+
+// Hash returns a hash of node such that Equal(a, b) implies Hash(a) ==
+// Hash(b).
+func Hash(node Node) uint64 {
+	return EqualOptions{}.Hash(node)
+}
+
+// Equal returns true if a and b are the same expression according to o.
+func (o EqualOptions) Equal(a, b Node) bool {
+	return o.equal(a, b, false)
+}
+
+// Hash returns a hash of node such that o.Equal(a, b) implies o.Hash(a) ==
+// o.Hash(b).
+func (o EqualOptions) Hash(node Node) uint64 {
+	h := fnv.New64a()
+	o.hash(h, node, false)
+	return h.Sum64()
+}
+
+// simplify removes the grouping of node that o ignores.  Removable brackets
+// are only removed once, and only if operand is true.
+func (o EqualOptions) simplify(node Node, operand bool) Node {
+	if !o.IgnoreGrouping {
+		return node
+	}
+	for {
+		switch n := node.(type) {
+		case *Row:
+			items := o.flatten(nil, n.Items)
+			if len(items) != 1 {
+				return &Row{Items: items}
+			}
+			node = items[0]
+		case *Fenced:
+			switch {
+			case n.Invisible():
+			case operand && n.Removable():
+				operand = false
+			default:
+				return node
+			}
+			node = n.Body
+		default:
+			return node
+		}
+	}
+}
+
+// flatten appends items to dest, replacing rows and invisible brackets with
+// their items.
+func (o EqualOptions) flatten(dest, items []Node) []Node {
+	for _, item := range items {
+		switch n := item.(type) {
+		case *Row:
+			dest = o.flatten(dest, n.Items)
+		case *Fenced:
+			if n.Invisible() {
+				dest = o.flatten(dest, n.Body.Items)
+			} else {
+				dest = append(dest, item)
+			}
+		default:
+			dest = append(dest, item)
+		}
+	}
+	return dest
+}
+
+func (o EqualOptions) equal(a, b Node, operand bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	a, b = o.simplify(a, operand), o.simplify(b, operand)
+	switch m := a.(type) {
+	case *Row:
+		n, ok := b.(*Row)
+		if !ok || len(m.Items) != len(n.Items) {
+			return false
+		}
+		for i := range m.Items {
+			if !o.equal(m.Items[i], n.Items[i], false) {
+				return false
+			}
+		}
+		return true
+	case *Constant:
+		n, ok := b.(*Constant)
+		return ok && sameSymbol(m.Token, n.Token)
+	case *Text:
+		n, ok := b.(*Text)
+		return ok && m.Value == n.Value
+	case *Error:
+		n, ok := b.(*Error)
+		return ok && sameSymbol(m.Token, n.Token)
+	case *Placeholder:
+		_, ok := b.(*Placeholder)
+		return ok
+	case *Unary:
+		n, ok := b.(*Unary)
+		return ok && sameSymbol(m.Op, n.Op) && o.equal(m.Arg, n.Arg, true)
+	case *Style:
+		n, ok := b.(*Style)
+		return ok && sameSymbol(m.Op, n.Op) && sameAttrs(m.Attrs, n.Attrs) && o.equal(m.Arg, n.Arg, true)
+	case *Binary:
+		n, ok := b.(*Binary)
+		return ok && sameSymbol(m.Op, n.Op) &&
+			o.equal(m.Arg1, n.Arg1, true) && o.equal(m.Arg2, n.Arg2, true)
+	case *Frac:
+		n, ok := b.(*Frac)
+		return ok && o.equal(m.Num, n.Num, true) && o.equal(m.Den, n.Den, true)
+	case *FunctionApplication:
+		n, ok := b.(*FunctionApplication)
+		return ok && o.equal(m.Func, n.Func, false) && o.equal(m.Arg, n.Arg, false)
+	case *Script:
+		n, ok := b.(*Script)
+		return ok && m.Limits == n.Limits && o.equal(m.Base, n.Base, false) &&
+			o.equal(m.Sub, n.Sub, true) && o.equal(m.Sup, n.Sup, true)
+	case *Fenced:
+		n, ok := b.(*Fenced)
+		return ok && sameSymbol(m.Open, n.Open) && sameSymbol(m.Close, n.Close) &&
+			o.equal(m.Body, n.Body, false)
+	case *Matrix:
+		n, ok := b.(*Matrix)
+		if !ok || !sameSymbol(m.Open, n.Open) || !sameSymbol(m.Close, n.Close) || len(m.Rows) != len(n.Rows) {
+			return false
+		}
+		for i := range m.Rows {
+			if !o.equal(m.Rows[i], n.Rows[i], false) {
+				return false
+			}
+		}
+		return true
+	case *MatrixRow:
+		n, ok := b.(*MatrixRow)
+		if !ok || !sameSymbol(m.Open, n.Open) || !sameSymbol(m.Close, n.Close) || len(m.Cells) != len(n.Cells) {
+			return false
+		}
+		for i := range m.Cells {
+			if !o.equal(m.Cells[i], n.Cells[i], false) {
+				return false
+			}
+		}
+		return true
+	case *EquationArray:
+		n, ok := b.(*EquationArray)
+		if !ok || len(m.Lines) != len(n.Lines) {
+			return false
+		}
+		for i := range m.Lines {
+			if !o.equal(m.Lines[i], n.Lines[i], false) {
+				return false
+			}
+		}
+		return true
+	case *EquationLine:
+		n, ok := b.(*EquationLine)
+		return ok && sameSymbol(m.Marker, n.Marker) &&
+			o.equal(m.Left, n.Left, false) && o.equal(m.Right, n.Right, false)
+	}
+	return false
+}
+
+// Node kinds written by hash before the contents of each node.
+const (
+	hashNil byte = iota
+	hashRow
+	hashConstant
+	hashText
+	hashError
+	hashPlaceholder
+	hashUnary
+	hashBinary
+	hashFrac
+	hashFunctionApplication
+	hashScript
+	hashFenced
+	hashMatrix
+	hashMatrixRow
+	hashStyle
+	hashEquationArray
+	hashEquationLine
+)
+
+func (o EqualOptions) hash(h hash.Hash64, node Node, operand bool) {
+	if node == nil {
+		h.Write([]byte{hashNil})
+		return
+	}
+	switch n := o.simplify(node, operand).(type) {
+	case *Row:
+		h.Write([]byte{hashRow})
+		hashInt(h, len(n.Items))
+		for _, item := range n.Items {
+			o.hash(h, item, false)
+		}
+	case *Constant:
+		h.Write([]byte{hashConstant})
+		hashSymbol(h, n.Token)
+	case *Text:
+		h.Write([]byte{hashText})
+		hashString(h, n.Value)
+	case *Error:
+		h.Write([]byte{hashError})
+		hashSymbol(h, n.Token)
+	case *Placeholder:
+		h.Write([]byte{hashPlaceholder})
+	case *Unary:
+		h.Write([]byte{hashUnary})
+		hashSymbol(h, n.Op)
+		o.hash(h, n.Arg, true)
+	case *Style:
+		h.Write([]byte{hashStyle})
+		hashSymbol(h, n.Op)
+		names := make([]string, 0, len(n.Attrs))
+		for name := range n.Attrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		hashInt(h, len(names))
+		for _, name := range names {
+			hashString(h, name)
+			hashString(h, n.Attrs[name])
+		}
+		o.hash(h, n.Arg, true)
+	case *Binary:
+		h.Write([]byte{hashBinary})
+		hashSymbol(h, n.Op)
+		o.hash(h, n.Arg1, true)
+		o.hash(h, n.Arg2, true)
+	case *Frac:
+		h.Write([]byte{hashFrac})
+		o.hash(h, n.Num, true)
+		o.hash(h, n.Den, true)
+	case *FunctionApplication:
+		h.Write([]byte{hashFunctionApplication})
+		o.hash(h, n.Func, false)
+		o.hash(h, n.Arg, false)
+	case *Script:
+		h.Write([]byte{hashScript, byte(n.Limits)})
+		o.hash(h, n.Base, false)
+		o.hash(h, n.Sub, true)
+		o.hash(h, n.Sup, true)
+	case *Fenced:
+		h.Write([]byte{hashFenced})
+		hashSymbol(h, n.Open)
+		hashSymbol(h, n.Close)
+		o.hash(h, n.Body, false)
+	case *Matrix:
+		h.Write([]byte{hashMatrix})
+		hashSymbol(h, n.Open)
+		hashSymbol(h, n.Close)
+		hashInt(h, len(n.Rows))
+		for _, row := range n.Rows {
+			o.hash(h, row, false)
+		}
+	case *MatrixRow:
+		h.Write([]byte{hashMatrixRow})
+		hashSymbol(h, n.Open)
+		hashSymbol(h, n.Close)
+		hashInt(h, len(n.Cells))
+		for _, cell := range n.Cells {
+			o.hash(h, cell, false)
+		}
+	case *EquationArray:
+		h.Write([]byte{hashEquationArray})
+		hashInt(h, len(n.Lines))
+		for _, line := range n.Lines {
+			o.hash(h, line, false)
+		}
+	case *EquationLine:
+		h.Write([]byte{hashEquationLine})
+		hashSymbol(h, n.Marker)
+		o.hash(h, n.Left, false)
+		o.hash(h, n.Right, false)
+	}
+}
+
+// A symbolKey identifies the meaning of a symbol.  Symbols with the same key
+// are synonyms, e.g. <= and lt=, or bb and mathbf.
+type symbolKey struct {
+	tag, output   string
+	atname, atval string
+	ttype         int
+}
+
+func keyOf(tok scanner.Token) symbolKey {
+	sym := tok.Symbol
+	if sym == nil {
+		return symbolKey{ttype: -1}
+	}
+	key := symbolKey{tag: sym.Tag(), output: sym.Output(), ttype: sym.Type()}
+	if sym.AttrName() != "" {
+		// The output of style symbols is their input.
+		key.output, key.atname, key.atval = "", sym.AttrName(), sym.AttrValue()
+	}
+	return key
+}
+
+func sameSymbol(a, b scanner.Token) bool {
+	return keyOf(a) == keyOf(b)
+}
+
+func sameAttrs(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if v, ok := b[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func hashSymbol(h hash.Hash64, tok scanner.Token) {
+	key := keyOf(tok)
+	hashInt(h, key.ttype)
+	hashString(h, key.tag)
+	hashString(h, key.output)
+	hashString(h, key.atname)
+	hashString(h, key.atval)
+}
+
+func hashString(h hash.Hash64, s string) {
+	hashInt(h, len(s))
+	h.Write([]byte(s))
+}
+
+func hashInt(h hash.Hash64, n int) {
+	var buf [binary.MaxVarintLen64]byte
+	h.Write(buf[:binary.PutVarint(buf[:], int64(n))])
+}
@@ -0,0 +1,59 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/ast"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b           string
+		want           bool
+		ignoreGrouping bool
+	}{
+		{a: "x^2+1", b: "x ^ 2 + 1", want: true},
+		{a: "a <= b", b: "a lt= b", want: true},
+		{a: "lambda -> oo", b: "lamda rarr oo", want: true},
+		{a: "bb x", b: "mathbf x", want: true},
+		{a: `"hello"`, b: "text(hello)", want: true},
+		{a: "int f dx", b: "int f {:d x:}", want: true},
+		{a: "x^2+1", b: "x^2+2"},
+		{a: "x^2", b: "x_2"},
+		{a: "a < b", b: "a <= b"},
+		{a: "bb x", b: "cc x"},
+		{a: "color(red)x", b: "color[red]x", want: true},
+		{a: "color(red)x", b: "color(blue)x"},
+		{a: "color(red)x", b: "class(red)x"},
+		{a: "color(red)(x)", b: "color(red)x", want: true, ignoreGrouping: true},
+		{a: "(x)", b: "[x]"},
+		{a: "x^(2)", b: "x^2"},
+		{a: "x^(2)", b: "x^2", want: true, ignoreGrouping: true},
+		{a: "{:x:}^2", b: "x^2", want: true, ignoreGrouping: true},
+		{a: "(a+b)/c", b: "{:a+b:}/c", want: true, ignoreGrouping: true},
+		{a: "sqrt(x+1)", b: "sqrt{:x+1:}", want: true, ignoreGrouping: true},
+		{a: "a+{:b+c:}", b: "a+b+c", want: true, ignoreGrouping: true},
+		{a: "(x)", b: "x", ignoreGrouping: true},
+		{a: "x^((2))", b: "x^2", ignoreGrouping: true},
+		{a: "sqrt x+1", b: "sqrt{:x+1:}", ignoreGrouping: true},
+		{a: "sin(x)", b: "sin x", ignoreGrouping: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			o := ast.EqualOptions{IgnoreGrouping: tt.ignoreGrouping}
+			a, b := mustParse(t, tt.a), mustParse(t, tt.b)
+			if got := o.Equal(a, b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := o.Equal(b, a); got != tt.want {
+				t.Errorf("Equal() = %v, want %v (swapped)", got, tt.want)
+			}
+			if got := o.Hash(a) == o.Hash(b); got != tt.want {
+				t.Errorf("Hash() equal = %v, want %v", got, tt.want)
+			}
+			if !tt.ignoreGrouping && ast.Equal(a, b) != tt.want {
+				t.Errorf("ast.Equal() = %v, want %v", !tt.want, tt.want)
+			}
+		})
+	}
+}
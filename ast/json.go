@@ -0,0 +1,373 @@
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// The JSON representation of syntax trees is described by the JSON Schema in
+// ast.schema.json.  Each node is an object with a "type" field naming its Go
+// type and "pos" and "end" fields giving its span in the input.  Tokens are
+// objects giving the input, tag, output and ttype of their symbol along with
+// their span.
+
+// MarshalJSON returns the JSON representation of a syntax tree.
+func MarshalJSON(node Node) ([]byte, error) {
+	return json.Marshal(toJSON(node))
+}
+
+// UnmarshalJSON parses the JSON representation of a syntax tree.
+func UnmarshalJSON(data []byte) (Node, error) {
+	var j jsonNode
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	return fromJSON(&j)
+}
+
+type jsonNode struct {
+	Type   string            `json:"type"`
+	Pos    int               `json:"pos"`
+	End    int               `json:"end"`
+	Token  *jsonToken        `json:"token,omitempty"`
+	Value  *string           `json:"value,omitempty"`
+	Op     *jsonToken        `json:"op,omitempty"`
+	Attrs  map[string]string `json:"attrs,omitempty"`
+	Items  []*jsonNode       `json:"items,omitempty"`
+	Arg    *jsonNode         `json:"arg,omitempty"`
+	Arg1   *jsonNode         `json:"arg1,omitempty"`
+	Arg2   *jsonNode         `json:"arg2,omitempty"`
+	Num    *jsonNode         `json:"num,omitempty"`
+	Slash  *jsonToken        `json:"slash,omitempty"`
+	Den    *jsonNode         `json:"den,omitempty"`
+	Func   *jsonNode         `json:"func,omitempty"`
+	Base   *jsonNode         `json:"base,omitempty"`
+	Sub    *jsonNode         `json:"sub,omitempty"`
+	Sup    *jsonNode         `json:"sup,omitempty"`
+	Limits string            `json:"limits,omitempty"`
+	Open   *jsonToken        `json:"open,omitempty"`
+	Body   *jsonNode         `json:"body,omitempty"`
+	Close  *jsonToken        `json:"close,omitempty"`
+	Rows   []*jsonNode       `json:"rows,omitempty"`
+	Cells  []*jsonNode       `json:"cells,omitempty"`
+	Lines  []*jsonNode       `json:"lines,omitempty"`
+	Left   *jsonNode         `json:"left,omitempty"`
+	Marker *jsonToken        `json:"marker,omitempty"`
+	Right  *jsonNode         `json:"right,omitempty"`
+}
+
+type jsonToken struct {
+	Input  string `json:"input"`
+	Tag    string `json:"tag"`
+	Output string `json:"output"`
+	Type   string `json:"ttype"`
+	Pos    int    `json:"pos"`
+	End    int    `json:"end"`
+}
+
+var limitsNames = []string{
+	NoLimits:      "none",
+	MovableLimits: "movable",
+	FixedLimits:   "fixed",
+}
+
+func toJSONToken(tok scanner.Token) *jsonToken {
+	if !tok.IsValid() {
+		return nil
+	}
+	return &jsonToken{
+		Input:  tok.Symbol.Input(),
+		Tag:    tok.Symbol.Tag(),
+		Output: tok.Symbol.Output(),
+		Type:   scanner.TypeName(tok.Symbol.Type()),
+		Pos:    tok.Pos,
+		End:    tok.End,
+	}
+}
+
+func fromJSONToken(j *jsonToken) (scanner.Token, error) {
+	if j == nil {
+		return scanner.Token{}, nil
+	}
+	if j.Input == "" {
+		return scanner.Token{}, fmt.Errorf("token at %d has no input", j.Pos)
+	}
+	return scanner.Token{Symbol: scanner.Lookup(j.Input), Pos: j.Pos, End: j.End}, nil
+}
+
+func toJSON(node Node) *jsonNode {
+	if node == nil {
+		return nil
+	}
+	j := &jsonNode{Pos: node.Pos(), End: node.End()}
+	switch n := node.(type) {
+	case *Row:
+		j.Type = "Row"
+		j.Items = make([]*jsonNode, len(n.Items))
+		for i, item := range n.Items {
+			j.Items[i] = toJSON(item)
+		}
+	case *Constant:
+		j.Type = "Constant"
+		j.Token = toJSONToken(n.Token)
+	case *Text:
+		j.Type = "Text"
+		j.Token = toJSONToken(n.Token)
+		j.Value = &n.Value
+	case *Error:
+		j.Type = "Error"
+		j.Token = toJSONToken(n.Token)
+	case *Placeholder:
+		j.Type = "Placeholder"
+	case *Unary:
+		j.Type = "Unary"
+		j.Op = toJSONToken(n.Op)
+		j.Arg = toJSON(n.Arg)
+	case *Style:
+		j.Type = "Style"
+		j.Op = toJSONToken(n.Op)
+		j.Attrs = n.Attrs
+		j.Arg = toJSON(n.Arg)
+	case *Binary:
+		j.Type = "Binary"
+		j.Op = toJSONToken(n.Op)
+		j.Arg1 = toJSON(n.Arg1)
+		j.Arg2 = toJSON(n.Arg2)
+	case *Frac:
+		j.Type = "Frac"
+		j.Num = toJSON(n.Num)
+		j.Slash = toJSONToken(n.Slash)
+		j.Den = toJSON(n.Den)
+	case *FunctionApplication:
+		j.Type = "FunctionApplication"
+		j.Func = toJSON(n.Func)
+		j.Arg = toJSON(n.Arg)
+	case *Script:
+		j.Type = "Script"
+		j.Base = toJSON(n.Base)
+		j.Sub = toJSON(n.Sub)
+		j.Sup = toJSON(n.Sup)
+		j.Limits = limitsNames[n.Limits]
+	case *Fenced:
+		j.Type = "Fenced"
+		j.Open = toJSONToken(n.Open)
+		j.Body = toJSON(n.Body)
+		j.Close = toJSONToken(n.Close)
+	case *Matrix:
+		j.Type = "Matrix"
+		j.Open = toJSONToken(n.Open)
+		j.Rows = make([]*jsonNode, len(n.Rows))
+		for i, row := range n.Rows {
+			j.Rows[i] = toJSON(row)
+		}
+		j.Close = toJSONToken(n.Close)
+	case *MatrixRow:
+		j.Type = "MatrixRow"
+		j.Open = toJSONToken(n.Open)
+		j.Cells = make([]*jsonNode, len(n.Cells))
+		for i, cell := range n.Cells {
+			j.Cells[i] = toJSON(cell)
+		}
+		j.Close = toJSONToken(n.Close)
+	case *EquationArray:
+		j.Type = "EquationArray"
+		j.Lines = make([]*jsonNode, len(n.Lines))
+		for i, line := range n.Lines {
+			j.Lines[i] = toJSON(line)
+		}
+	case *EquationLine:
+		j.Type = "EquationLine"
+		j.Left = toJSON(n.Left)
+		j.Marker = toJSONToken(n.Marker)
+		j.Right = toJSON(n.Right)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+	return j
+}
+
+func fromJSON(j *jsonNode) (Node, error) {
+	var err error
+	// All the helpers below record the first error in err and return a
+	// dummy value after that.
+	node := func(j *jsonNode, field string) Node {
+		if err != nil {
+			return nil
+		}
+		if j == nil {
+			err = fmt.Errorf("missing %s", field)
+			return nil
+		}
+		var n Node
+		n, err = fromJSON(j)
+		return n
+	}
+	optNode := func(j *jsonNode, field string) Node {
+		if j == nil {
+			return nil
+		}
+		return node(j, field)
+	}
+	token := func(j *jsonToken, field string) scanner.Token {
+		if err != nil {
+			return scanner.Token{}
+		}
+		if j == nil {
+			err = fmt.Errorf("missing %s", field)
+			return scanner.Token{}
+		}
+		var tok scanner.Token
+		tok, err = fromJSONToken(j)
+		return tok
+	}
+	var n Node
+	switch j.Type {
+	case "Row":
+		row := &Row{}
+		for _, item := range j.Items {
+			row.Items = append(row.Items, node(item, "item"))
+		}
+		n = row
+	case "Constant":
+		n = &Constant{Token: token(j.Token, "token")}
+	case "Text":
+		text := &Text{Token: token(j.Token, "token"), EndPos: j.End}
+		if j.Value != nil {
+			text.Value = *j.Value
+		}
+		n = text
+	case "Error":
+		n = &Error{Token: token(j.Token, "token")}
+	case "Placeholder":
+		n = &Placeholder{At: j.Pos}
+	case "Unary":
+		n = &Unary{Op: token(j.Op, "op"), Arg: node(j.Arg, "arg")}
+	case "Style":
+		n = &Style{Op: token(j.Op, "op"), Attrs: j.Attrs, Arg: node(j.Arg, "arg")}
+	case "Binary":
+		n = &Binary{Op: token(j.Op, "op"), Arg1: node(j.Arg1, "arg1"), Arg2: node(j.Arg2, "arg2")}
+	case "Frac":
+		n = &Frac{Num: node(j.Num, "num"), Slash: token(j.Slash, "slash"), Den: node(j.Den, "den")}
+	case "FunctionApplication":
+		n = &FunctionApplication{Func: node(j.Func, "func"), Arg: node(j.Arg, "arg")}
+	case "Script":
+		script := &Script{Base: node(j.Base, "base"), Sub: optNode(j.Sub, "sub"), Sup: optNode(j.Sup, "sup")}
+		for limits, name := range limitsNames {
+			if name == j.Limits {
+				script.Limits = Limits(limits)
+			}
+		}
+		n = script
+	case "Fenced":
+		fenced := &Fenced{Open: token(j.Open, "open")}
+		if err == nil {
+			fenced.Close, err = fromJSONToken(j.Close)
+		}
+		body, ok := node(j.Body, "body").(*Row)
+		if !ok && err == nil {
+			err = fmt.Errorf("body of Fenced at %d is not a Row", j.Pos)
+		}
+		fenced.Body = body
+		n = fenced
+	case "Matrix":
+		matrix := &Matrix{Open: token(j.Open, "open"), Close: token(j.Close, "close")}
+		for _, row := range j.Rows {
+			row, ok := node(row, "row").(*MatrixRow)
+			if !ok && err == nil {
+				err = fmt.Errorf("row of Matrix at %d is not a MatrixRow", j.Pos)
+			}
+			matrix.Rows = append(matrix.Rows, row)
+		}
+		n = matrix
+	case "MatrixRow":
+		row := &MatrixRow{Open: token(j.Open, "open"), Close: token(j.Close, "close")}
+		for _, cell := range j.Cells {
+			cell, ok := node(cell, "cell").(*Row)
+			if !ok && err == nil {
+				err = fmt.Errorf("cell of MatrixRow at %d is not a Row", j.Pos)
+			}
+			row.Cells = append(row.Cells, cell)
+		}
+		n = row
+	case "EquationArray":
+		array := &EquationArray{}
+		for _, line := range j.Lines {
+			line, ok := node(line, "line").(*EquationLine)
+			if !ok && err == nil {
+				err = fmt.Errorf("line of EquationArray at %d is not an EquationLine", j.Pos)
+			}
+			array.Lines = append(array.Lines, line)
+		}
+		n = array
+	case "EquationLine":
+		line := &EquationLine{}
+		if err == nil {
+			line.Marker, err = fromJSONToken(j.Marker)
+		}
+		left, ok := node(j.Left, "left").(*Row)
+		if !ok && err == nil {
+			err = fmt.Errorf("left of EquationLine at %d is not a Row", j.Pos)
+		}
+		right, ok := node(j.Right, "right").(*Row)
+		if !ok && err == nil {
+			err = fmt.Errorf("right of EquationLine at %d is not a Row", j.Pos)
+		}
+		line.Left, line.Right = left, right
+		n = line
+	default:
+		return nil, fmt.Errorf("unknown node type %q", j.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s at %d: %s", j.Type, j.Pos, err)
+	}
+	return n, nil
+}
+
+func unmarshalInto(data []byte, dest Node) error {
+	node, err := UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	if reflect.TypeOf(node) != reflect.TypeOf(dest) {
+		return fmt.Errorf("cannot unmarshal %T into %T", node, dest)
+	}
+	reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(node).Elem())
+	return nil
+}
+
+func (n *Row) MarshalJSON() ([]byte, error)                 { return MarshalJSON(n) }
+func (n *Constant) MarshalJSON() ([]byte, error)            { return MarshalJSON(n) }
+func (n *Text) MarshalJSON() ([]byte, error)                { return MarshalJSON(n) }
+func (n *Error) MarshalJSON() ([]byte, error)               { return MarshalJSON(n) }
+func (n *Placeholder) MarshalJSON() ([]byte, error)         { return MarshalJSON(n) }
+func (n *Unary) MarshalJSON() ([]byte, error)               { return MarshalJSON(n) }
+func (n *Style) MarshalJSON() ([]byte, error)               { return MarshalJSON(n) }
+func (n *Binary) MarshalJSON() ([]byte, error)              { return MarshalJSON(n) }
+func (n *Frac) MarshalJSON() ([]byte, error)                { return MarshalJSON(n) }
+func (n *Script) MarshalJSON() ([]byte, error)              { return MarshalJSON(n) }
+func (n *FunctionApplication) MarshalJSON() ([]byte, error) { return MarshalJSON(n) }
+func (n *Fenced) MarshalJSON() ([]byte, error)              { return MarshalJSON(n) }
+func (n *Matrix) MarshalJSON() ([]byte, error)              { return MarshalJSON(n) }
+func (n *MatrixRow) MarshalJSON() ([]byte, error)           { return MarshalJSON(n) }
+func (n *EquationArray) MarshalJSON() ([]byte, error)       { return MarshalJSON(n) }
+func (n *EquationLine) MarshalJSON() ([]byte, error)        { return MarshalJSON(n) }
+
+func (n *Row) UnmarshalJSON(data []byte) error                 { return unmarshalInto(data, n) }
+func (n *Constant) UnmarshalJSON(data []byte) error            { return unmarshalInto(data, n) }
+func (n *Text) UnmarshalJSON(data []byte) error                { return unmarshalInto(data, n) }
+func (n *Error) UnmarshalJSON(data []byte) error               { return unmarshalInto(data, n) }
+func (n *Placeholder) UnmarshalJSON(data []byte) error         { return unmarshalInto(data, n) }
+func (n *Unary) UnmarshalJSON(data []byte) error               { return unmarshalInto(data, n) }
+func (n *Style) UnmarshalJSON(data []byte) error               { return unmarshalInto(data, n) }
+func (n *Binary) UnmarshalJSON(data []byte) error              { return unmarshalInto(data, n) }
+func (n *Frac) UnmarshalJSON(data []byte) error                { return unmarshalInto(data, n) }
+func (n *Script) UnmarshalJSON(data []byte) error              { return unmarshalInto(data, n) }
+func (n *FunctionApplication) UnmarshalJSON(data []byte) error { return unmarshalInto(data, n) }
+func (n *Fenced) UnmarshalJSON(data []byte) error              { return unmarshalInto(data, n) }
+func (n *Matrix) UnmarshalJSON(data []byte) error              { return unmarshalInto(data, n) }
+func (n *MatrixRow) UnmarshalJSON(data []byte) error           { return unmarshalInto(data, n) }
+func (n *EquationArray) UnmarshalJSON(data []byte) error       { return unmarshalInto(data, n) }
+func (n *EquationLine) UnmarshalJSON(data []byte) error        { return unmarshalInto(data, n) }
@@ -0,0 +1,182 @@
+package ast_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+)
+
+var jsonTests = []string{
+	"",
+	"x^2+1",
+	"sum_(i=1)^n i^2",
+	"frac(a)(b) + a/b",
+	"sqrt(x) - root(3)(y)",
+	`"text" + text(more text)`,
+	"|x| + (a, b]",
+	"int_0^1 f(x) dx",
+	"ubrace(1+2)_3",
+	"sin^2 x + log_2(x)",
+	"(a + frac(b)",
+	"a) + sqrt",
+	"[(a, b), (c, d)] + {(x, x > 0):}",
+	"bb x + color(red)(y) + class[big]z",
+}
+
+func TestJSON_roundTrip(t *testing.T) {
+	for _, input := range jsonTests {
+		t.Run(input, func(t *testing.T) {
+			node, _ := parser.Parse(input)
+			data, err := ast.MarshalJSON(node)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			got, err := ast.UnmarshalJSON(data)
+			if err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, node) {
+				t.Errorf("UnmarshalJSON(MarshalJSON()) = %#v, want %#v", got, node)
+			}
+			data2, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if !bytes.Equal(data, data2) {
+				t.Errorf("json.Marshal() = %s, want %s", data2, data)
+			}
+			var row ast.Row
+			if err := json.Unmarshal(data, &row); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(&row, node) {
+				t.Errorf("json.Unmarshal() = %#v, want %#v", &row, node)
+			}
+		})
+	}
+}
+
+func TestJSON_format(t *testing.T) {
+	node := mustParse(t, "x_1")
+	data, err := ast.MarshalJSON(node)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	want := `{"type":"Row","pos":0,"end":3,"items":[` +
+		`{"type":"Script","pos":0,"end":3,` +
+		`"base":{"type":"Constant","pos":0,"end":1,"token":{"input":"x","tag":"mi","output":"x","ttype":"CONST","pos":0,"end":1}},` +
+		`"sub":{"type":"Constant","pos":2,"end":3,"token":{"input":"1","tag":"mn","output":"1","ttype":"CONST","pos":2,"end":3}},` +
+		`"limits":"none"}]}`
+	if string(data) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestJSON_errors(t *testing.T) {
+	tests := []string{
+		`{"type":"Foo"}`,
+		`{"type":"Unary","op":{"input":"sqrt"}}`,
+		`{"type":"Constant","token":{"input":""}}`,
+		`{"type":"Fenced","open":{"input":"("},"body":{"type":"Constant","token":{"input":"x"}}}`,
+	}
+	for _, data := range tests {
+		if node, err := ast.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("UnmarshalJSON(%s) = %v, want error", data, node)
+		}
+	}
+	var row ast.Row
+	if err := json.Unmarshal([]byte(`{"type":"Placeholder"}`), &row); err == nil {
+		t.Errorf("json.Unmarshal() into Row succeeded for a Placeholder")
+	}
+}
+
+// TestJSON_schema checks the output of MarshalJSON against the properties of
+// each node type in the schema.
+func TestJSON_schema(t *testing.T) {
+	data, err := ioutil.ReadFile("ast.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type object struct {
+		Properties map[string]interface{} `json:"properties"`
+		Required   []string               `json:"required"`
+	}
+	var schema struct {
+		Definitions map[string]object `json:"definitions"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("invalid schema: %v", err)
+	}
+	var check func(v interface{}, def string)
+	check = func(v interface{}, def string) {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			t.Errorf("%s: not an object: %v", def, v)
+			return
+		}
+		if def == "node" {
+			def, _ = obj["type"].(string)
+		}
+		s, ok := schema.Definitions[def]
+		if !ok {
+			t.Errorf("no definition for %q", def)
+			return
+		}
+		for _, key := range s.Required {
+			if _, ok := obj[key]; !ok {
+				t.Errorf("%s: missing required %q in %v", def, key, obj)
+			}
+		}
+		for key, val := range obj {
+			prop, ok := s.Properties[key].(map[string]interface{})
+			if !ok {
+				t.Errorf("%s: unexpected property %q", def, key)
+				continue
+			}
+			if _, ok := prop["items"]; ok {
+				for _, item := range val.([]interface{}) {
+					check(item, "node")
+				}
+				continue
+			}
+			switch ref, _ := prop["$ref"].(string); ref {
+			case "#/definitions/node":
+				check(val, "node")
+			case "#/definitions/token", "#/definitions/Row":
+				check(val, ref[len("#/definitions/"):])
+			}
+		}
+	}
+	for _, input := range jsonTests {
+		node, _ := parser.Parse(input)
+		data, err := ast.MarshalJSON(node)
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			t.Fatal(err)
+		}
+		check(v, "node")
+	}
+}
+
+func TestJSON_equationArray(t *testing.T) {
+	block, _ := parser.ParseBlock("a = b\n= c\nc & d\ne")
+	data, err := ast.MarshalJSON(block)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	var got ast.EquationArray
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(&got, block) {
+		t.Errorf("json.Unmarshal() = %#v, want %#v", &got, block)
+	}
+}
@@ -0,0 +1,227 @@
+package ast
+
+import "github.com/arnodel/asciimath/scanner"
+
+// A Visitor's Visit method is invoked for each node encountered by Walk.  If
+// the result visitor w is not nil, Walk visits each of the children of node
+// with the visitor w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses a syntax tree in depth-first order, as go/ast.Walk does: it
+// starts by calling v.Visit(node); node must not be nil.  If the visitor w
+// returned by v.Visit(node) is not nil, Walk is invoked recursively with
+// visitor w for each of the non-nil children of node, followed by a call of
+// w.Visit(nil).
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *Row:
+		for _, item := range n.Items {
+			Walk(v, item)
+		}
+	case *Unary:
+		Walk(v, n.Arg)
+	case *Style:
+		Walk(v, n.Arg)
+	case *Binary:
+		Walk(v, n.Arg1)
+		Walk(v, n.Arg2)
+	case *Frac:
+		Walk(v, n.Num)
+		Walk(v, n.Den)
+	case *FunctionApplication:
+		Walk(v, n.Func)
+		Walk(v, n.Arg)
+	case *Script:
+		Walk(v, n.Base)
+		if n.Sub != nil {
+			Walk(v, n.Sub)
+		}
+		if n.Sup != nil {
+			Walk(v, n.Sup)
+		}
+	case *Fenced:
+		Walk(v, n.Body)
+	case *Matrix:
+		for _, row := range n.Rows {
+			Walk(v, row)
+		}
+	case *MatrixRow:
+		for _, cell := range n.Cells {
+			Walk(v, cell)
+		}
+	case *EquationArray:
+		for _, line := range n.Lines {
+			Walk(v, line)
+		}
+	case *EquationLine:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+	}
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses a syntax tree in depth-first order: it starts by calling
+// f(node); node must not be nil.  If f returns true, Inspect invokes f
+// recursively for each of the non-nil children of node, followed by a call of
+// f(nil).
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// Rewrite replaces nodes of a syntax tree bottom-up: the children of a node
+// are rewritten before f is called on the node itself, which is then replaced
+// with the result of f.  The tree is modified in place and the new root is
+// returned.
+//
+// Returning nil removes an item from a Row or a subscript or superscript from
+// a Script; any other node is replaced with a Placeholder instead.  A
+// replacement for the body of a Fenced node which is not a Row is wrapped in
+// one, and so is a replacement for a cell of a matrix or a side of an
+// EquationLine.  A replacement for a MatrixRow or an EquationLine must be a
+// *MatrixRow or an *EquationLine, or nil, which removes the row or line.
+//
+// Source spans are preserved: tokens in a replacement node which have no
+// location (i.e. Pos and End are both 0) are given the span of the node they
+// replace.
+func Rewrite(node Node, f func(Node) Node) Node {
+	pos, end := node.Pos(), node.End()
+	switch n := node.(type) {
+	case *Row:
+		items := n.Items[:0]
+		for _, item := range n.Items {
+			if item = Rewrite(item, f); item != nil {
+				items = append(items, item)
+			}
+		}
+		n.Items = items
+	case *Unary:
+		n.Arg = rewriteRequired(n.Arg, f)
+	case *Style:
+		n.Arg = rewriteRequired(n.Arg, f)
+	case *Binary:
+		n.Arg1 = rewriteRequired(n.Arg1, f)
+		n.Arg2 = rewriteRequired(n.Arg2, f)
+	case *Frac:
+		n.Num = rewriteRequired(n.Num, f)
+		n.Den = rewriteRequired(n.Den, f)
+	case *FunctionApplication:
+		n.Func = rewriteRequired(n.Func, f)
+		n.Arg = rewriteRequired(n.Arg, f)
+	case *Script:
+		n.Base = rewriteRequired(n.Base, f)
+		if n.Sub != nil {
+			n.Sub = Rewrite(n.Sub, f)
+		}
+		if n.Sup != nil {
+			n.Sup = Rewrite(n.Sup, f)
+		}
+	case *Fenced:
+		n.Body = rewriteRow(n.Body, f)
+	case *Matrix:
+		rows := n.Rows[:0]
+		for _, row := range n.Rows {
+			if row := Rewrite(row, f); row != nil {
+				rows = append(rows, row.(*MatrixRow))
+			}
+		}
+		n.Rows = rows
+	case *MatrixRow:
+		for i, cell := range n.Cells {
+			n.Cells[i] = rewriteRow(cell, f)
+		}
+	case *EquationArray:
+		lines := n.Lines[:0]
+		for _, line := range n.Lines {
+			if line := Rewrite(line, f); line != nil {
+				lines = append(lines, line.(*EquationLine))
+			}
+		}
+		n.Lines = lines
+	case *EquationLine:
+		n.Left = rewriteRow(n.Left, f)
+		n.Right = rewriteRow(n.Right, f)
+	}
+	repl := f(node)
+	if repl != nil && repl != node {
+		setSpan(repl, pos, end)
+	}
+	return repl
+}
+
+func rewriteRequired(node Node, f func(Node) Node) Node {
+	pos := node.Pos()
+	if repl := Rewrite(node, f); repl != nil {
+		return repl
+	}
+	return &Placeholder{At: pos}
+}
+
+func rewriteRow(row *Row, f func(Node) Node) *Row {
+	switch repl := rewriteRequired(row, f).(type) {
+	case *Row:
+		return repl
+	default:
+		return &Row{Items: []Node{repl}}
+	}
+}
+
+// setSpan gives the span pos:end to all tokens without a location in the tree
+// rooted at node.
+func setSpan(node Node, pos, end int) {
+	set := func(tok *scanner.Token) {
+		if tok.Pos == 0 && tok.End == 0 {
+			tok.Pos, tok.End = pos, end
+		}
+	}
+	Inspect(node, func(node Node) bool {
+		switch n := node.(type) {
+		case *Constant:
+			set(&n.Token)
+		case *Error:
+			set(&n.Token)
+		case *Text:
+			if n.Token.Pos == 0 && n.Token.End == 0 && n.EndPos == 0 {
+				set(&n.Token)
+				n.EndPos = end
+			}
+		case *Unary:
+			set(&n.Op)
+		case *Style:
+			set(&n.Op)
+		case *Binary:
+			set(&n.Op)
+		case *Frac:
+			set(&n.Slash)
+		case *Fenced:
+			set(&n.Open)
+			if n.Close.IsValid() {
+				set(&n.Close)
+			}
+		case *Matrix:
+			set(&n.Open)
+			set(&n.Close)
+		case *MatrixRow:
+			set(&n.Open)
+			set(&n.Close)
+		case *EquationLine:
+			if n.Marker.IsValid() {
+				set(&n.Marker)
+			}
+		}
+		return true
+	})
+}
@@ -0,0 +1,126 @@
+package ast_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+func mustParse(t *testing.T, input string) ast.Node {
+	t.Helper()
+	node, err := parser.Parse(input)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", input, err)
+	}
+	return node
+}
+
+// constants returns the inputs of the Constant nodes in the tree, in order.
+func constants(node ast.Node) []string {
+	var inputs []string
+	ast.Inspect(node, func(n ast.Node) bool {
+		if c, ok := n.(*ast.Constant); ok {
+			inputs = append(inputs, c.Token.Input())
+		}
+		return true
+	})
+	return inputs
+}
+
+type countVisitor map[string]int
+
+func (v countVisitor) Visit(node ast.Node) ast.Visitor {
+	if node == nil {
+		v["nil"]++
+	} else {
+		v[reflect.TypeOf(node).Elem().Name()]++
+	}
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	node := mustParse(t, "sum_(i=1)^n frac(i)(2)+sqrt(x)/|y|")
+	got := countVisitor{}
+	ast.Walk(got, node)
+	want := countVisitor{
+		"Row":      6,
+		"Script":   1,
+		"Fenced":   5,
+		"Binary":   1,
+		"Unary":    1,
+		"Frac":     1,
+		"Constant": 10,
+		"nil":      25,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Walk() visited %v, want %v", got, want)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	node := mustParse(t, "x_(i+1)^2 + sqrt(y)")
+	got := constants(node)
+	want := []string{"x", "i", "+", "1", "2", "+", "y"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Inspect() visited %v, want %v", got, want)
+	}
+
+	var skipped []string
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Unary:
+			return false
+		case *ast.Constant:
+			skipped = append(skipped, n.Token.Input())
+		}
+		return true
+	})
+	want = []string{"x", "i", "+", "1", "2", "+"}
+	if !reflect.DeepEqual(skipped, want) {
+		t.Errorf("Inspect() visited %v, want %v", skipped, want)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	input := "a+sqrt(b)+c"
+	node := mustParse(t, input)
+	var order []string
+	node = ast.Rewrite(node, func(n ast.Node) ast.Node {
+		order = append(order, reflect.TypeOf(n).Elem().Name())
+		switch n := n.(type) {
+		case *ast.Unary:
+			// Replace sqrt(b) with b^(1/2)
+			half := &ast.Frac{
+				Num:   &ast.Constant{Token: scanner.Token{Symbol: scanner.Lookup("1")}},
+				Slash: scanner.Token{Symbol: scanner.Lookup("/")},
+				Den:   &ast.Constant{Token: scanner.Token{Symbol: scanner.Lookup("2")}},
+			}
+			return &ast.Script{Base: n.Arg, Sup: half}
+		case *ast.Constant:
+			if n.Token.Input() == "c" {
+				return nil
+			}
+		}
+		return n
+	})
+	wantOrder := "Constant Constant Constant Row Fenced Unary Constant Constant Row"
+	if strings.Join(order, " ") != wantOrder {
+		t.Errorf("Rewrite() visited %v, want %s", order, wantOrder)
+	}
+	got := constants(node)
+	want := []string{"a", "+", "b", "1", "2", "+"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Rewrite() = %v, want %v", got, want)
+	}
+	script := node.(*ast.Row).Items[2].(*ast.Script)
+	if script.Pos() != 6 || script.End() != 9 {
+		t.Errorf("span = %d:%d, want 6:9", script.Pos(), script.End())
+	}
+	if half := script.Sup.(*ast.Frac); half.Slash.Pos != 2 || half.Slash.End != 9 {
+		t.Errorf("new token span = %d:%d, want 2:9", half.Slash.Pos, half.Slash.End)
+	}
+}
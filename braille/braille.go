@@ -0,0 +1,627 @@
+// Package braille writes syntax trees as Unicode braille, in the Nemeth Code
+// for mathematics or in the technical material of Unified English Braille.
+//
+// In Nemeth, numbers are written with the digits of the lower cells and a
+// numeric indicator after a space, comparison signs are spaced, fractions
+// are between fraction indicators, with complex fraction indicators around
+// fractions of fractions, and scripts follow level indicators with a
+// baseline indicator after them.  Big operators and accents are written
+// with the five steps of modified expressions, and radicals between a
+// radical and a termination indicator.
+//
+// In UEB, numbers are written with the numeric indicator and the letters a
+// to j, fractions between general fraction indicators, and scripts after a
+// level indicator, in grouping indicators if they are more than one item.
+// Expressions with letters are in grade 1, the words of a symbol being
+// uncontracted.
+//
+// Symbols without braille in this package are written with their English
+// words from the speech package.  Style nodes for fonts are typeform
+// indicators before each letter of their argument, and those for colors,
+// ids and classes are ignored.  Matrices and EquationArray nodes have a line
+// for each row.
+package braille
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+	"github.com/arnodel/asciimath/speech"
+)
+
+// Code is a braille code for mathematics.
+type Code int
+
+// The braille codes.
+const (
+	Nemeth Code = iota
+	UEB
+)
+
+// Options control the braille for an expression.
+type Options struct {
+	Code Code
+}
+
+// Node returns the Nemeth braille for a syntax tree.
+func Node(node ast.Node) string {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its Nemeth braille.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the braille for a syntax tree.
+func (o Options) Node(node ast.Node) string {
+	p := printer{code: o.Code, spaced: true}
+	p.node(node)
+	lines := strings.Split(p.String(), "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " ")
+		switch {
+		case o.Code == Nemeth && isLetter(node):
+			// A letter on its own takes the English letter indicator.
+			line = ";" + line
+		case o.Code == UEB && p.letters:
+			if strings.Contains(line, " ") {
+				line = ";;;" + line + ";'"
+			} else {
+				line = ";;" + line
+			}
+		}
+		lines[i] = unicodeBraille(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// unicodeBraille turns Braille ASCII into Unicode braille.
+func unicodeBraille(s string) string {
+	b := make([]rune, len(s))
+	for i := 0; i < len(s); i++ {
+		b[i] = rune(0x2800 + strings.IndexByte(brailleASCII, s[i]))
+	}
+	return string(b)
+}
+
+// A printer writes the Braille ASCII for syntax trees.
+type printer struct {
+	strings.Builder
+	code Code
+	// level is the Nemeth level indicator of the current script, "" on
+	// the baseline.
+	level string
+	// spaced is true at the start of a line and after a space, where
+	// Nemeth numbers take a numeric indicator.
+	spaced bool
+	// pending is true after a Nemeth script, until the indicator of the
+	// level that follows it.
+	pending bool
+	// number is true after a UEB number, where the letters a to j take a
+	// grade 1 indicator.
+	number bool
+	// font is the typeform indicator of letters.
+	font string
+	// letters is true once a letter is written, after which UEB is in
+	// grade 1.
+	letters bool
+}
+
+func (p *printer) write(s string) {
+	if s == "" {
+		return
+	}
+	if p.pending {
+		p.pending = false
+		if p.level == "" {
+			p.WriteString(`"`)
+		} else {
+			p.WriteString(p.level)
+		}
+	}
+	p.WriteString(s)
+	p.spaced = false
+	p.number = false
+}
+
+// space writes a space, after which a Nemeth script restates its level.
+func (p *printer) space() {
+	if s := p.String(); s != "" && !strings.HasSuffix(s, " ") && !strings.HasSuffix(s, "\n") {
+		p.WriteString(" ")
+	}
+	p.spaced = true
+	p.number = false
+	p.pending = p.level != ""
+}
+
+func (p *printer) newline() {
+	p.WriteString("\n")
+	p.spaced = true
+	p.number = false
+	p.pending = false
+}
+
+func (p *printer) node(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.Row:
+		for _, item := range n.Items {
+			p.node(item)
+		}
+	case *ast.Constant:
+		p.symbol(n.Token.Symbol)
+	case *ast.Error:
+		p.symbol(n.Token.Symbol)
+	case *ast.Placeholder:
+	case *ast.Text:
+		p.text(n.Value)
+	case *ast.Unary:
+		p.unary(n)
+	case *ast.Binary:
+		p.binary(n)
+	case *ast.Style:
+		p.style(n)
+	case *ast.Frac:
+		p.frac(n.Num, n.Den)
+	case *ast.FunctionApplication:
+		p.node(n.Func)
+		if _, ok := n.Arg.(*ast.Fenced); !ok && isName(n.Func) {
+			p.space()
+		}
+		p.node(n.Arg)
+	case *ast.Script:
+		p.script(n)
+	case *ast.Fenced:
+		p.fenced(n)
+	case *ast.Matrix:
+		p.matrix(n)
+	case *ast.EquationArray:
+		for i, line := range n.Lines {
+			if i > 0 {
+				p.newline()
+			}
+			p.node(line.Left)
+			p.node(line.Right)
+		}
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+}
+
+func (p *printer) symbol(sym *scanner.Symbol) {
+	in, out := sym.Input(), sym.Output()
+	c, ok := cells[in]
+	switch {
+	case sym.Invisible() || in == ":}":
+	case sym.Tag() == "mn":
+		p.digits(in)
+	case len(in) == 1 && isLetterRune(rune(in[0])) && sym.Tag() == "mi":
+		p.letter(rune(in[0]))
+	case ok && c[p.code] != "":
+		if comparisons[in] && p.code == Nemeth {
+			p.space()
+			p.write(c[p.code])
+			p.space()
+			return
+		}
+		spaced := p.spaced
+		p.write(c[p.code])
+		switch {
+		case in == ",":
+			p.space()
+		case in == "-" && spaced:
+			// A number after a minus sign at the start of an
+			// expression takes a numeric indicator.
+			p.spaced = true
+		}
+	case brackets[out][p.code] != "":
+		p.write(brackets[out][p.code])
+	case isWord(in):
+		p.text(in)
+	default:
+		words := speech.Options{Verbosity: speech.Superbrief}.Node(&ast.Constant{Token: scanner.Token{Symbol: sym}})
+		if comparisons[in] {
+			p.space()
+			p.text(words)
+			p.space()
+			return
+		}
+		p.text(words)
+	}
+}
+
+func isLetterRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+func isWord(s string) bool {
+	for _, r := range s {
+		if !isLetterRune(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func (p *printer) letter(r rune) {
+	s := string(unicode.ToUpper(r))
+	if unicode.IsUpper(r) {
+		s = "," + s
+	}
+	if p.code == UEB && p.number && unicode.ToLower(r) <= 'j' {
+		s = ";" + s
+	}
+	p.write(p.font + s)
+	p.letters = true
+}
+
+// digits writes a number.
+func (p *printer) digits(s string) {
+	if p.code == UEB {
+		p.write("#" + uebDigits(s))
+		p.number = true
+		return
+	}
+	if p.spaced && p.level == "" {
+		s = "#" + s
+	}
+	p.write(s)
+}
+
+// uebDigits writes the digits of a number as the letters a to j and its
+// decimal point as the cell of the period.
+func uebDigits(s string) string {
+	return strings.NewReplacer(
+		"1", "A", "2", "B", "3", "C", "4", "D", "5", "E", "6", "F",
+		"7", "G", "8", "H", "9", "I", "0", "J", ".", "4", ",", "1",
+	).Replace(s)
+}
+
+// text writes words in uncontracted braille.
+func (p *printer) text(s string) {
+	for i := 0; i < len(s); {
+		r := rune(s[i])
+		switch {
+		case r == ' ':
+			p.space()
+		case isLetterRune(r):
+			p.letter(r)
+		case r >= '0' && r <= '9':
+			j := i
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+				j++
+			}
+			p.digits(s[i:j])
+			i = j
+			continue
+		case cells[string(r)][p.code] != "":
+			p.write(cells[string(r)][p.code])
+		}
+		i++
+	}
+}
+
+// isName returns true if node is the name of a function, which is followed
+// by a space in Nemeth.
+func isName(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Constant:
+		return len(n.Token.Input()) > 1 && isWord(n.Token.Input())
+	case *ast.Script:
+		return isName(n.Base)
+	}
+	return false
+}
+
+// isLetter returns true if node is a single letter.
+func isLetter(node ast.Node) bool {
+	k, ok := single(node).(*ast.Constant)
+	return ok && k.Token.Symbol.Tag() == "mi" && len(k.Token.Input()) == 1 && isWord(k.Token.Input())
+}
+
+// operand returns the node inside the brackets of an argument, a script or
+// an operand of /, which are removed as in ASCIIMathML.
+func operand(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		return fenced.Body
+	}
+	return node
+}
+
+// single returns the item of a row of one item, or node.
+func single(node ast.Node) ast.Node {
+	for {
+		row, ok := node.(*ast.Row)
+		if !ok || len(row.Items) != 1 {
+			return node
+		}
+		node = row.Items[0]
+	}
+}
+
+// number returns the digits of a number.
+func number(node ast.Node) (string, bool) {
+	k, ok := single(operand(node)).(*ast.Constant)
+	if !ok || k.Token.Symbol.Tag() != "mn" {
+		return "", false
+	}
+	return k.Token.Input(), true
+}
+
+// item writes a UEB script or index, in grouping indicators if it is not a
+// single item.
+func (p *printer) item(node ast.Node) {
+	node = operand(node)
+	switch single(node).(type) {
+	case *ast.Constant, *ast.Text, *ast.Frac, *ast.Fenced, *ast.Matrix:
+		p.node(node)
+		return
+	}
+	p.write("<")
+	p.node(node)
+	p.write(">")
+}
+
+func hasFrac(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Frac:
+			found = true
+		case *ast.Binary:
+			found = found || n.Op.Input() == "frac"
+		}
+		return !found
+	})
+	return found
+}
+
+func (p *printer) frac(num, den ast.Node) {
+	num, den = operand(num), operand(den)
+	if p.code == UEB {
+		a, ok1 := number(num)
+		b, ok2 := number(den)
+		if ok1 && ok2 {
+			// A numeric fraction is a number.
+			p.write("#" + uebDigits(a) + "/" + uebDigits(b))
+			p.number = true
+			return
+		}
+		p.write("(")
+		p.node(num)
+		p.write("./")
+		p.node(den)
+		p.write(")")
+		return
+	}
+	complex := ""
+	if hasFrac(num) || hasFrac(den) {
+		complex = ","
+	}
+	p.write(complex + "?")
+	p.node(num)
+	p.write(complex + "/")
+	p.node(den)
+	p.write(complex + "#")
+}
+
+func (p *printer) script(n *ast.Script) {
+	if k, ok := n.Base.(*ast.Constant); ok && k.Token.Type() == scanner.UNDEROVER || n.Limits != ast.NoLimits {
+		p.modified(n.Base, n.Sub, n.Sup, "", "")
+		return
+	}
+	p.node(n.Base)
+	if p.code == UEB {
+		if n.Sub != nil {
+			p.write("5")
+			p.item(n.Sub)
+		}
+		if n.Sup != nil {
+			p.write("9")
+			p.item(n.Sup)
+		}
+		return
+	}
+	level, changed := p.level, false
+	if n.Sub != nil {
+		if digits, ok := number(n.Sub); ok && level == "" && isLetter(n.Base) {
+			// The numeric subscripts of letters are written without a
+			// level indicator.
+			p.write(digits)
+		} else {
+			p.script1(level+";", n.Sub)
+			changed = true
+		}
+	}
+	if n.Sup != nil {
+		p.script1(level+"^", n.Sup)
+		changed = true
+	}
+	p.level = level
+	p.pending = changed
+}
+
+// script1 writes a Nemeth script at a level.
+func (p *printer) script1(level string, script ast.Node) {
+	p.pending = false
+	p.level = level
+	p.WriteString(level)
+	p.node(operand(script))
+}
+
+// modified writes a base with marks or expressions under and over it: the
+// five steps of Nemeth, or the directly under and over indicators of UEB.
+// under and over are marks, used when sub and sup are nil.
+func (p *printer) modified(base, sub, sup ast.Node, under, over string) {
+	if p.code == Nemeth {
+		p.write(`"`)
+		p.node(base)
+		if sub != nil || under != "" {
+			p.write("%" + under)
+			if sub != nil {
+				p.node(operand(sub))
+			}
+		}
+		if sup != nil || over != "" {
+			p.write("<" + over)
+			if sup != nil {
+				p.node(operand(sup))
+			}
+		}
+		p.write("]")
+		return
+	}
+	p.item(base)
+	if sub != nil || under != "" {
+		p.write(`"5` + under)
+		if sub != nil {
+			p.item(sub)
+		}
+	}
+	if sup != nil || over != "" {
+		p.write(`"9` + over)
+		if sup != nil {
+			p.item(sup)
+		}
+	}
+}
+
+func (p *printer) radical(index, arg ast.Node) {
+	if p.code == UEB {
+		p.write("%")
+		if index != nil {
+			p.write("9")
+			p.item(index)
+		}
+		p.node(arg)
+		p.write("+")
+		return
+	}
+	if index != nil {
+		p.write("<")
+		p.node(index)
+	}
+	p.write(">")
+	p.node(arg)
+	p.write("]")
+}
+
+func (p *printer) unary(n *ast.Unary) {
+	sym := n.Op.Symbol
+	in, arg := sym.Input(), operand(n.Arg)
+	switch lr := sym.RewriteLeftRight(); {
+	case in == "sqrt":
+		p.radical(nil, arg)
+	case lr != [2]string{} && brackets[lr[0]][p.code] != "":
+		p.write(brackets[lr[0]][p.code])
+		p.node(arg)
+		p.write(brackets[lr[1]][p.code])
+	case in == "cancel":
+		if p.code == UEB {
+			p.item(arg)
+			p.write("@:")
+			return
+		}
+		p.write("[")
+		p.node(arg)
+		p.write("]")
+	case in == "bar" && p.code == UEB:
+		// The bar follows the item under it.
+		p.item(arg)
+		p.write(":")
+	case in == "ul" || in == "ubrace":
+		p.modified(arg, nil, nil, marks[in][p.code], "")
+	case marks[in][p.code] != "":
+		p.modified(arg, nil, nil, "", marks[in][p.code])
+	default:
+		p.symbol(sym)
+		if _, ok := n.Arg.(*ast.Fenced); !ok && isWord(in) {
+			p.space()
+		}
+		p.node(n.Arg)
+	}
+}
+
+func (p *printer) binary(n *ast.Binary) {
+	switch n.Op.Input() {
+	case "frac":
+		p.frac(n.Arg1, n.Arg2)
+	case "root":
+		p.radical(operand(n.Arg1), operand(n.Arg2))
+	case "stackrel", "overset":
+		p.modified(operand(n.Arg2), nil, n.Arg1, "", "")
+	case "underset":
+		p.modified(operand(n.Arg2), n.Arg1, nil, "", "")
+	default:
+		p.symbol(n.Op.Symbol)
+		p.node(n.Arg1)
+		p.node(n.Arg2)
+	}
+}
+
+func (p *printer) style(n *ast.Style) {
+	if ast.StyleAttr(n.Op) != "mathvariant" {
+		p.node(operand(n.Arg))
+		return
+	}
+	font := p.font
+	p.font = fonts[n.Value()][p.code]
+	p.node(operand(n.Arg))
+	p.font = font
+}
+
+func (p *printer) fenced(n *ast.Fenced) {
+	p.write(brackets[n.Open.Symbol.Output()][p.code])
+	p.node(n.Body)
+	if n.Close.IsValid() {
+		p.write(brackets[n.Close.Symbol.Output()][p.code])
+	}
+}
+
+// matrix writes a line for each row of a matrix, between enlarged grouping
+// symbols in Nemeth.  Cases have no closing bracket.
+func (p *printer) matrix(n *ast.Matrix) {
+	open, close := brackets[n.Open.Symbol.Output()][p.code], ""
+	if n.Close.IsValid() {
+		close = brackets[n.Close.Symbol.Output()][p.code]
+	}
+	if p.code == Nemeth {
+		open = "," + open
+		if close != "" {
+			close = "," + close
+		}
+	}
+	for i, row := range n.Rows {
+		if i > 0 {
+			p.newline()
+		}
+		p.write(open)
+		for j, cell := range row.Cells {
+			if j > 0 && j < len(row.Cells)-1 && isColumnLine(cell) {
+				continue
+			}
+			p.space()
+			p.node(cell)
+		}
+		if close != "" {
+			p.space()
+			p.write(close)
+		}
+	}
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
@@ -0,0 +1,167 @@
+package braille
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// The braille of the tests is in Braille ASCII.
+func TestOptions_Node(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		nemeth, ueb string
+	}{
+		{
+			name:   "numbers",
+			input:  "-3 + 2.5 = x",
+			nemeth: `-#3+2.5 .K X`,
+			ueb:    `;;"-#C"6#B4E"7X`,
+		},
+		{
+			name:   "letter",
+			input:  "x",
+			nemeth: `;X`,
+			ueb:    `;;X`,
+		},
+		{
+			name:   "fractions",
+			input:  "frac(1)(2) + (a+b)/c",
+			nemeth: `?1/2#+?A+B/C#`,
+			ueb:    `;;#A/B"6(A"6B./C)`,
+		},
+		{
+			name:   "complex fraction",
+			input:  "1/(1/x)",
+			nemeth: `,?1,/?1/X#,#`,
+			ueb:    `;;(#A./(#A./X))`,
+		},
+		{
+			name:   "scripts",
+			input:  "x_1 + x_i^2 + e^(x^2) + 1",
+			nemeth: `X1+X;I^2"+E^X^^2"+1`,
+			ueb:    `;;X5#A"6X5I9#B"6E9<X9#B>"6#A`,
+		},
+		{
+			name:   "comparison in a script",
+			input:  "x^(a=b) = 1",
+			nemeth: `X^A ^.K ^B .K #1`,
+			ueb:    `;;X9<A"7B>"7#A`,
+		},
+		{
+			name:   "radicals",
+			input:  "sqrt x + root(3)(x^2)",
+			nemeth: `>X]+<3>X^2"]`,
+			ueb:    `;;%X+"6%9#CX9#B+`,
+		},
+		{
+			name:   "greek letters",
+			input:  "alpha + Delta",
+			nemeth: `.A+.,D`,
+			ueb:    `.A"6,.D`,
+		},
+		{
+			name:   "comparison signs",
+			input:  "a <= b != c in RR",
+			nemeth: `A "K: B /.K C @E _;,R`,
+			ueb:    `;;A_@<B"7@:C^E^2,R`,
+		},
+		{
+			name:   "symbol without braille",
+			input:  "x -< y",
+			nemeth: `X PRECEDES Y`,
+			ueb:    `;;;X PRECEDES Y;'`,
+		},
+		{
+			name:   "limits",
+			input:  "sum_(i=1)^n i",
+			nemeth: `".,S%I .K #1<N]I`,
+			ueb:    `;;,.S"5<I"7#A>"9NI`,
+		},
+		{
+			name:   "integral",
+			input:  "int_0^1 f(x) dx",
+			nemeth: `!;0^1"F(X)DX`,
+			ueb:    `;;!5#J9#A;F"<X">DX`,
+		},
+		{
+			name:   "functions",
+			input:  "sin x + |x|",
+			nemeth: `SIN X+\X\`,
+			ueb:    `;;;SIN X"6_\X_\;'`,
+		},
+		{
+			name:   "accents",
+			input:  "bar x + vec v",
+			nemeth: `"X<:]+"V<$33O]`,
+			ueb:    `;;X:"6V"9\O`,
+		},
+		{
+			name:   "fonts",
+			input:  "bb x + bbb R + color(red)(y)",
+			nemeth: `_;X+_;,R+Y`,
+			ueb:    `;;^2X"6^2,R"6Y`,
+		},
+		{
+			name:   "matrix",
+			input:  "[[1,2],[3,4]]",
+			nemeth: ",@( #1 #2 ,@)\n,@( #3 #4 ,@)",
+			ueb:    ".< #A #B .>\n.< #C #D .>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node, err := parser.Parse(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := Node(node), ascii(test.nemeth); got != want {
+				t.Errorf("got Nemeth %s, want %s", got, want)
+			}
+			if got, want := (Options{Code: UEB}).Node(node), ascii(test.ueb); got != want {
+				t.Errorf("got UEB %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func ascii(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = unicodeBraille(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestNode_equationArray(t *testing.T) {
+	node, err := parser.ParseBlock("f(x) = x + 1\n= 1 + x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := Node(node), ascii("F(X) .K X+1\n.K #1+X"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestCells checks that the Greek letters and the usual comparison signs of
+// AMsymbols have braille in both codes.
+func TestCells(t *testing.T) {
+	relations := map[string]bool{}
+	for _, in := range strings.Fields("= != lt < gt > <= lt= >= gt= in !in sub sup ~~") {
+		relations[in] = true
+	}
+	for i := range scanner.AMsymbols {
+		sym := &scanner.AMsymbols[i]
+		out := []rune(sym.Output())
+		greek := len(out) == 1 && (out[0] >= 'Α' && out[0] <= 'ω' || out[0] == 'ϕ' || out[0] == 'ϑ' || out[0] == 'ɛ')
+		if !greek && !relations[sym.Input()] {
+			continue
+		}
+		if c := cells[sym.Input()]; c[Nemeth] == "" || c[UEB] == "" {
+			t.Errorf("no braille for %s", sym.Input())
+		}
+	}
+}
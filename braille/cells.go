@@ -0,0 +1,95 @@
+package braille
+
+// The braille of this package is written in North American Braille ASCII,
+// in which each of the 64 cells of six dots is a printable character, and
+// turned into the characters of the Unicode braille block at the end.
+// brailleASCII has the character for each cell, in the order of the block.
+const brailleASCII = " A1B'K2L@CIF/MSP\"E3H9O6R^DJG>NTQ,*5<-U8V.%[$+X!&;:4\\0Z7(_?W]#Y)="
+
+// cells maps the inputs of symbols to their braille in Nemeth and in UEB.
+// An empty braille is that of the words of the symbol.
+var cells = map[string][2]string{
+	// Greek letters.
+	"alpha": {".A", ".A"}, "beta": {".B", ".B"}, "chi": {".&", ".&"},
+	"delta": {".D", ".D"}, "Delta": {".,D", ",.D"}, "epsi": {".E", ".E"},
+	"varepsilon": {"@.E", ".E"}, "eta": {".:", ".:"}, "gamma": {".G", ".G"},
+	"Gamma": {".,G", ",.G"}, "iota": {".I", ".I"}, "kappa": {".K", ".K"},
+	"lambda": {".L", ".L"}, "Lambda": {".,L", ",.L"}, "lamda": {".L", ".L"},
+	"Lamda": {".,L", ",.L"}, "mu": {".M", ".M"}, "nu": {".N", ".N"},
+	"omega": {".W", ".W"}, "Omega": {".,W", ",.W"}, "phi": {".F", ".F"},
+	"varphi": {"@.F", ".F"}, "Phi": {".,F", ",.F"}, "pi": {".P", ".P"},
+	"Pi": {".,P", ",.P"}, "psi": {".Y", ".Y"}, "Psi": {".,Y", ",.Y"},
+	"rho": {".R", ".R"}, "sigma": {".S", ".S"}, "Sigma": {".,S", ",.S"},
+	"tau": {".T", ".T"}, "theta": {".?", ".?"}, "vartheta": {"@.?", ".?"},
+	"Theta": {".,?", ",.?"}, "upsilon": {".U", ".U"}, "xi": {".X", ".X"},
+	"Xi": {".,X", ",.X"}, "zeta": {".Z", ".Z"},
+
+	// Operators.
+	"+": {"+", "\"6"}, "-": {"-", "\"-"}, "*": {"*", "\"4"},
+	"**": {"@#", "\"9"}, "//": {"_/", "_/"}, "/": {"_/", "_/"},
+	"\\\\": {"_*", "_*"}, "setminus": {"_*", "_*"}, "xx": {"@*", "\"8"},
+	"-:": {"./", "\"/"}, "+-": {"+-", "_6"}, "^^": {"@%", ""},
+	"vv": {"@+", ""}, "nn": {".%", ".8"}, "uu": {".+", ".6"},
+	"^^^": {"@%", ""}, "vvv": {"@+", ""}, "nnn": {".%", ".8"},
+	"uuu": {".+", ".6"}, "sum": {".,S", ",.S"}, "prod": {".,P", ",.P"},
+	"int": {"!", "!"}, "!": {"&", "6"}, "'": {"'", "7"},
+
+	// Comparison signs.
+	"=": {".K", "\"7"}, "!=": {"/.K", "\"7@:"}, ":=": {"_3.K", "3\"7"},
+	"<": {"\"K", "@<"}, "lt": {"\"K", "@<"}, ">": {".1", "@>"},
+	"gt": {".1", "@>"}, "<=": {"\"K:", "_@<"}, "lt=": {"\"K:", "_@<"},
+	">=": {".1:", "_@>"}, "gt=": {".1:", "_@>"}, "in": {"@E", "^E"},
+	"!in": {"/@E", "^E@:"}, "sub": {"_\"K", "^<"}, "sup": {"_.1", "^>"},
+	"sube": {"_\"K:", ""}, "supe": {"_.1:", ""}, "-=": {"_L", ""},
+	"~=": {"@:.K", ""}, "~~": {"@:@:", "@9@9"}, "prop": {"_=", ""},
+	"->": {"$33O", "\\O"}, "rarr": {"$33O", "\\O"}, "larr": {"$[33", "\\["},
+	"harr": {"$[33O", ""}, "=>": {"$77O", ""}, "rArr": {"$77O", ""},
+	"lArr": {"$[77", ""}, "<=>": {"$[77O", ""}, "hArr": {"$[77O", ""},
+
+	// Miscellaneous symbols.
+	"oo": {",=", "#="}, "del": {"@D", "@D"}, "grad": {".$", ""},
+	"O/": {"_0", ""}, "...": {"'''", "444"}, "cdots": {"'''", "444"},
+	":.": {",*", ""}, ":'": {"@/", ""}, "/_": {"$[", "_["},
+	"/_\\": {"$T", ""}, "square": {"$4", ""}, "AA": {"@&", ""},
+	"EE": {"@=", ""}, "_|_": {"#'", ""}, "CC": {"_;,C", "^2,C"},
+	"NN": {"_;,N", "^2,N"}, "QQ": {"_;,Q", "^2,Q"}, "RR": {"_;,R", "^2,R"},
+	"ZZ": {"_;,Z", "^2,Z"}, ",": {",", "1"}, ";": {"_2", "2"},
+	":": {"_3", "3"}, ".": {"_4", "4"},
+}
+
+// comparisons are the inputs of the comparison signs and arrows, which are
+// spaced in Nemeth.
+var comparisons = map[string]bool{
+	"=": true, "!=": true, ":=": true, "<": true, "lt": true, ">": true,
+	"gt": true, "<=": true, "lt=": true, ">=": true, "gt=": true,
+	"-<": true, "-lt": true, ">-": true, "-<=": true, ">-=": true,
+	"in": true, "!in": true, "sub": true, "sup": true, "sube": true,
+	"supe": true, "-=": true, "~=": true, "~~": true, "prop": true,
+	"=>": true, "<=>": true, "|--": true, "|==": true, "uarr": true,
+	"darr": true, "rarr": true, "->": true, ">->": true, "->>": true,
+	">->>": true, "|->": true, "larr": true, "harr": true, "rArr": true,
+	"lArr": true, "hArr": true,
+}
+
+// brackets maps the outputs of brackets to their braille.
+var brackets = map[string][2]string{
+	"(": {"(", "\"<"}, ")": {")", "\">"}, "[": {"@(", ".<"}, "]": {"@)", ".>"},
+	"{": {".(", "_<"}, "}": {".)", "_>"}, "|": {"\\", "_\\"},
+	"∥": {"\\\\", "_\\_\\"}, "\u2329": {"..(", "@.<"}, "\u232A": {"..)", "@.>"},
+}
+
+// marks maps the inputs of accents to the braille of their marks, which are
+// above their base except for ul and ubrace.
+var marks = map[string][2]string{
+	"bar": {":", ":"}, "hat": {"_<", "@5"}, "tilde": {"@:", "@9"},
+	"vec": {"$33O", "\\O"}, "dot": {"*", "4"}, "ddot": {"**", "44"},
+	"overarc": {"$A", "$A"}, "ul": {":", ":"}, "obrace": {".(", "_<"},
+	"ubrace": {".)", "_>"},
+}
+
+// fonts maps the values of the mathvariant attribute to the typeform
+// indicators for letters.  Double-struck letters are written in bold.
+var fonts = map[string][2]string{
+	"bold": {"_;", "^2"}, "double-struck": {"_;", "^2"}, "script": {"@;", "@2"},
+	"fraktur": {"_", ""}, "sans-serif": {",.;", ""},
+}
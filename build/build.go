@@ -0,0 +1,379 @@
+// Package build constructs syntax trees of ASCIIMath expressions from Go code.
+//
+// The functions in this package add the brackets that the grammar requires,
+// e.g. around a sum used as a denominator, and no others, so that the trees
+// they return can be written with format.Node and parsed back to the same
+// trees:
+//
+//	x := build.Sym("x")
+//	e := build.Frac(build.Row(x, build.Sym("+"), build.Num(1)), build.Sup(x, build.Num(2)))
+//	format.Node(e) // "(x+1)/x^2", nil
+//
+// Rows are sequences of items without any precedence, as in the rest of the
+// syntax tree: Row(a, Sym("+"), b) is a+b and a nested row is the same as its
+// items.  Use Paren to group items explicitly.
+package build
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Sym returns a constant for the symbol written input, e.g. "x", "alpha",
+// "+" or "oo".  Symbols that are definitions in ASCIIMathML are replaced with
+// what they stand for, as the parser does: Sym("dx") is {:d x:}.
+func Sym(input string) ast.Node {
+	tok := token(input)
+	if tok.Type() != scanner.DEFINITION {
+		return &ast.Constant{Token: tok}
+	}
+	defTokens, _ := scanner.New().Scan(tok.Symbol.Output())
+	if len(defTokens) == 1 {
+		return Sym(defTokens[0].Input())
+	}
+	// The other definitions are of the form {:d x:}.
+	var items []ast.Node
+	for _, defTok := range defTokens[1 : len(defTokens)-1] {
+		items = append(items, Sym(defTok.Input()))
+	}
+	return Group(defTokens[0].Input(), defTokens[len(defTokens)-1].Input(), items...)
+}
+
+// Num returns the number x.  Negative numbers are a minus sign followed by
+// a number.
+func Num(x float64) ast.Node {
+	if x < 0 {
+		return Row(Sym("-"), Num(-x))
+	}
+	return Sym(strconv.FormatFloat(x, 'f', -1, 64))
+}
+
+// Text returns a literal piece of text.  format.Node returns an error for a
+// text containing all of ", ), ] and }, which ASCIIMath cannot write.
+func Text(s string) ast.Node {
+	return &ast.Text{Token: token(`"`), Value: s}
+}
+
+// Row returns the sequence of items.  Rows among the items are replaced with
+// their own items.
+func Row(items ...ast.Node) *ast.Row {
+	row := &ast.Row{}
+	for _, item := range items {
+		if r, ok := item.(*ast.Row); ok {
+			row.Items = append(row.Items, Row(r.Items...).Items...)
+		} else {
+			row.Items = append(row.Items, item)
+		}
+	}
+	return row
+}
+
+// Paren returns items between ( and ).
+func Paren(items ...ast.Node) *ast.Fenced {
+	return Group("(", ")", items...)
+}
+
+// Group returns items between the brackets open and close, e.g. "[" and ")"
+// or "{:" and ":}".
+func Group(open, close string, items ...ast.Node) *ast.Fenced {
+	return &ast.Fenced{Open: token(open), Body: Row(items...), Close: token(close)}
+}
+
+// Frac returns the fraction num/den.
+func Frac(num, den ast.Node) ast.Node {
+	return &ast.Frac{Num: operand(num), Slash: token("/"), Den: operand(den)}
+}
+
+// Sup returns base with a superscript.
+func Sup(base, sup ast.Node) ast.Node {
+	return SubSup(base, nil, sup)
+}
+
+// Sub returns base with a subscript.
+func Sub(base, sub ast.Node) ast.Node {
+	return SubSup(base, sub, nil)
+}
+
+// SubSup returns base with a subscript and a superscript, either of which can
+// be nil.
+func SubSup(base, sub, sup ast.Node) ast.Node {
+	base = simple(base)
+	n := &ast.Script{Base: base}
+	switch b := base.(type) {
+	case *ast.Constant:
+		n.Limits = ast.LimitsFor(b.Token)
+	case *ast.Unary:
+		n.Limits = ast.LimitsFor(b.Op)
+	case *ast.Binary:
+		n.Limits = ast.LimitsFor(b.Op)
+	}
+	if sub != nil {
+		n.Sub = script(sub)
+	}
+	if sup != nil {
+		n.Sup = script(sup)
+	}
+	return n
+}
+
+// Sqrt returns the square root of x.
+func Sqrt(x ast.Node) ast.Node {
+	return Unary("sqrt", x)
+}
+
+// Root returns the n-th root of x.
+func Root(n, x ast.Node) ast.Node {
+	return Binary("root", n, x)
+}
+
+// Unary returns the unary symbol op, e.g. "sqrt", "hat" or "abs", applied to
+// arg.  Font symbols such as "bb" give a Style.
+func Unary(op string, arg ast.Node) ast.Node {
+	tok := token(op)
+	if name := tok.Symbol.AttrName(); name != "" {
+		return &ast.Style{Op: tok, Attrs: map[string]string{name: tok.Symbol.AttrValue()}, Arg: simple(arg)}
+	}
+	return &ast.Unary{Op: tok, Arg: simple(arg)}
+}
+
+// Color returns arg in the given color, e.g. "red" or "#ff0000".
+func Color(color string, arg ast.Node) ast.Node {
+	return style("color", color, arg)
+}
+
+// ID returns arg with the given id.
+func ID(id string, arg ast.Node) ast.Node {
+	return style("id", id, arg)
+}
+
+// Class returns arg with the given class.
+func Class(class string, arg ast.Node) ast.Node {
+	return style("class", class, arg)
+}
+
+func style(op, value string, arg ast.Node) ast.Node {
+	tok := token(op)
+	return &ast.Style{Op: tok, Attrs: map[string]string{ast.StyleAttr(tok): value}, Arg: simple(arg)}
+}
+
+// Binary returns the binary symbol op, e.g. "frac", "root" or "stackrel",
+// applied to arg1 and arg2.
+func Binary(op string, arg1, arg2 ast.Node) ast.Node {
+	return &ast.Binary{Op: token(op), Arg1: simple(arg1), Arg2: simple(arg2)}
+}
+
+// Apply returns the function fn applied to args, which are separated by
+// commas if there are more than one.  The function is a symbol, such as
+// Sym("sin") or Sym("f"), possibly with scripts, e.g. Sub(Sym("log"),
+// Num(2)).
+//
+// If fn is not a function symbol in ASCIIMathML, e.g. Sym("h"), the result
+// is a row made of fn and its arguments in brackets, which is how h(x) is
+// parsed.
+func Apply(fn ast.Node, args ...ast.Node) ast.Node {
+	var arg ast.Node
+	if len(args) == 1 {
+		arg = unwrap(args[0])
+	} else {
+		var items []ast.Node
+		for i, a := range args {
+			if i > 0 {
+				items = append(items, Sym(","))
+			}
+			items = append(items, a)
+		}
+		arg = Paren(items...)
+	}
+	fn = unwrap(fn)
+	name := fnName(fn)
+	if !name.IsValid() || !name.Symbol.IsFunc() {
+		if !isFenced(arg, "") {
+			arg = Paren(arg)
+		}
+		return Row(fn, arg)
+	}
+	switch {
+	case len(name.Input()) == 1:
+		// Single letter functions only take arguments in round brackets.
+		if !isFenced(arg, "(") {
+			arg = Paren(arg)
+		}
+	case isFenced(arg, "|") || isComma(arg):
+		// A function followed by "|" or "," has no argument.
+		arg = Paren(arg)
+	case isScript(fn):
+		// The argument of sin^2 is an I expression.
+		if !isSimple(arg) && !isScript(arg) {
+			arg = Paren(arg)
+		}
+	default:
+		arg = simple(arg)
+	}
+	return &ast.FunctionApplication{Func: fn, Arg: arg}
+}
+
+// Sum returns the sum of body from from to to.  Any of from and to can be
+// nil.
+func Sum(from, to, body ast.Node) ast.Node {
+	return BigOp("sum", from, to, body)
+}
+
+// Prod returns the product of body from from to to.  Any of from and to can
+// be nil.
+func Prod(from, to, body ast.Node) ast.Node {
+	return BigOp("prod", from, to, body)
+}
+
+// Int returns the integral of integrand from from to to with respect to
+// variable, e.g. int_0^1 f(x) dx.  Any of from and to can be nil.
+func Int(from, to, integrand, variable ast.Node) ast.Node {
+	differential := Row(Sym("d"), variable)
+	if c, ok := unwrap(variable).(*ast.Constant); ok && token("d"+c.Token.Input()).Type() == scanner.DEFINITION {
+		differential = Row(Sym("d" + c.Token.Input()))
+	}
+	return Row(BigOp("int", from, to, integrand), differential)
+}
+
+// Lim returns the limit of body, with under written under lim, e.g.
+// Row(Sym("x"), Sym("->"), Num(0)).
+func Lim(under, body ast.Node) ast.Node {
+	return BigOp("lim", under, nil, body)
+}
+
+// BigOp returns the symbol op, e.g. "sum" or "bigcup", with the scripts from
+// and to, either of which can be nil, followed by body.
+func BigOp(op string, from, to, body ast.Node) ast.Node {
+	body = unwrap(body)
+	if _, ok := body.(*ast.Row); ok {
+		body = Paren(body)
+	}
+	if from == nil && to == nil {
+		return Row(Sym(op), body)
+	}
+	return Row(SubSup(Sym(op), from, to), body)
+}
+
+// Matrix returns the matrix with the given rows, written [(a,b),(c,d)].  All
+// the rows must have the same number of cells, and as ASCIIMathML only
+// recognises matrices with more than one cell, it panics for a matrix of one
+// cell or none: [(a)] is a in brackets.
+func Matrix(rows ...[]ast.Node) *ast.Matrix {
+	if len(rows) == 0 || len(rows[0]) == 0 || len(rows) == 1 && len(rows[0]) == 1 {
+		panic("build: matrices have more than one cell")
+	}
+	matrix := &ast.Matrix{Open: token("["), Close: token("]")}
+	for _, cells := range rows {
+		if len(cells) != len(rows[0]) {
+			panic(fmt.Sprintf("build: matrix rows have %d and %d cells", len(rows[0]), len(cells)))
+		}
+		row := &ast.MatrixRow{Open: token("("), Close: token(")")}
+		for _, cell := range cells {
+			r := Row(cell)
+			for _, item := range r.Items {
+				if isComma(item) {
+					r = Row(Paren(r))
+					break
+				}
+			}
+			row.Cells = append(row.Cells, r)
+		}
+		matrix.Rows = append(matrix.Rows, row)
+	}
+	return matrix
+}
+
+func token(input string) scanner.Token {
+	return scanner.Token{Symbol: scanner.Lookup(input)}
+}
+
+// unwrap returns the item of a row with a single item, or node otherwise.
+func unwrap(node ast.Node) ast.Node {
+	if row, ok := node.(*ast.Row); ok && len(row.Items) == 1 {
+		return unwrap(row.Items[0])
+	}
+	return node
+}
+
+// simple returns node, in brackets if it is not a simple expression.
+func simple(node ast.Node) ast.Node {
+	if node = unwrap(node); isSimple(node) {
+		return node
+	}
+	return Paren(node)
+}
+
+// operand returns node, in brackets if it cannot be an operand of "/".
+func operand(node ast.Node) ast.Node {
+	if node = unwrap(node); isSimple(node) || isScript(node) {
+		return node
+	}
+	return Paren(node)
+}
+
+// script returns node, in brackets if it cannot be a script.  As in
+// ASCIIMathML, x^-1 is the same as x^(-1).
+func script(node ast.Node) ast.Node {
+	node = unwrap(node)
+	if row, ok := node.(*ast.Row); ok && len(row.Items) == 2 && isSign(row.Items[0]) && isSimple(row.Items[1]) {
+		return node
+	}
+	return simple(node)
+}
+
+// isSimple returns true if node is a simple expression in the grammar of
+// ASCIIMathML, i.e. it can be an argument of a unary or binary symbol or
+// the base of a script.
+func isSimple(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Constant, *ast.Text, *ast.Unary, *ast.Style, *ast.Binary, *ast.Fenced, *ast.Matrix, *ast.Placeholder:
+		return true
+	case *ast.FunctionApplication:
+		return !isScript(n.Func)
+	}
+	return false
+}
+
+// isScript returns true if node is an intermediate expression that is not
+// simple.
+func isScript(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Script:
+		return true
+	case *ast.FunctionApplication:
+		return isScript(n.Func)
+	}
+	return false
+}
+
+// isFenced returns true if node is between brackets starting with open, or
+// any brackets if open is "".
+func isFenced(node ast.Node, open string) bool {
+	var tok scanner.Token
+	switch n := node.(type) {
+	case *ast.Fenced:
+		tok = n.Open
+	case *ast.Matrix:
+		tok = n.Open
+	default:
+		return false
+	}
+	return open == "" || len(tok.Input()) >= len(open) && tok.Input()[:len(open)] == open
+}
+
+func isComma(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == ","
+}
+
+func isSign(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == "-"
+}
+
+// fnName returns the token of the function symbol in fn, or the zero Token.
+func fnName(fn ast.Node) scanner.Token {
+	return (&ast.FunctionApplication{Func: fn}).Name()
+}
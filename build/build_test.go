@@ -0,0 +1,163 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/format"
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestBuild(t *testing.T) {
+	x, y, n, i := Sym("x"), Sym("y"), Sym("n"), Sym("i")
+	plus, eq := Sym("+"), Sym("=")
+	tests := []struct {
+		name string
+		node ast.Node
+		want string
+	}{
+		{
+			name: "fraction",
+			node: Frac(Row(x, plus, Num(1)), Sup(x, Num(2))),
+			want: "(x+1)/x^2",
+		},
+		{
+			name: "simple fraction",
+			node: Frac(Num(1), Num(2)),
+			want: "1/2",
+		},
+		{
+			name: "nested fraction",
+			node: Frac(Frac(x, y), Num(2)),
+			want: "(x/y)/2",
+		},
+		{
+			name: "negative exponent",
+			node: Sup(x, Num(-1)),
+			want: "x^-1",
+		},
+		{
+			name: "negative numerator",
+			node: Frac(Num(-1), Num(2.5)),
+			want: "(-1)/2.5",
+		},
+		{
+			name: "script of script",
+			node: Sup(Sup(x, Num(2)), Num(3)),
+			want: "(x^2)^3",
+		},
+		{
+			name: "subscript and superscript",
+			node: SubSup(x, Row(i, plus, Num(1)), Num(2)),
+			want: "x_(i+1)^2",
+		},
+		{
+			name: "square root",
+			node: Row(Sqrt(Sup(x, Num(2))), plus, Sup(Sqrt(x), Num(2))),
+			want: "sqrt(x^2) + sqrt x^2",
+		},
+		{
+			name: "root",
+			node: Root(Num(3), Row(x, plus, y)),
+			want: "root 3(x + y)",
+		},
+		{
+			name: "sum",
+			node: Sum(Row(i, eq, Num(1)), n, Sup(i, Num(2))),
+			want: "sum_(i=1)^n i^2",
+		},
+		{
+			name: "sum of a row",
+			node: Row(Sum(i, nil, Row(i, plus, Num(1))), plus, Num(1)),
+			want: "sum_i(i + 1) + 1",
+		},
+		{
+			name: "integral",
+			node: Row(Int(Num(0), Num(1), Apply(Sym("f"), x), x), plus, Int(nil, nil, Row(Sym("u"), plus, Num(1)), Sym("u"))),
+			want: "int_0^1 f(x) dx + int(u + 1) du",
+		},
+		{
+			name: "limit",
+			node: Lim(Row(x, Sym("->"), Num(0)), Frac(Apply(Sym("sin"), x), x)),
+			want: "lim_(x->0) sin x/x",
+		},
+		{
+			name: "functions",
+			node: Row(Apply(Sym("f"), x), plus, Apply(Sym("g"), x, y), plus, Apply(Sym("h"), x)),
+			want: "f(x) + g(x, y) + h(x)",
+		},
+		{
+			name: "function of a row",
+			node: Apply(Sym("sin"), Row(x, plus, y)),
+			want: "sin(x + y)",
+		},
+		{
+			name: "function with scripts",
+			node: Row(Apply(Sup(Sym("sin"), Num(2)), Sup(x, Num(2))), plus, Apply(Sub(Sym("log"), Num(2)), x)),
+			want: "sin^2 x^2 + log_2 x",
+		},
+		{
+			name: "function of an absolute value",
+			node: Apply(Sym("ln"), Group("|", "|", x)),
+			want: "ln(|x|)",
+		},
+		{
+			name: "matrix",
+			node: Matrix([]ast.Node{x, Row(Sym("-"), y)}, []ast.Node{y, Apply(Sym("g"), x, y)}),
+			want: "[(x, -y), (y, g(x, y))]",
+		},
+		{
+			name: "matrix with commas",
+			node: Matrix([]ast.Node{Row(x, Sym(","), y), Num(1)}),
+			want: "[((x, y), 1)]",
+		},
+		{
+			name: "unary and binary",
+			node: Row(Unary("hat", Row(x, y)), plus, Binary("stackrel", Sym("d"), eq), Text("if")),
+			want: `hat(xy) + stackrel d = "if"`,
+		},
+		{
+			name: "styles",
+			node: Row(Unary("bb", x), plus, Color("red", Row(x, plus, y)), Class("big", Sym("2"))),
+			want: "bb x + color(red)(x + y) class(big) 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := format.Node(tt.node)
+			if err != nil || got != tt.want {
+				t.Errorf("format.Node() = %q, %v, want %q", got, err, tt.want)
+			}
+			parsed, err := parser.Parse(got)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", got, err)
+			}
+			if !ast.Equal(parsed, Row(tt.node)) {
+				t.Errorf("Parse(%q) is not the built tree", got)
+			}
+		})
+	}
+}
+
+func TestMatrix_panics(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]ast.Node
+	}{
+		{"uneven rows", [][]ast.Node{{Sym("a"), Sym("b")}, {Sym("c")}}},
+		// [(a)] is parsed as a in brackets, not as a matrix.
+		{"one cell", [][]ast.Node{{Sym("a")}}},
+		{"no rows", nil},
+		{"no cells", [][]ast.Node{{}, {}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Matrix() did not panic")
+				}
+			}()
+			Matrix(tt.rows...)
+		})
+	}
+}
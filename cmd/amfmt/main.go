@@ -0,0 +1,111 @@
+// Amfmt formats ASCIIMath source files, in the way gofmt formats Go source
+// files.  A file contains one expression per line.
+//
+// Usage:
+//
+//	amfmt [flags] [path ...]
+//
+// Without a path, amfmt formats the standard input.  Each path must be a
+// file: unlike gofmt, amfmt does not walk directories, as ASCIIMath files
+// have no extension to find them by.  The flags are:
+//
+//	-l
+//		Do not print the formatted source to standard output.  Print
+//		the names of files whose formatting differs from amfmt's.
+//	-w
+//		Do not print the formatted source to standard output.  Write
+//		the result to the file instead.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/arnodel/asciimath/format"
+)
+
+var (
+	list  = flag.Bool("l", false, "list files whose formatting differs from amfmt's")
+	write = flag.Bool("w", false, "write result to (source) file instead of stdout")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: amfmt [flags] [path ...]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	exitCode := 0
+	if flag.NArg() == 0 {
+		if *write {
+			fmt.Fprintln(os.Stderr, "amfmt: cannot use -w with standard input")
+			os.Exit(2)
+		}
+		if err := processFile("<standard input>", os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exitCode = 2
+		}
+		os.Exit(exitCode)
+	}
+	for _, path := range flag.Args() {
+		if err := processPath(path); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exitCode = 2
+		}
+	}
+	os.Exit(exitCode)
+}
+
+// processPath formats the file at path, which must not be a directory.
+func processPath(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s: is a directory, amfmt only formats files", path)
+	}
+	return processFile(path, f, os.Stdout)
+}
+
+func processFile(filename string, in io.Reader, out io.Writer) error {
+	src, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	res, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err)
+	}
+	if bytes.Equal(src, res) {
+		if !*list && !*write {
+			_, err = out.Write(res)
+		}
+		return err
+	}
+	if *list {
+		fmt.Fprintln(out, filename)
+	}
+	if *write {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filename, res, info.Mode().Perm())
+	}
+	if !*list {
+		_, err = out.Write(res)
+	}
+	return err
+}
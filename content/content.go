@@ -0,0 +1,566 @@
+// Package content renders expressions as Content MathML, which encodes their
+// meaning rather than their layout: a+b*c is
+//
+//	<apply><plus/><ci>a</ci><apply><times/><ci>b</ci><ci>c</ci></apply></apply>
+//
+// The expression is first converted to a semantic tree, so the precedence
+// rules of the semantic package apply.  Operators and functions with a
+// Content MathML element are written with it (<plus/>, <leq/>, <sin/>...),
+// big operators with their bound variable and limits (bvar, lowlimit,
+// uplimit), and other symbols as ci elements, or csymbol elements for
+// operators.  Relation chains such as 0 < x <= 1 are the conjunction of their
+// links, and cases are piecewise elements.
+//
+// With the Strict option, the output is Strict Content MathML: operators are
+// csymbol elements with the OpenMath content dictionary that defines them,
+// bound variables are introduced by lambda, and the limits of sums and
+// integrals are intervals.
+//
+// An EquationArray is read as a derivation: lines without a left hand side
+// continue the relation chain of the line before them, and separate
+// equations are joined with and.
+package content
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+	"github.com/arnodel/asciimath/semantic"
+)
+
+// Options control the form of the output.
+type Options struct {
+	// Strict writes Strict Content MathML.
+	Strict bool
+}
+
+// Node returns the Content MathML for a syntax tree with the default options.
+func Node(node ast.Node) (string, error) {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its Content MathML with the
+// default options.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Options{}.Node(node)
+}
+
+// Node returns the Content MathML for a syntax tree.  It returns a
+// *semantic.Error if the tree cannot be converted to an expression.
+func (o Options) Node(node ast.Node) (string, error) {
+	block, ok := node.(*ast.EquationArray)
+	if !ok {
+		expr, err := semantic.FromAST(node)
+		if err != nil {
+			return "", err
+		}
+		return o.Tree(expr), nil
+	}
+	var exprs []string
+	for _, items := range statements(block) {
+		expr, err := semantic.FromAST(&ast.Row{Items: items})
+		if err != nil {
+			return "", err
+		}
+		exprs = append(exprs, o.expr(expr))
+	}
+	if len(exprs) == 1 {
+		return "<math>" + exprs[0] + "</math>", nil
+	}
+	return "<math>" + o.apply("and", exprs...) + "</math>", nil
+}
+
+// statements returns the items of the statements made by the lines of a
+// block.  A line without a left hand side continues the statement before it.
+func statements(block *ast.EquationArray) [][]ast.Node {
+	var stmts [][]ast.Node
+	for _, line := range block.Lines {
+		if len(line.Left.Items) > 0 || len(stmts) == 0 {
+			stmts = append(stmts, nil)
+		}
+		last := len(stmts) - 1
+		stmts[last] = append(stmts[last], line.Left.Items...)
+		stmts[last] = append(stmts[last], line.Right.Items...)
+	}
+	return stmts
+}
+
+// Tree returns the Content MathML for an expression tree.
+func (o Options) Tree(expr semantic.Expr) string {
+	return "<math>" + o.expr(expr) + "</math>"
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func leaf(name, text string) string {
+	return "<" + name + ">" + escaper.Replace(text) + "</" + name + ">"
+}
+
+func elem(name string, children ...string) string {
+	return "<" + name + ">" + strings.Join(children, "") + "</" + name + ">"
+}
+
+// elements maps the outputs of operators and functions to the Content MathML
+// elements for them.
+var elements = map[string]string{
+	"+": "plus", "-": "minus", "⋅": "times", "×": "times", "∗": "times",
+	"/": "divide", "÷": "divide", "mod": "rem", "∘": "compose",
+	"=": "eq", "≠": "neq", "<": "lt", ">": "gt", "≤": "leq", "≥": "geq",
+	"≈": "approx", "≡": "equivalent", "|": "factorof",
+	"∈": "in", "∉": "notin", "⊂": "prsubset", "⊆": "subset",
+	"∪": "union", "∩": "intersect", "\\": "setdiff",
+	"∧": "and", "and": "and", "∨": "or", "or": "or", "¬": "not",
+	"⇒": "implies", "⇔": "equivalent", "→": "tendsto",
+	"∑": "sum", "∏": "product", "∫": "int", "lim": "limit",
+	"min": "min", "max": "max",
+	"⋃": "union", "⋂": "intersect", "⋀": "and", "⋁": "or",
+	"sin": "sin", "cos": "cos", "tan": "tan", "sec": "sec", "csc": "csc",
+	"cot": "cot", "sinh": "sinh", "cosh": "cosh", "tanh": "tanh",
+	"sech": "sech", "csch": "csch", "coth": "coth", "arcsin": "arcsin",
+	"arccos": "arccos", "arctan": "arctan", "exp": "exp", "ln": "ln",
+	"log": "log", "det": "determinant", "gcd": "gcd", "lcm": "lcm",
+	"abs": "abs", "floor": "floor", "ceil": "ceiling",
+}
+
+// constants maps the outputs of symbols to the Content MathML elements for
+// the constants they stand for.
+var constants = map[string]string{
+	"π": "pi", "∞": "infinity", "∅": "emptyset", "ℕ": "naturalnumbers",
+	"ℤ": "integers", "ℚ": "rationals", "ℝ": "reals", "ℂ": "complexes",
+}
+
+// strictSymbols maps Content MathML elements to the content dictionary and
+// name of the symbols for them in Strict Content MathML.
+var strictSymbols = map[string][2]string{
+	"plus": {"arith1", "plus"}, "minus": {"arith1", "minus"},
+	"unary_minus": {"arith1", "unary_minus"}, "times": {"arith1", "times"},
+	"divide": {"arith1", "divide"}, "power": {"arith1", "power"},
+	"root": {"arith1", "root"}, "abs": {"arith1", "abs"},
+	"gcd": {"arith1", "gcd"}, "lcm": {"arith1", "lcm"},
+	"sum": {"arith1", "sum"}, "product": {"arith1", "product"},
+	"rem": {"integer1", "remainder"}, "factorof": {"integer1", "factorof"},
+	"floor": {"rounding1", "floor"}, "ceiling": {"rounding1", "ceiling"},
+	"compose": {"fns1", "left_compose"},
+	"eq":      {"relation1", "eq"}, "neq": {"relation1", "neq"},
+	"lt": {"relation1", "lt"}, "gt": {"relation1", "gt"},
+	"leq": {"relation1", "leq"}, "geq": {"relation1", "geq"},
+	"approx": {"relation1", "approx"},
+	"in":     {"set1", "in"}, "notin": {"set1", "notin"},
+	"prsubset": {"set1", "prsubset"}, "subset": {"set1", "subset"},
+	"union": {"set1", "union"}, "intersect": {"set1", "intersect"},
+	"setdiff": {"set1", "setdiff"}, "emptyset": {"set1", "emptyset"},
+	"and": {"logic1", "and"}, "or": {"logic1", "or"}, "not": {"logic1", "not"},
+	"implies": {"logic1", "implies"}, "equivalent": {"logic1", "equivalent"},
+	"limit": {"limit1", "limit"}, "min": {"minmax1", "min"},
+	"max": {"minmax1", "max"},
+	"int": {"calculus1", "defint"}, "diff": {"calculus1", "diff"},
+	"sin": {"transc1", "sin"}, "cos": {"transc1", "cos"},
+	"tan": {"transc1", "tan"}, "sec": {"transc1", "sec"},
+	"csc": {"transc1", "csc"}, "cot": {"transc1", "cot"},
+	"sinh": {"transc1", "sinh"}, "cosh": {"transc1", "cosh"},
+	"tanh": {"transc1", "tanh"}, "sech": {"transc1", "sech"},
+	"csch": {"transc1", "csch"}, "coth": {"transc1", "coth"},
+	"arcsin": {"transc1", "arcsin"}, "arccos": {"transc1", "arccos"},
+	"arctan": {"transc1", "arctan"}, "exp": {"transc1", "exp"},
+	"ln": {"transc1", "ln"}, "log": {"transc1", "log"},
+	"determinant": {"linalg1", "determinant"},
+	"pi":          {"nums1", "pi"}, "infinity": {"nums1", "infinity"},
+	"naturalnumbers": {"setname1", "N"}, "integers": {"setname1", "Z"},
+	"rationals": {"setname1", "Q"}, "reals": {"setname1", "R"},
+	"complexes": {"setname1", "C"},
+}
+
+// symbol returns the empty element name, or the csymbol for it in Strict
+// Content MathML.  Elements with no symbol in the standard content
+// dictionaries, and operators with no element, are symbols of the private
+// content dictionary "asciimath".
+func (o Options) symbol(name string) string {
+	if !o.Strict {
+		return "<" + name + "/>"
+	}
+	if s, ok := strictSymbols[name]; ok {
+		return csymbol(s[0], s[1])
+	}
+	return csymbol("asciimath", name)
+}
+
+func csymbol(cd, name string) string {
+	return `<csymbol cd="` + cd + `">` + escaper.Replace(name) + "</csymbol>"
+}
+
+// apply applies the operator whose element is name to args.
+func (o Options) apply(name string, args ...string) string {
+	return elem("apply", append([]string{o.symbol(name)}, args...)...)
+}
+
+// operator returns the element for the operator of tok, or a csymbol for
+// tokens which have none.
+func (o Options) operator(tok scanner.Token) string {
+	if name, ok := elements[tok.Symbol.Output()]; ok {
+		return o.symbol(name)
+	}
+	if o.Strict {
+		return csymbol("asciimath", tok.Input())
+	}
+	return leaf("csymbol", tok.Symbol.Output())
+}
+
+func (o Options) expr(expr semantic.Expr) string {
+	switch e := expr.(type) {
+	case *semantic.Number:
+		return o.number(e.Token.Input())
+	case *semantic.Symbol:
+		if name, ok := constants[e.Token.Symbol.Output()]; ok {
+			return o.symbol(name)
+		}
+		return leaf("ci", e.Token.Symbol.Output())
+	case *semantic.Infix:
+		return o.infix(e)
+	case *semantic.RelationChain:
+		return o.relationChain(e)
+	case *semantic.Prefix:
+		switch e.Op.Input() {
+		case "+":
+			return o.expr(e.Arg)
+		case "-":
+			if o.Strict {
+				return o.apply("unary_minus", o.expr(e.Arg))
+			}
+			return o.apply("minus", o.expr(e.Arg))
+		}
+		return elem("apply", o.operator(e.Op), o.expr(e.Arg))
+	case *semantic.Power:
+		return o.apply("power", o.expr(e.Base), o.expr(e.Exponent))
+	case *semantic.Subscript:
+		return elem("apply", csymbol("ambiguous", "subscript"), o.expr(e.Base), o.expr(e.Index))
+	case *semantic.Call:
+		return o.call(e)
+	case *semantic.Tuple:
+		return o.tuple(e)
+	case *semantic.Matrix:
+		return o.matrix(e)
+	case *semantic.BigOp:
+		return o.bigOp(e)
+	case *semantic.Differential:
+		return elem("apply", leaf("ci", "d"), o.expr(e.Var))
+	case *semantic.Opaque:
+		if t, ok := e.Node.(*ast.Text); ok {
+			return leaf("cs", t.Value)
+		}
+		return "<cerror/>"
+	}
+	panic(fmt.Sprintf("unexpected expression type %T", expr))
+}
+
+func (o Options) number(s string) string {
+	switch {
+	case !o.Strict:
+		return leaf("cn", s)
+	case strings.Contains(s, "."):
+		return `<cn type="real">` + s + "</cn>"
+	}
+	return `<cn type="integer">` + s + "</cn>"
+}
+
+// nary are the operators whose nested applications are flattened, as in
+// a+b+c.
+var nary = map[string]bool{
+	"plus": true, "times": true, "and": true, "or": true, "union": true, "intersect": true,
+}
+
+// infixName returns the element for the operator of e, or "" if it has none.
+func infixName(e *semantic.Infix) string {
+	if !e.Op.IsValid() {
+		return "times"
+	}
+	return elements[e.Op.Symbol.Output()]
+}
+
+func (o Options) infix(e *semantic.Infix) string {
+	name := infixName(e)
+	if l, ok := e.Left.(*semantic.Differential); ok && name == "divide" {
+		if r, ok := e.Right.(*semantic.Differential); ok {
+			// dx/dt is the derivative of x with respect to t.
+			return o.bind("diff", r.Var, o.expr(l.Var))
+		}
+	}
+	switch {
+	case name == "":
+		return elem("apply", o.operator(e.Op), o.expr(e.Left), o.expr(e.Right))
+	case !nary[name]:
+		return o.apply(name, o.expr(e.Left), o.expr(e.Right))
+	}
+	var args []string
+	var collect func(semantic.Expr)
+	collect = func(x semantic.Expr) {
+		if in, ok := x.(*semantic.Infix); ok && infixName(in) == name {
+			collect(in.Left)
+			collect(in.Right)
+		} else {
+			args = append(args, o.expr(x))
+		}
+	}
+	collect(e)
+	return o.apply(name, args...)
+}
+
+// relationChain writes a chain of the same relation as one n-ary relation,
+// and other chains as the conjunction of their links.  Strict Content MathML
+// has no n-ary relations.
+func (o Options) relationChain(e *semantic.RelationChain) string {
+	_, same := elements[e.Ops[0].Symbol.Output()]
+	for _, op := range e.Ops {
+		same = same && !o.Strict && op.Symbol.Output() == e.Ops[0].Symbol.Output()
+	}
+	if same {
+		args := []string{o.operator(e.Ops[0])}
+		for _, operand := range e.Operands {
+			args = append(args, o.expr(operand))
+		}
+		return elem("apply", args...)
+	}
+	var links []string
+	for _, link := range e.Links() {
+		links = append(links, o.infix(link))
+	}
+	return o.apply("and", links...)
+}
+
+func (o Options) call(e *semantic.Call) string {
+	name := e.Name()
+	var args []string
+	for _, arg := range e.Args {
+		args = append(args, o.expr(arg))
+	}
+	switch fn := e.Func.(type) {
+	case *semantic.Power:
+		// sin^2 x is the square of sin x.
+		if _, ok := fn.Base.(*semantic.Symbol); ok {
+			inner := &semantic.Call{Span: e.Span, Func: fn.Base, Args: e.Args}
+			return o.apply("power", o.call(inner), o.expr(fn.Exponent))
+		}
+	case *semantic.Subscript:
+		// log_2 x is the logarithm to base 2.
+		if name.Input() == "log" && len(args) == 1 {
+			if o.Strict {
+				return o.apply("log", o.expr(fn.Index), args[0])
+			}
+			return o.apply("log", elem("logbase", o.expr(fn.Index)), args[0])
+		}
+	case *semantic.Symbol:
+		if name.Symbol.AttrName() != "" {
+			return o.font(name.Symbol, e.Args[0], args[0])
+		}
+		switch name.Input() {
+		case "root":
+			if o.Strict {
+				return o.apply("root", args[1], args[0])
+			}
+			return o.apply("root", elem("degree", args[0]), args[1])
+		case "sqrt":
+			if o.Strict {
+				return o.apply("root", args[0], o.number("2"))
+			}
+			return o.apply("root", args[0])
+		case "|":
+			return o.apply("abs", args...)
+		}
+		if el, ok := elements[name.Symbol.Output()]; ok && (name.Symbol.IsFunc() || name.Type() == scanner.UNARY) {
+			return o.apply(el, args...)
+		}
+		if !name.Symbol.IsFunc() && (name.Type() == scanner.UNARY || name.Type() == scanner.BINARY) {
+			// Accents and other symbols with no meaning in Content
+			// MathML.
+			return elem("apply", append([]string{o.operator(name)}, args...)...)
+		}
+	}
+	return elem("apply", append([]string{o.expr(e.Func)}, args...)...)
+}
+
+// font writes a font symbol applied to arg, whose Content MathML is str.  A
+// letter in a font is another identifier: its ci element holds presentation
+// markup, or in Strict Content MathML the letter of the font if Unicode has
+// one.  The letters of
+// bb and bbb that name number sets are these sets.  Fonts have no meaning for
+// other expressions.
+func (o Options) font(sym *scanner.Symbol, arg semantic.Expr, str string) string {
+	s, ok := arg.(*semantic.Symbol)
+	if !ok {
+		return str
+	}
+	letter := s.Token.Symbol.Output()
+	codes := sym.Codes()
+	if len(letter) == 1 && sym.AttrValue() == "double-struck" {
+		if name, ok := constants[scanner.Lookup(letter+letter).Output()]; ok {
+			return o.symbol(name)
+		}
+	}
+	if !o.Strict {
+		return `<ci><mi mathvariant="` + sym.AttrValue() + `">` + escaper.Replace(letter) + "</mi></ci>"
+	}
+	if c := letter[0]; len(letter) == 1 && codes != nil && c >= 'A' && c <= 'Z' {
+		letter = codes[c-'A']
+	} else if len(letter) == 1 && codes != nil && c >= 'a' && c <= 'z' {
+		letter = codes[c-'a'+26]
+	}
+	return leaf("ci", letter)
+}
+
+// closures maps the brackets of intervals to their closure.
+var closures = map[string]string{
+	"()": "open", "[]": "closed", "(]": "open-closed", "[)": "closed-open",
+}
+
+// strictIntervals maps the closure of intervals to their symbol in Strict
+// Content MathML.
+var strictIntervals = map[string]string{
+	"open": "interval_oo", "closed": "interval_cc",
+	"open-closed": "interval_oc", "closed-open": "interval_co",
+}
+
+// tuple writes a tuple of two items between round or square brackets as an
+// interval, a tuple between braces as a set and other tuples as a list.
+func (o Options) tuple(e *semantic.Tuple) string {
+	var items []string
+	for _, item := range e.Items {
+		items = append(items, o.expr(item))
+	}
+	closure, interval := closures[e.Open.Input()+e.Close.Input()]
+	switch {
+	case interval && len(items) == 2 && o.Strict:
+		return elem("apply", append([]string{csymbol("interval1", strictIntervals[closure])}, items...)...)
+	case interval && len(items) == 2:
+		return `<interval closure="` + closure + `">` + strings.Join(items, "") + "</interval>"
+	case e.Open.Input() == "{" && e.Close.Input() == "}" && o.Strict:
+		return elem("apply", append([]string{csymbol("set1", "set")}, items...)...)
+	case e.Open.Input() == "{" && e.Close.Input() == "}":
+		return elem("set", items...)
+	case o.Strict:
+		return elem("apply", append([]string{csymbol("list1", "list")}, items...)...)
+	}
+	return elem("list", items...)
+}
+
+// matrix writes a matrix, or a piecewise definition for cases such as
+// {(1, x > 0), (0, x <= 0):}.
+func (o Options) matrix(e *semantic.Matrix) string {
+	cases := e.Open.Input() == "{" && e.Close.IsValid() && e.Close.Symbol.Invisible()
+	var rows []string
+	for _, row := range e.Rows {
+		var cells []string
+		for _, cell := range row {
+			cells = append(cells, o.expr(cell))
+		}
+		switch {
+		case cases && len(cells) == 2 && o.Strict:
+			rows = append(rows, elem("apply", csymbol("piece1", "piece"), cells[0], cells[1]))
+		case cases && len(cells) == 2:
+			rows = append(rows, elem("piece", cells...))
+		case cases && len(cells) == 1 && o.Strict:
+			rows = append(rows, elem("apply", csymbol("piece1", "otherwise"), cells[0]))
+		case cases && len(cells) == 1:
+			rows = append(rows, elem("otherwise", cells[0]))
+		case o.Strict:
+			rows = append(rows, elem("apply", append([]string{csymbol("linalg2", "matrixrow")}, cells...)...))
+		default:
+			rows = append(rows, elem("matrixrow", cells...))
+		}
+	}
+	switch {
+	case cases && o.Strict:
+		return elem("apply", append([]string{csymbol("piece1", "piecewise")}, rows...)...)
+	case cases:
+		return elem("piecewise", rows...)
+	case o.Strict:
+		return elem("apply", append([]string{csymbol("linalg2", "matrix")}, rows...)...)
+	}
+	return elem("matrix", rows...)
+}
+
+// bind returns the binding of v in body by the operator whose element is
+// name, with the given qualifiers: the operator applied to a bvar element,
+// the qualifiers and body, or in Strict Content MathML, to the qualifiers
+// and a lambda expression.
+func (o Options) bind(name string, v semantic.Expr, body string, qualifiers ...string) string {
+	bvar := elem("bvar", o.expr(v))
+	if o.Strict {
+		lambda := elem("bind", csymbol("fns1", "lambda"), bvar, body)
+		return o.apply(name, append(qualifiers, lambda)...)
+	}
+	return o.apply(name, append(append([]string{bvar}, qualifiers...), body)...)
+}
+
+// bigOp writes a big operator with its bound variable and limits:
+// sum_(i=1)^n binds i from 1 to n, int_0^1 f(x) dx binds x from 0 to 1 and
+// lim_(x->0) binds x as it tends to 0.  Other big operators are applied to
+// their limits and body.
+func (o Options) bigOp(e *semantic.BigOp) string {
+	name := elements[e.Op.Symbol.Output()]
+	if name == "" {
+		name = elements[e.Op.Input()]
+	}
+	body := e.Body
+	var bvar, lower semantic.Expr
+	if name == "int" {
+		body, bvar = splitDifferential(body)
+		lower = e.Lower
+	} else if in, ok := e.Lower.(*semantic.Infix); ok {
+		if op := infixName(in); op == "eq" && name != "limit" || op == "tendsto" && name == "limit" {
+			bvar, lower = in.Left, in.Right
+		}
+	}
+	var qualifiers []string
+	switch {
+	case name == "" || bvar == nil || body == nil:
+		// The operator is applied to its limits and body below.
+	case name == "limit" && o.Strict:
+		return o.bind(name, bvar, o.expr(body), o.expr(lower), csymbol("limit1", "both_sides"))
+	case name == "limit":
+		return o.bind(name, bvar, o.expr(body), elem("lowlimit", o.expr(lower)))
+	case !o.Strict:
+		if lower != nil {
+			qualifiers = append(qualifiers, elem("lowlimit", o.expr(lower)))
+		}
+		if e.Upper != nil {
+			qualifiers = append(qualifiers, elem("uplimit", o.expr(e.Upper)))
+		}
+		return o.bind(name, bvar, o.expr(body), qualifiers...)
+	case name == "int" && lower == nil && e.Upper == nil:
+		lambda := elem("bind", csymbol("fns1", "lambda"), elem("bvar", o.expr(bvar)), o.expr(body))
+		return elem("apply", csymbol("calculus1", "int"), lambda)
+	case lower != nil && e.Upper != nil && name == "int":
+		return o.bind(name, bvar, o.expr(body), elem("apply", csymbol("interval1", "interval"), o.expr(lower), o.expr(e.Upper)))
+	case lower != nil && e.Upper != nil && (name == "sum" || name == "product"):
+		return o.bind(name, bvar, o.expr(body), elem("apply", csymbol("interval1", "integer_interval"), o.expr(lower), o.expr(e.Upper)))
+	}
+	args := []string{o.operator(e.Op)}
+	for _, x := range []semantic.Expr{e.Lower, e.Upper, e.Body} {
+		if x != nil {
+			args = append(args, o.expr(x))
+		}
+	}
+	return elem("apply", args...)
+}
+
+// splitDifferential splits the body of an integral into its integrand and
+// the variable of the differential that ends it, as in f(x) dx.  The
+// variable is nil if there is no differential.
+func splitDifferential(body semantic.Expr) (semantic.Expr, semantic.Expr) {
+	switch b := body.(type) {
+	case *semantic.Differential:
+		one := scanner.Token{Symbol: scanner.Lookup("1"), Pos: b.Pos(), End: b.Pos()}
+		return &semantic.Number{Span: b.Span, Token: one}, b.Var
+	case *semantic.Infix:
+		if d, ok := b.Right.(*semantic.Differential); ok && !b.Op.IsValid() {
+			return b.Left, d.Var
+		}
+	}
+	return body, nil
+}
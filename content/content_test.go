@@ -0,0 +1,177 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   string
+		strict string
+	}{
+		{
+			name:   "precedence",
+			input:  "a+b*c",
+			want:   `<apply><plus/><ci>a</ci><apply><times/><ci>b</ci><ci>c</ci></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">plus</csymbol><ci>a</ci><apply><csymbol cd="arith1">times</csymbol><ci>b</ci><ci>c</ci></apply></apply>`,
+		},
+		{
+			name:   "n-ary operators",
+			input:  "a+b+2c",
+			want:   `<apply><plus/><ci>a</ci><ci>b</ci><apply><times/><cn>2</cn><ci>c</ci></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">plus</csymbol><ci>a</ci><ci>b</ci><apply><csymbol cd="arith1">times</csymbol><cn type="integer">2</cn><ci>c</ci></apply></apply>`,
+		},
+		{
+			name:   "fractions and signs",
+			input:  "-frac(1)(2.5)",
+			want:   `<apply><minus/><apply><divide/><cn>1</cn><cn>2.5</cn></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">unary_minus</csymbol><apply><csymbol cd="arith1">divide</csymbol><cn type="integer">1</cn><cn type="real">2.5</cn></apply></apply>`,
+		},
+		{
+			name:   "relation chain",
+			input:  "0 < x <= 1",
+			want:   `<apply><and/><apply><lt/><cn>0</cn><ci>x</ci></apply><apply><leq/><ci>x</ci><cn>1</cn></apply></apply>`,
+			strict: `<apply><csymbol cd="logic1">and</csymbol><apply><csymbol cd="relation1">lt</csymbol><cn type="integer">0</cn><ci>x</ci></apply><apply><csymbol cd="relation1">leq</csymbol><ci>x</ci><cn type="integer">1</cn></apply></apply>`,
+		},
+		{
+			name:   "n-ary relation",
+			input:  "a = b = c",
+			want:   `<apply><eq/><ci>a</ci><ci>b</ci><ci>c</ci></apply>`,
+			strict: `<apply><csymbol cd="logic1">and</csymbol><apply><csymbol cd="relation1">eq</csymbol><ci>a</ci><ci>b</ci></apply><apply><csymbol cd="relation1">eq</csymbol><ci>b</ci><ci>c</ci></apply></apply>`,
+		},
+		{
+			name:   "functions",
+			input:  "sin^2 x + f(x, y)",
+			want:   `<apply><plus/><apply><power/><apply><sin/><ci>x</ci></apply><cn>2</cn></apply><apply><ci>f</ci><ci>x</ci><ci>y</ci></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">plus</csymbol><apply><csymbol cd="arith1">power</csymbol><apply><csymbol cd="transc1">sin</csymbol><ci>x</ci></apply><cn type="integer">2</cn></apply><apply><ci>f</ci><ci>x</ci><ci>y</ci></apply></apply>`,
+		},
+		{
+			name:   "logarithm base",
+			input:  "log_2 x",
+			want:   `<apply><log/><logbase><cn>2</cn></logbase><ci>x</ci></apply>`,
+			strict: `<apply><csymbol cd="transc1">log</csymbol><cn type="integer">2</cn><ci>x</ci></apply>`,
+		},
+		{
+			name:   "roots",
+			input:  "sqrt x - root(3)(y)",
+			want:   `<apply><minus/><apply><root/><ci>x</ci></apply><apply><root/><degree><cn>3</cn></degree><ci>y</ci></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">minus</csymbol><apply><csymbol cd="arith1">root</csymbol><ci>x</ci><cn type="integer">2</cn></apply><apply><csymbol cd="arith1">root</csymbol><ci>y</ci><cn type="integer">3</cn></apply></apply>`,
+		},
+		{
+			name:   "absolute value and subscript",
+			input:  "|x_i|",
+			want:   `<apply><abs/><apply><csymbol cd="ambiguous">subscript</csymbol><ci>x</ci><ci>i</ci></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">abs</csymbol><apply><csymbol cd="ambiguous">subscript</csymbol><ci>x</ci><ci>i</ci></apply></apply>`,
+		},
+		{
+			name:   "sum",
+			input:  "sum_(i=1)^n i^2",
+			want:   `<apply><sum/><bvar><ci>i</ci></bvar><lowlimit><cn>1</cn></lowlimit><uplimit><ci>n</ci></uplimit><apply><power/><ci>i</ci><cn>2</cn></apply></apply>`,
+			strict: `<apply><csymbol cd="arith1">sum</csymbol><apply><csymbol cd="interval1">integer_interval</csymbol><cn type="integer">1</cn><ci>n</ci></apply><bind><csymbol cd="fns1">lambda</csymbol><bvar><ci>i</ci></bvar><apply><csymbol cd="arith1">power</csymbol><ci>i</ci><cn type="integer">2</cn></apply></bind></apply>`,
+		},
+		{
+			name:   "definite integral",
+			input:  "int_0^1 f(x) dx",
+			want:   `<apply><int/><bvar><ci>x</ci></bvar><lowlimit><cn>0</cn></lowlimit><uplimit><cn>1</cn></uplimit><apply><ci>f</ci><ci>x</ci></apply></apply>`,
+			strict: `<apply><csymbol cd="calculus1">defint</csymbol><apply><csymbol cd="interval1">interval</csymbol><cn type="integer">0</cn><cn type="integer">1</cn></apply><bind><csymbol cd="fns1">lambda</csymbol><bvar><ci>x</ci></bvar><apply><ci>f</ci><ci>x</ci></apply></bind></apply>`,
+		},
+		{
+			name:   "indefinite integral",
+			input:  "int x dx",
+			want:   `<apply><int/><bvar><ci>x</ci></bvar><ci>x</ci></apply>`,
+			strict: `<apply><csymbol cd="calculus1">int</csymbol><bind><csymbol cd="fns1">lambda</csymbol><bvar><ci>x</ci></bvar><ci>x</ci></bind></apply>`,
+		},
+		{
+			name:   "limit",
+			input:  "lim_(x->0) x",
+			want:   `<apply><limit/><bvar><ci>x</ci></bvar><lowlimit><cn>0</cn></lowlimit><ci>x</ci></apply>`,
+			strict: `<apply><csymbol cd="limit1">limit</csymbol><cn type="integer">0</cn><csymbol cd="limit1">both_sides</csymbol><bind><csymbol cd="fns1">lambda</csymbol><bvar><ci>x</ci></bvar><ci>x</ci></bind></apply>`,
+		},
+		{
+			name:   "derivative",
+			input:  "dx/dt",
+			want:   `<apply><diff/><bvar><ci>t</ci></bvar><ci>x</ci></apply>`,
+			strict: `<apply><csymbol cd="calculus1">diff</csymbol><bind><csymbol cd="fns1">lambda</csymbol><bvar><ci>t</ci></bvar><ci>x</ci></bind></apply>`,
+		},
+		{
+			name:   "sets and intervals",
+			input:  "x in [0, 1) uu {2}",
+			want:   `<apply><in/><ci>x</ci><apply><union/><interval closure="closed-open"><cn>0</cn><cn>1</cn></interval><cn>2</cn></apply></apply>`,
+			strict: `<apply><csymbol cd="set1">in</csymbol><ci>x</ci><apply><csymbol cd="set1">union</csymbol><apply><csymbol cd="interval1">interval_co</csymbol><cn type="integer">0</cn><cn type="integer">1</cn></apply><cn type="integer">2</cn></apply></apply>`,
+		},
+		{
+			name:   "constants",
+			input:  "pi in RR",
+			want:   `<apply><in/><pi/><reals/></apply>`,
+			strict: `<apply><csymbol cd="set1">in</csymbol><csymbol cd="nums1">pi</csymbol><csymbol cd="setname1">R</csymbol></apply>`,
+		},
+		{
+			name:   "fonts",
+			input:  "bbb Z + cc A",
+			want:   `<apply><plus/><integers/><ci><mi mathvariant="script">A</mi></ci></apply>`,
+			strict: `<apply><csymbol cd="arith1">plus</csymbol><csymbol cd="setname1">Z</csymbol><ci>𝒜</ci></apply>`,
+		},
+		{
+			name:   "matrix",
+			input:  "[[1, 0], [0, 1]]",
+			want:   `<matrix><matrixrow><cn>1</cn><cn>0</cn></matrixrow><matrixrow><cn>0</cn><cn>1</cn></matrixrow></matrix>`,
+			strict: `<apply><csymbol cd="linalg2">matrix</csymbol><apply><csymbol cd="linalg2">matrixrow</csymbol><cn type="integer">1</cn><cn type="integer">0</cn></apply><apply><csymbol cd="linalg2">matrixrow</csymbol><cn type="integer">0</cn><cn type="integer">1</cn></apply></apply>`,
+		},
+		{
+			name:   "cases",
+			input:  "{(1, x > 0), (0, x <= 0):}",
+			want:   `<piecewise><piece><cn>1</cn><apply><gt/><ci>x</ci><cn>0</cn></apply></piece><piece><cn>0</cn><apply><leq/><ci>x</ci><cn>0</cn></apply></piece></piecewise>`,
+			strict: `<apply><csymbol cd="piece1">piecewise</csymbol><apply><csymbol cd="piece1">piece</csymbol><cn type="integer">1</cn><apply><csymbol cd="relation1">gt</csymbol><ci>x</ci><cn type="integer">0</cn></apply></apply><apply><csymbol cd="piece1">piece</csymbol><cn type="integer">0</cn><apply><csymbol cd="relation1">leq</csymbol><ci>x</ci><cn type="integer">0</cn></apply></apply></apply>`,
+		},
+		{
+			name:   "unknown operators",
+			input:  "a -> b",
+			want:   `<apply><tendsto/><ci>a</ci><ci>b</ci></apply>`,
+			strict: `<apply><csymbol cd="asciimath">tendsto</csymbol><ci>a</ci><ci>b</ci></apply>`,
+		},
+		{
+			name:   "text",
+			input:  `"speed" = 3`,
+			want:   `<apply><eq/><cs>speed</cs><cn>3</cn></apply>`,
+			strict: `<apply><csymbol cd="relation1">eq</csymbol><cs>speed</cs><cn type="integer">3</cn></apply>`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node, err := parser.Parse(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := (Options{}).Node(node); err != nil || got != "<math>"+test.want+"</math>" {
+				t.Errorf("Node() = %s, %v, want %s", got, err, test.want)
+			}
+			if got, err := (Options{Strict: true}).Node(node); err != nil || got != "<math>"+test.strict+"</math>" {
+				t.Errorf("Strict Node() = %s, %v, want %s", got, err, test.strict)
+			}
+		})
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("(a+b)^2 = a^2+2ab+b^2\n= b^2+2ab+a^2\nx & <= 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<math><apply><and/><apply><eq/><apply><power/><apply><plus/><ci>a</ci><ci>b</ci></apply><cn>2</cn></apply>` +
+		`<apply><plus/><apply><power/><ci>a</ci><cn>2</cn></apply><apply><times/><cn>2</cn><ci>a</ci><ci>b</ci></apply><apply><power/><ci>b</ci><cn>2</cn></apply></apply>` +
+		`<apply><plus/><apply><power/><ci>b</ci><cn>2</cn></apply><apply><times/><cn>2</cn><ci>a</ci><ci>b</ci></apply><apply><power/><ci>a</ci><cn>2</cn></apply></apply></apply>` +
+		`<apply><leq/><ci>x</ci><cn>1</cn></apply></apply></math>`
+	if got, err := (Options{}).Node(block); err != nil || got != want {
+		t.Errorf("Node() = %s, %v, want %s", got, err, want)
+	}
+}
+
+func TestExpr_error(t *testing.T) {
+	if _, err := Expr("a +"); err == nil {
+		t.Error("Expr() returned no error")
+	}
+}
@@ -0,0 +1,473 @@
+// Package format implements canonical formatting of ASCIIMath source.
+//
+// The canonical form of an expression uses the preferred spelling of each
+// symbol (e.g. <= rather than lt=, lambda rather than lamda), puts single
+// spaces around operators and relations, and removes brackets that are not
+// needed around scripts and the operands of /.
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Source formats ASCIIMath source made of one expression per line.  Blank
+// lines are kept and trailing spaces removed.  An error is returned if any
+// expression has a syntax error.
+func Source(src []byte) ([]byte, error) {
+	var out bytes.Buffer
+	lines := strings.Split(string(src), "\n")
+	for i, line := range lines {
+		formatted, err := Expr(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err)
+		}
+		out.WriteString(formatted)
+		if i < len(lines)-1 {
+			out.WriteByte('\n')
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// Expr formats a single ASCIIMath expression.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node)
+}
+
+// Node returns the canonical ASCIIMath source for a syntax tree.  An error is
+// returned if the tree has a text containing all of ", ), ] and }, or a value
+// of color, id or class containing all of ), ] and }, which cannot be written.
+func Node(node ast.Node) (string, error) {
+	p := printer{}
+	p.node(node)
+	if p.err != nil {
+		return "", p.err
+	}
+	return p.String(), nil
+}
+
+// A printer writes canonical source for syntax trees.  Operators are not
+// surrounded with spaces in tight mode, which is used for scripts.
+type printer struct {
+	strings.Builder
+	tight bool
+	err   error // the first text or value that cannot be written
+	marks []int // the offsets of the items written, where tokens start
+
+	// keepOpen is true if the first bracket written keeps its spelling, when
+	// it follows a single-letter function which a ( would be applied to.
+	keepOpen bool
+}
+
+// sprint returns the source for node, in tight mode if tight is true.
+func (p *printer) sprint(node ast.Node, tight bool) string {
+	q := printer{tight: tight, keepOpen: p.keepOpen && p.Len() == 0}
+	q.node(node)
+	if p.err == nil {
+		p.err = q.err
+	}
+	return q.String()
+}
+
+func (p *printer) node(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.Row:
+		p.row(n)
+	case *ast.Constant:
+		p.WriteString(preferred(n.Token))
+	case *ast.Error:
+		p.WriteString(n.Token.Input())
+	case *ast.Placeholder:
+		p.WriteString("()")
+	case *ast.Text:
+		p.text(n.Value)
+	case *ast.Unary:
+		p.WriteString(preferred(n.Op))
+		p.arg(n.Arg)
+	case *ast.Style:
+		if n.Op.Symbol.AttrName() != "" {
+			p.WriteString(preferred(n.Op))
+		} else {
+			// color, id and class have the same output in AMsymbols.
+			value, ok := delimit(n.Value())
+			if !ok && p.err == nil {
+				p.err = fmt.Errorf("cannot write value %q of %s, which contains ), ] and }", n.Value(), n.Op.Input())
+			}
+			p.WriteString(n.Op.Input() + value)
+		}
+		p.arg(n.Arg)
+	case *ast.Binary:
+		p.WriteString(preferred(n.Op))
+		p.arg(n.Arg1)
+		p.arg(n.Arg2)
+	case *ast.FunctionApplication:
+		p.node(n.Func)
+		p.arg(n.Arg)
+	case *ast.Frac:
+		p.operand(n.Num)
+		p.WriteString("/")
+		p.operand(n.Den)
+	case *ast.Script:
+		p.node(n.Base)
+		if n.Sub != nil {
+			p.WriteString("_")
+			p.operand(n.Sub)
+		}
+		if n.Sup != nil {
+			p.WriteString("^")
+			p.operand(n.Sup)
+		}
+	case *ast.Fenced:
+		open := preferred(n.Open)
+		if p.keepOpen && p.Len() == 0 {
+			open, p.keepOpen = n.Open.Input(), false
+		}
+		body := p.sprint(n.Body, p.tight)
+		if def, ok := definitions[open+body+preferred(n.Close)]; ok {
+			p.WriteString(def)
+			return
+		}
+		close := ""
+		if n.Close.IsValid() {
+			close = preferred(n.Close)
+		}
+		p.WriteString(enclose(open, body, close))
+	case *ast.Matrix:
+		rows := make([]string, len(n.Rows))
+		for i, row := range n.Rows {
+			rows[i] = p.sprint(row, p.tight)
+		}
+		p.WriteString(enclose(preferred(n.Open), strings.Join(rows, p.comma()), preferred(n.Close)))
+	case *ast.MatrixRow:
+		cells := make([]string, len(n.Cells))
+		for i, cell := range n.Cells {
+			cells[i] = p.sprint(cell, p.tight)
+		}
+		p.WriteString(enclose(preferred(n.Open), strings.Join(cells, p.comma()), preferred(n.Close)))
+	case *ast.EquationArray:
+		for i, line := range n.Lines {
+			if i > 0 {
+				p.WriteByte('\n')
+			}
+			p.node(line)
+		}
+	case *ast.EquationLine:
+		if !n.Marker.IsValid() {
+			p.row(&ast.Row{Items: append(n.Left.Items[:len(n.Left.Items):len(n.Left.Items)], n.Right.Items...)})
+			return
+		}
+		if len(n.Left.Items) > 0 {
+			p.row(n.Left)
+			p.WriteByte(' ')
+		}
+		p.WriteString("& ")
+		p.row(n.Right)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+}
+
+// enclose returns body between brackets, separated from them by spaces only
+// when needed.
+func enclose(open, body, close string) string {
+	if !joinsSafely(open, body) {
+		open += " "
+	}
+	left := body
+	if body == "" {
+		left = open
+	}
+	if close != "" && !joinsSafely(left, close) {
+		close = " " + close
+	}
+	return open + body + close
+}
+
+// comma returns the separator of matrix rows and cells.
+func (p *printer) comma() string {
+	if p.tight {
+		return ","
+	}
+	return ", "
+}
+
+// row writes the items of a Row with spaces around operators.
+func (p *printer) row(n *ast.Row) {
+	for i, item := range n.Items {
+		str := p.sprint(item, p.tight)
+		if i > 0 && isLetterFunc(n.Items[i-1]) && strings.HasPrefix(str, "(") {
+			q := printer{tight: p.tight, keepOpen: true}
+			q.node(item)
+			str = q.String()
+		}
+		if i > 0 && (p.needsSpace(n.Items[:i], item, str) || !joinsSafely(p.tail(), str)) {
+			p.WriteByte(' ')
+		}
+		p.mark()
+		p.WriteString(str)
+	}
+}
+
+// needsSpace returns true if item, written as str, is separated by a space
+// from the items before it in canonical form.
+func (p *printer) needsSpace(before []ast.Node, item ast.Node, str string) bool {
+	prev := before[len(before)-1]
+	switch {
+	case p.tight:
+		return false
+	case isSpaced(item):
+		return !isComma(item)
+	case isSpaced(prev):
+		var prevPrev ast.Node
+		if len(before) > 1 {
+			prevPrev = before[len(before)-2]
+		}
+		return !isUnarySign(prevPrev, prev)
+	default:
+		return !canJoin(prev, item, str)
+	}
+}
+
+// mark records that a token starts at the end of the output.
+func (p *printer) mark() {
+	p.marks = append(p.marks, p.Len())
+}
+
+// tail returns the end of the output that a token written after it can
+// change: from the last mark at least longestInput bytes before its end, so
+// that joining items does not scan the whole output again.
+func (p *printer) tail() string {
+	s := p.String()
+	if len(p.marks) == 0 {
+		return s
+	}
+	start := p.marks[0]
+	for i := len(p.marks) - 1; i >= 0; i-- {
+		if len(s)-p.marks[i] >= longestInput {
+			start = p.marks[i]
+			break
+		}
+	}
+	return s[start:]
+}
+
+// arg writes the argument of a unary or binary symbol, separating it with a
+// space unless it starts with a bracket.
+func (p *printer) arg(node ast.Node) {
+	str := p.sprint(node, p.tight)
+	if _, ok := node.(*ast.Fenced); !ok || !joinsSafely(p.tail(), str) {
+		p.WriteByte(' ')
+	}
+	p.mark()
+	p.WriteString(str)
+}
+
+// operand writes a script or an operand of /, removing brackets around it
+// when they are not needed.
+func (p *printer) operand(node ast.Node) {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() && len(fenced.Body.Items) == 1 {
+		switch item := fenced.Body.Items[0].(type) {
+		case *ast.Constant:
+			if tag := item.Token.Symbol.Tag(); tag == "mi" || tag == "mn" {
+				node = item
+			}
+		case *ast.Text:
+			node = item
+		}
+	}
+	p.WriteString(p.sprint(node, true))
+}
+
+func (p *printer) text(value string) {
+	if !strings.Contains(value, `"`) {
+		p.WriteString(`"` + value + `"`)
+		return
+	}
+	delimited, ok := delimit(value)
+	if !ok {
+		if p.err == nil {
+			p.err = fmt.Errorf("cannot write text %q, which contains \", ), ] and }", value)
+		}
+		return
+	}
+	p.WriteString("text" + delimited)
+}
+
+// delimit returns value between the first of (), [] and {} whose closing
+// delimiter it does not contain, as it is read up to that delimiter.  It
+// returns false if value contains all of them.
+func delimit(value string) (string, bool) {
+	for _, delims := range []string{"()", "[]", "{}"} {
+		if !strings.Contains(value, delims[1:]) {
+			return delims[:1] + value + delims[1:], true
+		}
+	}
+	return "", false
+}
+
+// preferredInputs maps the input of each symbol in AMsymbols to its
+// preferred spelling: the first symbol in AMsymbols with the same tag, output
+// and type, favouring symbols written without letters, e.g. -> over rarr.
+var preferredInputs = map[string]string{}
+
+// longestInput is the length of the longest input in AMsymbols.
+var longestInput int
+
+// definitions maps the expansion of DEFINITION symbols to their input, e.g.
+// {:d x:} to dx.
+var definitions = map[string]string{}
+
+func init() {
+	type key struct {
+		tag, output string
+		ttype       int
+	}
+	first := map[key]string{}
+	for i := range scanner.AMsymbols {
+		sym := &scanner.AMsymbols[i]
+		k := key{sym.Tag(), sym.Output(), sym.Type()}
+		if cur, ok := first[k]; !ok || hasLetter(cur) && !hasLetter(sym.Input()) {
+			first[k] = sym.Input()
+		}
+	}
+	for i := range scanner.AMsymbols {
+		sym := &scanner.AMsymbols[i]
+		preferredInputs[sym.Input()] = first[key{sym.Tag(), sym.Output(), sym.Type()}]
+		if len(sym.Input()) > longestInput {
+			longestInput = len(sym.Input())
+		}
+		if sym.Type() == scanner.DEFINITION && strings.HasPrefix(sym.Output(), "{:") {
+			definitions[sym.Output()] = sym.Input()
+		}
+	}
+}
+
+func preferred(tok scanner.Token) string {
+	if input, ok := preferredInputs[tok.Input()]; ok {
+		return input
+	}
+	return tok.Input()
+}
+
+func hasLetter(s string) bool {
+	return strings.IndexFunc(s, unicode.IsLetter) >= 0
+}
+
+// spacedInputs are the operators, relations and arrows which are written
+// with spaces around them.
+var spacedInputs = map[string]bool{
+	// Not in AMsymbols
+	"+": true, "-": true, "=": true, "<": true, ">": true,
+
+	// Binary operation symbols
+	"*": true, "**": true, "***": true, "//": true, "\\\\": true,
+	"setminus": true, "xx": true, "|><": true, "><|": true, "|><|": true,
+	"-:": true, "@": true, "o+": true, "ox": true, "o.": true, "^^": true,
+	"vv": true, "nn": true, "uu": true, "+-": true, "mod": true,
+
+	// Binary relation symbols
+	"!=": true, ":=": true, "lt": true, "<=": true, "lt=": true, "gt": true,
+	">=": true, "gt=": true, "-<": true, "-lt": true, ">-": true, "-<=": true,
+	">-=": true, "in": true, "!in": true, "sub": true, "sup": true,
+	"sube": true, "supe": true, "-=": true, "~=": true, "~~": true,
+	"prop": true,
+
+	// Logical symbols
+	"and": true, "or": true, "=>": true, "if": true, "<=>": true,
+	"|--": true, "|==": true,
+
+	// Arrows
+	"uarr": true, "darr": true, "rarr": true, "->": true, ">->": true,
+	"->>": true, ">->>": true, "|->": true, "larr": true, "harr": true,
+	"rArr": true, "lArr": true, "hArr": true,
+
+	// Separators
+	",": true, "|": true,
+}
+
+func isSpaced(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && spacedInputs[c.Token.Input()]
+}
+
+func isComma(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == ","
+}
+
+// isUnarySign returns true if op is a sign in front of an operand, as in -x
+// or a = -b.
+func isUnarySign(prev, op ast.Node) bool {
+	switch op.(*ast.Constant).Token.Input() {
+	case "-", "+", "+-":
+		return prev == nil || isSpaced(prev)
+	}
+	return false
+}
+
+// canJoin returns true if two juxtaposed items are written without a space
+// between them, e.g. 2x, xy or f(x).
+func canJoin(left, right ast.Node, rightStr string) bool {
+	if _, ok := right.(*ast.Fenced); ok && !isLetter(rightStr[:1]) {
+		switch left.(type) {
+		case *ast.Constant, *ast.Fenced, *ast.Script:
+			return true
+		}
+	}
+	if c, ok := left.(*ast.Constant); ok {
+		switch c.Token.Symbol.Tag() {
+		case "mn":
+			return isLetterConst(right)
+		case "mi":
+			return isLetterConst(left) && isLetterConst(right)
+		}
+	}
+	return false
+}
+
+// isLetterFunc returns true if node is a function named with a single letter,
+// f or g, which is only applied to an argument starting with (.
+func isLetterFunc(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Symbol.IsFunc() && len(c.Token.Input()) == 1
+}
+
+// isLetterConst returns true if node is a constant made of a single ASCII
+// letter.
+func isLetterConst(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && isLetter(c.Token.Input())
+}
+
+func isLetter(s string) bool {
+	return len(s) == 1 && (s[0] >= 'a' && s[0] <= 'z' || s[0] >= 'A' && s[0] <= 'Z')
+}
+
+// joinsSafely returns true if left and right scan to the same tokens when
+// concatenated as they do separately.
+func joinsSafely(left, right string) bool {
+	s := scanner.New()
+	leftTokens, _ := s.Scan(left)
+	rightTokens, _ := s.Scan(right)
+	joined, _ := s.Scan(left + right)
+	if len(joined) != len(leftTokens)+len(rightTokens) {
+		return false
+	}
+	for i, tok := range rightTokens {
+		if joined[len(leftTokens)+i].Pos != tok.Pos+len(left) {
+			return false
+		}
+	}
+	return true
+}
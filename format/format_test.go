@@ -0,0 +1,257 @@
+package format
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+func TestExpr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "spacing",
+			input: "x ^ 2+1",
+			want:  "x^2 + 1",
+		},
+		{
+			name:  "synonyms",
+			input: "lamda lt= 2->Lamda",
+			want:  "lambda <= 2 -> Lambda",
+		},
+		{
+			name:  "implicit product",
+			input: "2 x y",
+			want:  "2xy",
+		},
+		{
+			name:  "unsafe implicit product",
+			input: "d x + c o s + x i",
+			want:  "d x + co s + x i",
+		},
+		{
+			name:  "function call",
+			input: "f (x) + g ( x , y )",
+			want:  "f(x) + g(x, y)",
+		},
+		{
+			name:  "function application",
+			input: "sin^2x + log_2 ( x ) + f x + ln sin x",
+			want:  "sin^2 x + log_2(x) + fx + ln sin x",
+		},
+		{
+			name:  "unary minus",
+			input: "-x + ( - y ) = - z",
+			want:  "-x + (-y) = -z",
+		},
+		{
+			name:  "scripts are tight",
+			input: "sum_( i = 1 )^ n i ^ ( 2 )",
+			want:  "sum_(i=1)^n i^2",
+		},
+		{
+			name:  "needed brackets",
+			input: "x^(-1) + x^(a+b) + x^((a))",
+			want:  "x^(-1) + x^(a+b) + x^((a))",
+		},
+		{
+			name:  "fraction",
+			input: "(a)/(b + 1)",
+			want:  "a/(b+1)",
+		},
+		{
+			name:  "unary and binary",
+			input: "sqrt x + sqrt(y) + root (3) (z) + frac a b",
+			want:  "sqrt x + sqrt(y) + root(3)(z) + frac a b",
+		},
+		{
+			name:  "definitions",
+			input: "int_0^1 f(x)dx divide 2",
+			want:  "int_0^1 f(x) dx -: 2",
+		},
+		{
+			name:  "text",
+			input: `text(if)x and text[say "hi"]`,
+			want:  `"if" x and text(say "hi")`,
+		},
+		{
+			name:  "brackets",
+			input: "<< a,b >> + [ 0 , 1 ) + {: x :}",
+			want:  "(:a, b:) + [0, 1) + {:x:}",
+		},
+		{
+			name:  "absolute value and divides",
+			input: "| x |+a|b",
+			want:  "|x| + a | b",
+		},
+		{
+			name:  "matrix",
+			input: "[ (a,b) ,(c , d)] + x^((1),(2))",
+			want:  "[(a, b), (c, d)] + x^((1),(2))",
+		},
+		{
+			name:  "styles",
+			input: "bb x + color [red] (y+1)+id{a}b",
+			want:  "bb x + color(red)(y + 1) + id(a) b",
+		},
+		{
+			name:  "style value with a bracket",
+			input: "color{a)b}x + id[c]y",
+			want:  "color[a)b] x + id(c) y",
+		},
+		{
+			name:    "syntax error",
+			input:   "(a",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Expr(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expr() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Expr() = %q, want %q", got, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			again, err := Expr(got)
+			if err != nil || again != got {
+				t.Errorf("Expr(%q) = %q, %v, want idempotent", got, again, err)
+			}
+		})
+	}
+}
+
+// TestExpr_roundTrip checks that formatting does not change the expression,
+// apart from the brackets it removes.
+func TestExpr_roundTrip(t *testing.T) {
+	for _, input := range []string{
+		"x ^ 2+1",
+		"lamda lt= 2->Lamda",
+		"2 x y",
+		"d x + c o s + x i",
+		"f (x) + g ( x , y )",
+		"sin^2x + log_2 ( x ) + f x + ln sin x",
+		"-x + ( - y ) = - z",
+		"sum_( i = 1 )^ n i ^ ( 2 )",
+		"x^(-1) + x^(a+b) + x^((a))",
+		"bb x + color [red] (y+1)+id{a}b",
+		"+ -^<",
+		"{ :)",
+		"( :}",
+		"f<<xx-> )",
+		"f<<x)^2 + g[:x:]",
+		"sqrt<<x>> + frac{:a:}<<b>>",
+		"a - - b + - -c",
+		"color{a)b}x + class[c]y",
+		"_x + x^2^3 + a/b/c",
+	} {
+		want, err := parser.Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", input, err)
+		}
+		formatted, err := Expr(input)
+		if err != nil {
+			t.Errorf("Expr(%q) error = %v", input, err)
+			continue
+		}
+		got, err := parser.Parse(formatted)
+		if err != nil || !(ast.EqualOptions{IgnoreGrouping: true}).Equal(got, want) {
+			t.Errorf("Expr(%q) = %q, which does not parse back to the same expression (error %v)", input, formatted, err)
+		}
+	}
+}
+
+// TestExpr_long checks that the time taken to format an expression grows
+// linearly with its length.
+func TestExpr_long(t *testing.T) {
+	for _, input := range []string{
+		strings.Repeat("xy", 1000),
+		strings.Repeat("a+", 1000) + "b",
+		"x^(" + strings.Repeat("ab", 1000) + ")",
+	} {
+		start := time.Now()
+		if _, err := Expr(input); err != nil {
+			t.Fatalf("Expr(%.10q...) error = %v", input, err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("Expr(%.10q...) took %v", input, d)
+		}
+	}
+}
+
+func TestSource(t *testing.T) {
+	src := "x+1\n\n  a lt= b  \n"
+	want := "x + 1\n\na <= b\n"
+	got, err := Source([]byte(src))
+	if err != nil {
+		t.Fatalf("Source() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Source() = %q, want %q", got, want)
+	}
+	if _, err := Source([]byte("x\nsqrt\n")); err == nil || err.Error() != `line 2: 0: missing argument for "sqrt"` {
+		t.Errorf("Source() error = %v", err)
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("(a+b)^2=(a+b)(a+b)\n  =a^2+2ab+b^2\nx&lt=y\n&:=z")
+	if err != nil {
+		t.Fatalf("ParseBlock() error = %v", err)
+	}
+	want := "(a + b)^2 = (a + b)(a + b)\n= a^2 + 2ab + b^2\nx & <= y\n& := z"
+	if got, err := Node(block); err != nil || got != want {
+		t.Errorf("Node() = %q, %v, want %q", got, err, want)
+	}
+}
+
+func TestNode_text(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "a (b)", want: `"a (b)"`},
+		{value: `say "a"`, want: `text(say "a")`},
+		{value: `"a" (b)`, want: `text["a" (b)]`},
+		{value: `"a" (b) [c]`, want: `text{"a" (b) [c]}`},
+		{value: `"a" (b) [c] {d}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		text := &ast.Text{Token: scanner.Token{Symbol: scanner.Lookup(`"`)}, Value: tt.value}
+		got, err := Node(&ast.Row{Items: []ast.Node{text}})
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Node(%q) = %q, %v, want %q", tt.value, got, err, tt.want)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		parsed, err := parser.Parse(got)
+		if err != nil || !ast.Equal(parsed, &ast.Row{Items: []ast.Node{text}}) {
+			t.Errorf("Parse(%q) = %v, %v, want the text %q", got, parsed, err, tt.value)
+		}
+	}
+}
+
+func TestNode_style(t *testing.T) {
+	color := scanner.Token{Symbol: scanner.Lookup("color")}
+	x := &ast.Constant{Token: scanner.Token{Symbol: scanner.Lookup("x")}}
+	style := &ast.Style{Op: color, Attrs: map[string]string{"mathcolor": "a) b] c}"}, Arg: x}
+	if got, err := Node(style); err == nil {
+		t.Errorf("Node() = %q, want an error", got)
+	}
+}
@@ -0,0 +1,198 @@
+package html
+
+// Stylesheet is the CSS for the HTML of this package, to be put in a style
+// element or served alongside it.  Lengths are in ems so that math follows
+// the font size of the text around it.
+const Stylesheet = `.am-math {
+  font-family: "Cambria Math", "STIX Two Math", "Latin Modern Math", "DejaVu Serif", serif;
+  font-style: normal;
+  font-weight: normal;
+  line-height: 1.2;
+  white-space: nowrap;
+  text-indent: 0;
+}
+.am-display {
+  display: block;
+  margin: 1em 0;
+  text-align: center;
+}
+.am-mi {
+  font-style: italic;
+}
+.am-text {
+  white-space: pre;
+}
+.am-word {
+  margin: 0 .5em;
+}
+.am-bin {
+  margin: 0 .2222em;
+}
+.am-rel {
+  margin: 0 .2778em;
+}
+.am-punct {
+  margin-right: .1667em;
+}
+.am-op {
+  margin-right: .1667em;
+}
+.am-op + .am-fenced {
+  margin-left: -.1667em;
+}
+.am-small .am-bin, .am-small .am-rel, .am-small .am-punct {
+  margin: 0;
+}
+.am-large {
+  font-size: 150%;
+  vertical-align: -.1em;
+}
+.am-integral {
+  font-size: 200%;
+  vertical-align: -.17em;
+}
+.am-small {
+  font-size: 70%;
+}
+
+/* Fractions are centered on the middle of the line. */
+.am-frac {
+  display: inline-block;
+  vertical-align: middle;
+  margin: 0 .12em;
+  text-align: center;
+}
+.am-frac > .am-num, .am-frac > .am-den {
+  display: block;
+  padding: 0 .1em;
+}
+.am-frac > .am-den {
+  border-top: .06em solid;
+}
+
+/* Scripts. */
+.am-sup {
+  vertical-align: .5em;
+}
+.am-sub {
+  vertical-align: -.3em;
+}
+.am-subsup {
+  display: inline-block;
+  vertical-align: -.4em;
+  text-align: left;
+}
+.am-subsup > .am-sup, .am-subsup > .am-sub {
+  display: block;
+  vertical-align: baseline;
+  line-height: 1.1;
+}
+
+/* Limits and accents are the caption of a table, so that the baseline of
+   the table is the baseline of its base. */
+.am-over, .am-under {
+  display: inline-table;
+  text-align: center;
+}
+.am-over > .am-mark {
+  display: table-caption;
+  caption-side: top;
+}
+.am-under > .am-mark {
+  display: table-caption;
+  caption-side: bottom;
+}
+.am-accent {
+  line-height: .6;
+}
+.am-obrace, .am-ubrace {
+  height: .25em;
+  border: .06em solid;
+}
+.am-obrace {
+  border-bottom: 0;
+  border-radius: .4em .4em 0 0;
+}
+.am-ubrace {
+  border-top: 0;
+  border-radius: 0 0 .4em .4em;
+}
+.am-overline {
+  display: inline-block;
+  padding-top: .08em;
+  border-top: .06em solid;
+}
+.am-underline {
+  display: inline-block;
+  padding-bottom: .08em;
+  border-bottom: .06em solid;
+}
+.am-cancel {
+  background: linear-gradient(to top right, transparent calc(50% - .04em), currentColor calc(50% - .04em), currentColor calc(50% + .04em), transparent calc(50% + .04em));
+}
+
+/* Radicals are a radical sign stretched to the height of their radicand,
+   with a border above it. */
+.am-surd {
+  display: inline-block;
+  line-height: 1;
+  transform-origin: bottom;
+}
+.am-radicand {
+  display: inline-block;
+  padding: .1em .1em 0 .05em;
+  border-top: .06em solid;
+}
+.am-index {
+  margin-right: -.6em;
+}
+
+/* Brackets are stretched around the middle of the line. */
+.am-bracket {
+  display: inline-block;
+  line-height: 1;
+  transform-origin: 50% 60%;
+}
+
+/* Matrices and equation arrays. */
+.am-table {
+  display: inline-table;
+  vertical-align: middle;
+  border-collapse: collapse;
+}
+.am-row {
+  display: table-row;
+}
+.am-cell {
+  display: table-cell;
+  padding: .1em .4em;
+  text-align: center;
+}
+.am-cases > .am-row > .am-cell, .am-table > .am-row > .am-rhs {
+  text-align: left;
+}
+.am-table > .am-row > .am-lhs {
+  text-align: right;
+}
+.am-lhs, .am-rhs {
+  padding: .1em 0;
+}
+.am-line {
+  border-left: .06em solid;
+}
+
+/* Fonts. */
+.am-bold {
+  font-weight: bold;
+}
+.am-sans-serif {
+  font-family: sans-serif;
+}
+.am-monospace {
+  font-family: monospace;
+}
+.am-bold .am-mi, .am-sans-serif .am-mi, .am-monospace .am-mi,
+.am-double-struck .am-mi, .am-script .am-mi, .am-fraktur .am-mi {
+  font-style: normal;
+}
+`
@@ -0,0 +1,563 @@
+// Package html renders syntax trees as HTML spans styled by the CSS of
+// Stylesheet, for browsers without MathML support, as the HTML output of
+// KaTeX.  Fractions are inline blocks centered on the middle of the line,
+// scripts are raised and lowered with vertical-align, limits and accents are
+// table captions above and below their base, radicals have a border above
+// their radicand, and matrices and equation arrays are inline tables.
+//
+// The height and depth of each span are estimated from the structure of the
+// tree, in ems, to stretch brackets and radical signs to the height of what
+// they enclose with a CSS transform, as the page fonts are not known.
+//
+// The letters in the argument of a font symbol are replaced with the codes
+// of its symbol if it has them, and the other fonts are classes of the
+// stylesheet.  Style nodes for colors, ids and classes become the style, id
+// and class attributes of a span.
+package html
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Options control the layout of the HTML.
+type Options struct {
+	// Inline lays out the expression in a span in the line of text, in
+	// text style with smaller fractions and the limits of sums beside
+	// them, rather than as a displayed block.
+	Inline bool
+}
+
+// Node returns the HTML for a syntax tree with the default options.
+func Node(node ast.Node) string {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its HTML with the default
+// options.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the HTML for a syntax tree.
+func (o Options) Node(node ast.Node) string {
+	class := "am-math am-display"
+	if o.Inline {
+		class = "am-math am-inline"
+	}
+	s := context{size: 1, display: !o.Inline}.layout(node)
+	return `<span class="` + class + `">` + s.html + "</span>"
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// A span is HTML with its estimated height and depth, in ems of the font
+// size of its parent.
+type span struct {
+	html          string
+	height, depth float64
+}
+
+// Estimated lengths in ems.
+const (
+	axis   = 0.25 // the middle of the line, where fractions are centered
+	height = 0.7  // of characters
+	depth  = 0.2
+)
+
+func elem(class string, s span) span {
+	s.html = `<span class="` + class + `">` + s.html + "</span>"
+	return s
+}
+
+func leaf(class, text string) span {
+	return span{`<span class="` + class + `">` + escaper.Replace(text) + "</span>", height, depth}
+}
+
+// cat puts spans side by side.
+func cat(spans ...span) span {
+	var s span
+	for _, sp := range spans {
+		s.html += sp.html
+		s.height = math.Max(s.height, sp.height)
+		s.depth = math.Max(s.depth, sp.depth)
+	}
+	return s
+}
+
+// scaled returns the span of a child in a smaller font, by ratio.
+func (s span) scaled(ratio float64) span {
+	s.height *= ratio
+	s.depth *= ratio
+	return s
+}
+
+// A context holds the style in which a node is laid out.
+type context struct {
+	size    float64 // relative to the font size of the expression
+	display bool
+	codes   []string // the letters of a mathvariant, or nil
+}
+
+// smaller returns the context of scripts, with the class and the ratio of
+// their font size.
+func (c context) smaller() (context, string, float64) {
+	c.display = false
+	if c.size*0.7 < 0.5 {
+		return c, "", 1
+	}
+	c.size *= 0.7
+	return c, " am-small", 0.7
+}
+
+// script lays out a script, a limit or an index.
+func (c context) script(class string, node ast.Node) span {
+	c, small, ratio := c.smaller()
+	return elem(class+small, c.layout(operand(node))).scaled(ratio)
+}
+
+func (c context) layout(node ast.Node) span {
+	switch n := node.(type) {
+	case *ast.Row:
+		return c.row(n.Items)
+	case *ast.Constant:
+		return c.symbol(n.Token.Symbol, class(n))
+	case *ast.Error:
+		return leaf("am-mo", n.Token.Symbol.Output())
+	case *ast.Placeholder:
+		return leaf("am-mo", "□")
+	case *ast.Text:
+		return leaf("am-text", n.Value)
+	case *ast.Unary:
+		return c.unary(n)
+	case *ast.Binary:
+		return c.binary(n)
+	case *ast.Style:
+		return c.style(n)
+	case *ast.Frac:
+		return c.frac(operand(n.Num), operand(n.Den))
+	case *ast.FunctionApplication:
+		return cat(c.layout(n.Func), c.layout(n.Arg))
+	case *ast.Script:
+		return c.scripts(n)
+	case *ast.Fenced:
+		close := ""
+		if n.Close.IsValid() && !n.Close.Symbol.Invisible() {
+			close = n.Close.Symbol.Output()
+		}
+		open := ""
+		if !n.Open.Symbol.Invisible() {
+			open = n.Open.Symbol.Output()
+		}
+		return c.fenced(open, c.layout(n.Body), close)
+	case *ast.Matrix:
+		return c.matrix(n)
+	case *ast.EquationArray:
+		return c.equationArray(n)
+	}
+	panic(fmt.Sprintf("unexpected node type %T", node))
+}
+
+// Classes of operators, which give the spaces around them.
+const (
+	ord = iota
+	op
+	bin
+	rel
+	punct
+)
+
+var classes = []string{"", " am-op", " am-bin", " am-rel", " am-punct"}
+
+// binaries and relations are the outputs of the binary operators and of the
+// relations and arrows of AMsymbols.
+var binaries = map[string]bool{
+	"+": true, "-": true, "±": true, "∓": true, "⋅": true, "∗": true,
+	"⋆": true, "×": true, "÷": true, "∘": true, "⊕": true, "⊗": true,
+	"⊙": true, "∧": true, "∨": true, "∩": true, "∪": true, "\\": true,
+	"⋉": true, "⋊": true, "⋈": true,
+}
+
+var relations = map[string]bool{
+	"=": true, "<": true, ">": true, "≠": true, ":=": true, "≤": true,
+	"≥": true, "≺": true, "≻": true, "⪯": true, "⪰": true, "∈": true,
+	"∉": true, "⊂": true, "⊃": true, "⊆": true, "⊇": true, "≡": true,
+	"≅": true, "≈": true, "∝": true, "⇒": true, "⇔": true, "⊢": true,
+	"⊨": true, "↑": true, "↓": true, "→": true, "↣": true, "↠": true,
+	"⤖": true, "↦": true, "←": true, "↔": true, "⇐": true,
+}
+
+func class(n *ast.Constant) int {
+	sym := n.Token.Symbol
+	out := sym.Output()
+	switch {
+	case out == "," || out == ";":
+		return punct
+	case binaries[out]:
+		return bin
+	case relations[out]:
+		return rel
+	case sym.IsFunc() && utf8.RuneCountInString(out) > 1, sym.Type() == scanner.UNDEROVER, out == "∫", out == "∮":
+		return op
+	}
+	return ord
+}
+
+// row lays out items side by side.  Binary operators in front of their
+// operand are signs, without spaces around them.
+func (c context) row(items []ast.Node) span {
+	var spans []span
+	prev := -1
+	for _, item := range items {
+		k, ok := item.(*ast.Constant)
+		if !ok {
+			spans = append(spans, c.layout(item))
+			prev = ord
+			continue
+		}
+		cl := class(k)
+		if cl == bin && (prev == -1 || prev == bin || prev == rel || prev == punct || prev == op) {
+			cl = ord
+		}
+		spans = append(spans, c.symbol(k.Token.Symbol, cl))
+		prev = cl
+	}
+	return cat(spans...)
+}
+
+// operand returns the node inside the brackets of an argument, a script or
+// an operand of /, which are removed as in ASCIIMathML.
+func operand(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		return fenced.Body
+	}
+	return node
+}
+
+// symbol lays out a constant symbol as an operator of the given class, an
+// identifier or a number.  Large operators are enlarged in display style.
+func (c context) symbol(sym *scanner.Symbol, cl int) span {
+	out := sym.Output()
+	switch {
+	case sym.Type() == scanner.SPACE && strings.IndexFunc(out, unicode.IsLetter) >= 0:
+		return leaf("am-text am-word", out)
+	case c.display && (out == "∫" || out == "∮"):
+		return span{leaf("am-mo am-op am-integral", out).html, 1.3, 0.7}
+	case c.display && sym.Type() == scanner.UNDEROVER && utf8.RuneCountInString(out) == 1:
+		return span{leaf("am-mo am-op am-large", out).html, 1, 0.45}
+	case cl != ord:
+		if out == "-" {
+			out = "−"
+		}
+		return leaf("am-mo"+classes[cl], out)
+	case sym.Tag() == "mi" && c.codes != nil:
+		return leaf("am-mi", c.letters(out))
+	case sym.Tag() == "mi" || sym.Tag() == "mn":
+		return leaf("am-"+sym.Tag(), out)
+	}
+	if out == "-" {
+		out = "−"
+	}
+	return leaf("am-mo", out)
+}
+
+// letters replaces the letters of s with the codes of c.
+func (c context) letters(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			b.WriteString(c.codes[r-'A'])
+		case r >= 'a' && r <= 'z':
+			b.WriteString(c.codes[r-'a'+26])
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// over puts mark above base, and under below it.
+func over(base, mark span) span {
+	return span{`<span class="am-over">` + mark.html + base.html + "</span>", base.height + mark.height + mark.depth, base.depth}
+}
+
+func under(base, mark span) span {
+	return span{`<span class="am-under">` + base.html + mark.html + "</span>", base.height, base.depth + mark.height + mark.depth}
+}
+
+// accent returns the mark of an accent, which is close to its base.
+func accent(text string) span {
+	return span{leaf("am-mark am-accent", text).html, 0.2, 0}
+}
+
+func (c context) unary(n *ast.Unary) span {
+	sym := n.Op.Symbol
+	if _, ok := n.Arg.(*ast.Placeholder); ok && sym.IsFunc() {
+		return c.symbol(sym, ord)
+	}
+	arg := c.layout(operand(n.Arg))
+	switch lr := sym.RewriteLeftRight(); {
+	case sym.Input() == "sqrt":
+		return c.radical(arg)
+	case lr != [2]string{}:
+		return c.fenced(lr[0], arg, lr[1])
+	}
+	switch sym.Input() {
+	case "hat", "tilde", "dot", "ddot", "vec":
+		marks := map[string]string{"hat": "ˆ", "tilde": "˜", "dot": "˙", "ddot": "¨", "vec": "→"}
+		return over(arg, accent(marks[sym.Input()]))
+	case "overarc":
+		return over(arg, accent("⏜"))
+	case "bar":
+		arg = elem("am-overline", arg)
+		arg.height += 0.1
+		return arg
+	case "ul":
+		arg = elem("am-underline", arg)
+		arg.depth += 0.1
+		return arg
+	case "cancel":
+		return elem("am-cancel", arg)
+	case "obrace":
+		return over(arg, span{`<span class="am-mark am-obrace"></span>`, 0.3, 0})
+	case "ubrace":
+		return under(arg, span{`<span class="am-mark am-ubrace"></span>`, 0, 0.3})
+	}
+	return cat(leaf("am-mo", sym.Input()), c.fenced("(", arg, ")"))
+}
+
+// radical puts a radical sign stretched to the height of arg in front of it.
+func (c context) radical(arg span) span {
+	s := span{height: arg.height + 0.16, depth: arg.depth}
+	surd := `<span class="am-surd">√</span>`
+	if k := (s.height + s.depth) / (height + 0.3); k > 1.25 {
+		surd = `<span class="am-surd" style="transform: scaleY(` + num(k) + "); vertical-align: " + num(0.15-arg.depth) + `em">√</span>`
+	}
+	s.html = `<span class="am-sqrt">` + surd + elem("am-radicand", arg).html + "</span>"
+	return s
+}
+
+// root puts index above the start of a radical.
+func (c context) root(index ast.Node, arg span) span {
+	r := c.radical(arg)
+	idx := c.script("am-index", index)
+	// The index is raised to the middle of the radical sign.
+	shift := (r.height+r.depth)/2 - r.depth
+	idx.html = strings.Replace(idx.html, `">`, `" style="vertical-align: `+num(shift/0.7)+`em">`, 1)
+	r.html = `<span class="am-root">` + idx.html + r.html + "</span>"
+	r.height = math.Max(r.height, shift+idx.height)
+	return r
+}
+
+// frac stacks num over den, centered on the middle of the line.
+func (c context) frac(num, den ast.Node) span {
+	f, small, ratio := c, "", 1.0
+	if c.display {
+		f.display = false
+	} else {
+		f, small, ratio = c.smaller()
+	}
+	n := elem("am-num"+small, f.layout(num)).scaled(ratio)
+	d := elem("am-den"+small, f.layout(den)).scaled(ratio)
+	total := n.height + n.depth + d.height + d.depth + 0.1
+	return span{`<span class="am-frac">` + n.html + d.html + "</span>", total/2 + axis, total/2 - axis}
+}
+
+func (c context) binary(n *ast.Binary) span {
+	switch n.Op.Input() {
+	case "frac":
+		return c.frac(operand(n.Arg1), operand(n.Arg2))
+	case "root":
+		return c.root(n.Arg1, c.layout(operand(n.Arg2)))
+	case "stackrel", "overset":
+		return over(c.layout(operand(n.Arg2)), c.script("am-mark", n.Arg1))
+	case "underset":
+		return under(c.layout(operand(n.Arg2)), c.script("am-mark", n.Arg1))
+	}
+	a1, a2 := c.layout(operand(n.Arg1)), c.layout(operand(n.Arg2))
+	return cat(leaf("am-mo", n.Op.Input()), c.fenced("(", a1, ")"), c.fenced("(", a2, ")"))
+}
+
+// style puts the argument of n in a span with the class of a font, or the
+// style, id or class attribute of n.
+func (c context) style(n *ast.Style) span {
+	attr := ast.StyleAttr(n.Op)
+	if attr == "mathvariant" {
+		c.codes = n.Op.Symbol.Codes()
+		return elem("am-"+n.Value(), c.layout(operand(n.Arg)))
+	}
+	s := c.layout(operand(n.Arg))
+	value := escaper.Replace(n.Value())
+	switch attr {
+	case "mathcolor":
+		s.html = `<span style="color: ` + value + `">` + s.html + "</span>"
+	case "id", "class":
+		s.html = "<span " + attr + `="` + value + `">` + s.html + "</span>"
+	}
+	return s
+}
+
+func (c context) scripts(n *ast.Script) span {
+	base := c.layout(n.Base)
+	if n.UnderOver(c.display) {
+		if n.Sub != nil {
+			base = under(base, c.script("am-mark", n.Sub))
+		}
+		if n.Sup != nil {
+			base = over(base, c.script("am-mark", n.Sup))
+		}
+		return base
+	}
+	switch {
+	case n.Sub != nil && n.Sup != nil:
+		s, small, ratio := c.smaller()
+		sup, sub := s.layout(operand(n.Sup)), s.layout(operand(n.Sub))
+		scripts := span{
+			html:   `<span class="am-subsup` + small + `">` + elem("am-sup", sup).html + elem("am-sub", sub).html + "</span>",
+			height: (1.1 - 0.4 + sup.height) * ratio,
+			depth:  (0.4 + sub.depth) * ratio,
+		}
+		return cat(base, scripts)
+	case n.Sup != nil:
+		sup := c.script("am-sup", n.Sup)
+		// Superscripts are raised above tall bases.
+		if shift := base.height - 0.3; base.height > height+0.1 {
+			sup.html = strings.Replace(sup.html, `">`, `" style="vertical-align: `+num(shift/0.7)+`em">`, 1)
+			sup.height += shift
+		} else {
+			sup.height += 0.35
+		}
+		return cat(base, sup)
+	}
+	sub := c.script("am-sub", n.Sub)
+	sub.height -= 0.21
+	sub.depth += 0.21
+	return cat(base, sub)
+}
+
+// substitutes are the brackets written with another character, as the
+// CJK angle brackets are wide.
+var substitutes = map[string]string{
+	"\u2329": "⟨", "\u232A": "⟩",
+}
+
+// fenced puts body between brackets stretched to its height.  An empty
+// bracket is omitted.
+func (c context) fenced(open string, body span, close string) span {
+	s := body
+	s.html = ""
+	for i, b := range []string{open, close} {
+		if i == 1 {
+			s.html += body.html
+		}
+		if b == "" {
+			continue
+		}
+		if sub, ok := substitutes[b]; ok {
+			b = sub
+		}
+		half := math.Max(body.height-axis, body.depth+axis)
+		if k := 2 * half / (height + depth); k > 1.1 {
+			s.html += `<span class="am-bracket" style="transform: scaleY(` + num(k) + `)">` + escaper.Replace(b) + "</span>"
+		} else {
+			s.html += `<span class="am-bracket">` + escaper.Replace(b) + "</span>"
+		}
+	}
+	s.html = `<span class="am-fenced">` + s.html + "</span>"
+	return s
+}
+
+// table stacks rows of cells in an inline table centered on the middle of
+// the line.
+func table(class string, rows [][]span, cellClasses [][]string) span {
+	var b strings.Builder
+	b.WriteString(`<span class="am-table` + class + `">`)
+	total := 0.0
+	for i, row := range rows {
+		b.WriteString(`<span class="am-row">`)
+		h, d := height, depth
+		for j, cell := range row {
+			b.WriteString(`<span class="am-cell` + cellClasses[i][j] + `">` + cell.html + "</span>")
+			h, d = math.Max(h, cell.height), math.Max(d, cell.depth)
+		}
+		b.WriteString("</span>")
+		total += h + d + 0.2
+	}
+	b.WriteString("</span>")
+	return span{b.String(), total/2 + axis, total/2 - axis}
+}
+
+// matrix lays out the cells of a matrix in a table between brackets.  As in
+// ASCIIMathML, a cell made of a single "|" draws a line between the columns
+// around it.
+func (c context) matrix(n *ast.Matrix) span {
+	m := c
+	m.display = false
+	var rows [][]span
+	var classes [][]string
+	for _, r := range n.Rows {
+		var cells []span
+		var cl []string
+		line := false
+		for j, cell := range r.Cells {
+			if j > 0 && j < len(r.Cells)-1 && isColumnLine(cell) {
+				line = true
+				continue
+			}
+			cells = append(cells, m.layout(cell))
+			if line {
+				cl = append(cl, " am-line")
+			} else {
+				cl = append(cl, "")
+			}
+			line = false
+		}
+		rows = append(rows, cells)
+		classes = append(classes, cl)
+	}
+	class := ""
+	close := ""
+	if n.Close.Symbol.Invisible() {
+		class = " am-cases"
+	} else {
+		close = n.Close.Symbol.Output()
+	}
+	return c.fenced(n.Open.Symbol.Output(), table(class, rows, classes), close)
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	k, ok := cell.Items[0].(*ast.Constant)
+	return ok && k.Token.Type() == scanner.LEFTRIGHT
+}
+
+// equationArray lays out the lines of n in a table of two columns, split at
+// their alignment point.
+func (c context) equationArray(n *ast.EquationArray) span {
+	var rows [][]span
+	var classes [][]string
+	for _, line := range n.Lines {
+		rows = append(rows, []span{c.row(line.Left.Items), c.row(line.Right.Items)})
+		classes = append(classes, []string{" am-lhs", " am-rhs"})
+	}
+	return table("", rows, classes)
+}
+
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,132 @@
+package html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestExpr(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "spacing",
+			input: "-a + b = c, d",
+			want:  `<span class="am-mo">−</span><span class="am-mi">a</span><span class="am-mo am-bin">+</span><span class="am-mi">b</span><span class="am-mo am-rel">=</span><span class="am-mi">c</span><span class="am-mo am-punct">,</span><span class="am-mi">d</span>`,
+		},
+		{
+			name:  "fraction",
+			input: "frac(1)(2)",
+			want:  `<span class="am-frac"><span class="am-num"><span class="am-mn">1</span></span><span class="am-den"><span class="am-mn">2</span></span></span>`,
+		},
+		{
+			name:  "nested fraction",
+			input: "1/(1/x)",
+			want:  `<span class="am-frac"><span class="am-num"><span class="am-mn">1</span></span><span class="am-den"><span class="am-frac"><span class="am-num am-small"><span class="am-mn">1</span></span><span class="am-den am-small"><span class="am-mi">x</span></span></span></span></span>`,
+		},
+		{
+			name:  "scripts",
+			input: "x_i^2 + e^x",
+			want:  `<span class="am-mi">x</span><span class="am-subsup am-small"><span class="am-sup"><span class="am-mn">2</span></span><span class="am-sub"><span class="am-mi">i</span></span></span><span class="am-mo am-bin">+</span><span class="am-mi">e</span><span class="am-sup am-small"><span class="am-mi">x</span></span>`,
+		},
+		{
+			name:  "raised superscript",
+			input: "(a/b)^2",
+			want:  `<span class="am-sup am-small" style="vertical-align: 1.29em"><span class="am-mn">2</span></span>`,
+		},
+		{
+			name:  "limits",
+			input: "sum_(i=1)^n i",
+			want:  `<span class="am-over"><span class="am-mark am-small"><span class="am-mi">n</span></span><span class="am-under"><span class="am-mo am-op am-large">∑</span><span class="am-mark am-small"><span class="am-mi">i</span><span class="am-mo am-rel">=</span><span class="am-mn">1</span></span></span></span><span class="am-mi">i</span>`,
+		},
+		{
+			name:  "radicals",
+			input: "sqrt x + root(3)(x)",
+			want:  `<span class="am-sqrt"><span class="am-surd">√</span><span class="am-radicand"><span class="am-mi">x</span></span></span><span class="am-mo am-bin">+</span><span class="am-root"><span class="am-index am-small" style="vertical-align: 0.47em"><span class="am-mn">3</span></span><span class="am-sqrt"><span class="am-surd">√</span><span class="am-radicand"><span class="am-mi">x</span></span></span></span>`,
+		},
+		{
+			name:  "stretched radical",
+			input: "sqrt(a/b)",
+			want:  `<span class="am-surd" style="transform: scaleY(2.06); vertical-align: -0.55em">√</span>`,
+		},
+		{
+			name:  "brackets",
+			input: "(x) + (a/b)",
+			want:  `<span class="am-fenced"><span class="am-bracket">(</span><span class="am-mi">x</span><span class="am-bracket">)</span></span><span class="am-mo am-bin">+</span><span class="am-fenced"><span class="am-bracket" style="transform: scaleY(2.11)">(</span><span class="am-frac"><span class="am-num"><span class="am-mi">a</span></span><span class="am-den"><span class="am-mi">b</span></span></span><span class="am-bracket" style="transform: scaleY(2.11)">)</span></span>`,
+		},
+		{
+			name:  "angle brackets",
+			input: "(: x :)",
+			want:  `<span class="am-fenced"><span class="am-bracket">⟨</span><span class="am-mi">x</span><span class="am-bracket">⟩</span></span>`,
+		},
+		{
+			name:  "matrix",
+			input: "[[1,|,2]]",
+			want:  `<span class="am-fenced"><span class="am-bracket" style="transform: scaleY(1.22)">[</span><span class="am-table"><span class="am-row"><span class="am-cell"><span class="am-mn">1</span></span><span class="am-cell am-line"><span class="am-mn">2</span></span></span></span><span class="am-bracket" style="transform: scaleY(1.22)">]</span></span>`,
+		},
+		{
+			name:  "cases",
+			input: "{(1, if x > 0):}",
+			want:  `<span class="am-table am-cases">`,
+		},
+		{
+			name:  "accents",
+			input: "hat x + bar x + ubrace(x)_n",
+			want:  `<span class="am-over"><span class="am-mark am-accent">ˆ</span><span class="am-mi">x</span></span><span class="am-mo am-bin">+</span><span class="am-overline"><span class="am-mi">x</span></span><span class="am-mo am-bin">+</span><span class="am-under"><span class="am-under"><span class="am-mi">x</span><span class="am-mark am-ubrace"></span></span><span class="am-mark am-small"><span class="am-mi">n</span></span></span>`,
+		},
+		{
+			name:  "styles",
+			input: `bbb R + bb x + color(red)(x) + class(a)(x) + "text"`,
+			want:  `<span class="am-double-struck"><span class="am-mi">ℝ</span></span><span class="am-mo am-bin">+</span><span class="am-bold"><span class="am-mi">x</span></span><span class="am-mo am-bin">+</span><span style="color: red"><span class="am-mi">x</span></span><span class="am-mo am-bin">+</span><span class="a"><span class="am-mi">x</span></span><span class="am-mo am-bin">+</span><span class="am-text">text</span>`,
+		},
+		{
+			name:  "functions",
+			input: "sin x + f(x)",
+			want:  `<span class="am-mo am-op">sin</span><span class="am-mi">x</span><span class="am-mo am-bin">+</span><span class="am-mi">f</span><span class="am-fenced">`,
+		},
+		{
+			name:  "escaping",
+			input: "a < b",
+			want:  `<span class="am-mo am-rel">&lt;</span>`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Expr(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(got, test.want) {
+				t.Errorf("got %s, want it to contain %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestOptions_Node(t *testing.T) {
+	node, err := parser.Parse("sum_(i=1)^n 1/i")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Options{Inline: true}.Node(node)
+	want := `<span class="am-math am-inline"><span class="am-mo am-op">∑</span><span class="am-subsup am-small"><span class="am-sup"><span class="am-mi">n</span></span><span class="am-sub"><span class="am-mi">i</span><span class="am-mo am-rel">=</span><span class="am-mn">1</span></span></span><span class="am-frac"><span class="am-num am-small"><span class="am-mn">1</span></span><span class="am-den am-small"><span class="am-mi">i</span></span></span></span>`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	node, err := parser.ParseBlock("f(x) = x + 1\n= 1 + x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Node(node)
+	want := `<span class="am-math am-display"><span class="am-table"><span class="am-row"><span class="am-cell am-lhs"><span class="am-mi">f</span><span class="am-fenced"><span class="am-bracket">(</span><span class="am-mi">x</span><span class="am-bracket">)</span></span></span><span class="am-cell am-rhs"><span class="am-mo am-rel">=</span><span class="am-mi">x</span><span class="am-mo am-bin">+</span><span class="am-mn">1</span></span></span><span class="am-row"><span class="am-cell am-lhs"></span><span class="am-cell am-rhs"><span class="am-mo am-rel">=</span><span class="am-mn">1</span><span class="am-mo am-bin">+</span><span class="am-mi">x</span></span></span></span></span>`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
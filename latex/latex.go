@@ -0,0 +1,371 @@
+// Package latex renders syntax trees as LaTeX math, as AMTparseAMtoTeX does
+// in ASCIIMathML.
+//
+// Symbols are written with their tex name if they have one, e.g. \le for <=,
+// and with their input name otherwise, e.g. \alpha, unless they are marked
+// notexcopy.  Brackets become \left and \right pairs, fractions \frac, roots
+// \sqrt[n]{...}, matrices pmatrix, bmatrix or vmatrix environments, or an
+// array when they have column lines or an invisible bracket, and text
+// \text{...}.
+//
+// Style nodes use \mathbf, \mathbb, \mathcal and the other font commands,
+// \color for color, and the \htmlId and \htmlClass commands of MathJax and
+// KaTeX for id and class.  EquationArray nodes become an align* environment.
+package latex
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Expr parses an ASCIIMath expression and returns its LaTeX.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the LaTeX for a syntax tree.
+func Node(node ast.Node) string {
+	var p printer
+	p.node(node)
+	return p.String()
+}
+
+// A printer writes LaTeX for syntax trees, separating control words from the
+// letters that follow them.
+type printer struct {
+	strings.Builder
+}
+
+func (p *printer) write(s string) {
+	if s == "" {
+		return
+	}
+	if c := s[0]; (c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') && endsWithControlWord(p.String()) {
+		p.WriteByte(' ')
+	}
+	p.WriteString(s)
+}
+
+func endsWithControlWord(s string) bool {
+	i := len(s)
+	for i > 0 && (s[i-1] >= 'a' && s[i-1] <= 'z' || s[i-1] >= 'A' && s[i-1] <= 'Z') {
+		i--
+	}
+	return i < len(s) && i > 0 && s[i-1] == '\\'
+}
+
+func (p *printer) node(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.Row:
+		for _, item := range n.Items {
+			p.node(item)
+		}
+	case *ast.Constant:
+		p.write(symbol(n.Token.Symbol))
+	case *ast.Error:
+		if isBracket(n.Token) {
+			p.write(delimiter(n.Token.Symbol))
+		} else {
+			p.write(symbol(n.Token.Symbol))
+		}
+	case *ast.Placeholder:
+		p.write("{}")
+	case *ast.Text:
+		p.write(`\text{` + textEscaper.Replace(n.Value) + "}")
+	case *ast.Unary:
+		p.unary(n)
+	case *ast.Binary:
+		p.binary(n)
+	case *ast.Style:
+		p.style(n)
+	case *ast.Frac:
+		p.write(`\frac`)
+		p.arg(n.Num)
+		p.arg(n.Den)
+	case *ast.FunctionApplication:
+		p.node(n.Func)
+		p.node(n.Arg)
+	case *ast.Script:
+		p.script(n)
+	case *ast.Fenced:
+		p.fenced(n)
+	case *ast.Matrix:
+		p.matrix(n)
+	case *ast.EquationArray:
+		p.write(`\begin{align*}`)
+		for i, line := range n.Lines {
+			if i > 0 {
+				p.write(` \\ `)
+			}
+			p.node(line.Left)
+			p.write("&")
+			p.node(line.Right)
+		}
+		p.write(`\end{align*}`)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+}
+
+// arg writes an argument, a script or an operand of / in braces, removing
+// the brackets around it as ASCIIMathML does.
+func (p *printer) arg(node ast.Node) {
+	p.write("{")
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		node = fenced.Body
+	}
+	if _, ok := node.(*ast.Placeholder); !ok {
+		p.node(node)
+	}
+	p.write("}")
+}
+
+func (p *printer) unary(n *ast.Unary) {
+	sym := n.Op.Symbol
+	if lr := sym.RewriteLeftRight(); lr != [2]string{} {
+		p.write(`\left` + delimiters[lr[0]])
+		if fenced, ok := n.Arg.(*ast.Fenced); ok && fenced.Removable() {
+			p.node(fenced.Body)
+		} else if _, ok := n.Arg.(*ast.Placeholder); !ok {
+			p.node(n.Arg)
+		}
+		p.write(`\right` + delimiters[lr[1]])
+		return
+	}
+	p.write(symbol(sym))
+	p.arg(n.Arg)
+}
+
+func (p *printer) binary(n *ast.Binary) {
+	if n.Op.Input() == "root" {
+		p.write(`\sqrt[`)
+		if fenced, ok := n.Arg1.(*ast.Fenced); ok && fenced.Removable() {
+			p.node(fenced.Body)
+		} else if _, ok := n.Arg1.(*ast.Placeholder); !ok {
+			p.node(n.Arg1)
+		}
+		p.write("]")
+		p.arg(n.Arg2)
+		return
+	}
+	p.write(symbol(n.Op.Symbol))
+	p.arg(n.Arg1)
+	p.arg(n.Arg2)
+}
+
+// fontCommands maps the values of the mathvariant attribute to the LaTeX
+// commands for the same fonts.
+var fontCommands = map[string]string{
+	"bold":          `\mathbf`,
+	"double-struck": `\mathbb`,
+	"script":        `\mathcal`,
+	"fraktur":       `\mathfrak`,
+	"sans-serif":    `\mathsf`,
+	"monospace":     `\mathtt`,
+}
+
+func (p *printer) style(n *ast.Style) {
+	switch attr := ast.StyleAttr(n.Op); attr {
+	case "mathvariant":
+		p.write(fontCommands[n.Value()])
+		p.arg(n.Arg)
+	case "mathcolor":
+		p.write(`{\color{` + n.Value() + "}")
+		p.node(argBody(n.Arg))
+		p.write("}")
+	case "id":
+		p.write(`\htmlId{` + n.Value() + "}")
+		p.arg(n.Arg)
+	case "class":
+		p.write(`\htmlClass{` + n.Value() + "}")
+		p.arg(n.Arg)
+	}
+}
+
+// argBody returns the node inside the removable brackets of an argument.
+func argBody(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		return fenced.Body
+	}
+	return node
+}
+
+func (p *printer) script(n *ast.Script) {
+	if c, ok := n.Base.(*ast.Constant); ok && n.Limits != ast.NoLimits {
+		// Operators such as Lim need the starred \operatorname to take
+		// limits.
+		p.write(strings.Replace(symbol(c.Token.Symbol), `\operatorname{`, `\operatorname*{`, 1))
+	} else {
+		p.node(n.Base)
+	}
+	if n.Sub != nil {
+		p.write("_")
+		p.arg(n.Sub)
+	}
+	if n.Sup != nil {
+		p.write("^")
+		p.arg(n.Sup)
+	}
+}
+
+func (p *printer) fenced(n *ast.Fenced) {
+	if n.Invisible() {
+		p.node(n.Body)
+		return
+	}
+	p.write(`\left` + delimiter(n.Open.Symbol))
+	p.node(n.Body)
+	if n.Close.IsValid() {
+		p.write(`\right` + delimiter(n.Close.Symbol))
+	} else {
+		p.write(`\right.`)
+	}
+}
+
+// matrixEnvironments maps pairs of brackets to the environments of amsmath
+// for matrices between them.
+var matrixEnvironments = map[string]string{
+	"()": "pmatrix",
+	"[]": "bmatrix",
+	"||": "vmatrix",
+}
+
+func (p *printer) matrix(n *ast.Matrix) {
+	var spec strings.Builder
+	lines := false
+	for j, cell := range n.Rows[0].Cells {
+		if j > 0 && j < len(n.Rows[0].Cells)-1 && isColumnLine(cell) {
+			spec.WriteByte('|')
+			lines = true
+		} else if n.Close.Symbol.Invisible() {
+			// Cases are aligned left as in ASCIIMathML.
+			spec.WriteByte('l')
+		} else {
+			spec.WriteByte('c')
+		}
+	}
+	env := matrixEnvironments[n.Open.Symbol.Output()+n.Close.Symbol.Output()]
+	if env == "" || lines {
+		env = "array"
+		p.write(`\left` + delimiter(n.Open.Symbol))
+	}
+	p.write(`\begin{` + env + "}")
+	if env == "array" {
+		p.write("{" + spec.String() + "}")
+	}
+	for i, row := range n.Rows {
+		if i > 0 {
+			p.write(` \\ `)
+		}
+		first := true
+		for j, cell := range row.Cells {
+			if j > 0 && j < len(row.Cells)-1 && isColumnLine(cell) {
+				continue
+			}
+			if !first {
+				p.write(" & ")
+			}
+			p.node(cell)
+			first = false
+		}
+	}
+	p.write(`\end{` + env + "}")
+	if env == "array" {
+		p.write(`\right` + delimiter(n.Close.Symbol))
+	}
+}
+
+// isColumnLine returns true if cell is a single "|", which draws a line
+// between the columns around it in ASCIIMathML.
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
+
+func isBracket(tok scanner.Token) bool {
+	switch tok.Type() {
+	case scanner.LEFTBRACKET, scanner.RIGHTBRACKET, scanner.LEFTRIGHT:
+		return true
+	}
+	return false
+}
+
+// delimiters maps the output of brackets to LaTeX delimiters.
+var delimiters = map[string]string{
+	"(": "(", ")": ")", "[": "[", "]": "]", "{": `\{`, "}": `\}`,
+	"|": "|", "∥": `\|`, "〈": `\langle`, "〉": `\rangle`,
+	"⌊": `\lfloor`, "⌋": `\rfloor`, "⌈": `\lceil`, "⌉": `\rceil`,
+}
+
+// delimiter returns the LaTeX delimiter for a bracket, or "." for the
+// invisible brackets {: and :}.
+func delimiter(sym *scanner.Symbol) string {
+	if sym.Invisible() {
+		return "."
+	}
+	return delimiters[sym.Output()]
+}
+
+// texNames gives the LaTeX of symbols whose tex or input name is not a
+// LaTeX command.
+var texNames = map[string]string{
+	"lamda": `\lambda`, "Lamda": `\Lambda`, "lt": "<", "gt": ">",
+	"-lt": `\prec`, "//": "/", ":|:": "|", "\\ ": `\ `, "mod": `\bmod`,
+	"|~": `\lceil`, "~|": `\rceil`,
+	"CC": `\mathbb{C}`, "NN": `\mathbb{N}`, "QQ": `\mathbb{Q}`,
+	"RR": `\mathbb{R}`, "ZZ": `\mathbb{Z}`,
+	"and": `\text{ and }`, "or": `\text{ or }`, "if": `\text{ if }`,
+	"sech": `\operatorname{sech}`, "csch": `\operatorname{csch}`,
+	"lcm": `\operatorname{lcm}`, "lub": `\operatorname{lub}`,
+	"glb": `\operatorname{glb}`, "Lim": `\operatorname{Lim}`,
+}
+
+var mathEscaper = strings.NewReplacer(
+	"#", `\#`, "$", `\$`, "%", `\%`, "&", `\&`, "_", `\_`, "{", `\{`, "}", `\}`,
+	"~", `\sim`, "^", `\hat{}`, `\`, `\backslash`,
+)
+
+var textEscaper = strings.NewReplacer(
+	"#", `\#`, "$", `\$`, "%", `\%`, "&", `\&`, "_", `\_`, "{", `\{`, "}", `\}`,
+	"~", `\textasciitilde{}`, "^", `\textasciicircum{}`, `\`, `\textbackslash{}`,
+)
+
+// symbol returns the LaTeX for a symbol, e.g. \alpha, \le or \frac.
+func symbol(sym *scanner.Symbol) string {
+	input := sym.Input()
+	switch {
+	case texNames[input] != "":
+		return texNames[input]
+	case sym.Tex() != "":
+		return `\` + sym.Tex()
+	case sym.NoTexCopy():
+		return `\operatorname{` + sym.Output() + "}"
+	case len(input) > 1 && isWord(input):
+		if sym.IsFunc() && unicode.IsUpper(rune(input[0])) {
+			return `\operatorname{` + input + "}"
+		}
+		return `\` + input
+	}
+	return mathEscaper.Replace(input)
+}
+
+func isWord(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+package latex
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "tex names",
+			input: "a <= b -> c xx d",
+			want:  `a\le b\to c\times d`,
+		},
+		{
+			name:  "input names",
+			input: "alpha beta + lamda",
+			want:  `\alpha\beta+\lambda`,
+		},
+		{
+			name:  "scripts",
+			input: "sum_(i=1)^n i^2",
+			want:  `\sum_{i=1}^{n}i^{2}`,
+		},
+		{
+			name:  "fractions",
+			input: "frac(a)(b) + (a+b)/c",
+			want:  `\frac{a}{b}+\frac{a+b}{c}`,
+		},
+		{
+			name:  "roots",
+			input: "sqrt(x+1) + root(3)(x)",
+			want:  `\sqrt{x+1}+\sqrt[3]{x}`,
+		},
+		{
+			name:  "brackets",
+			input: "(a, b] + {x} + (: u, v :) + {: y :}",
+			want:  `\left(a,b\right]+\left\{x\right\}+\left\langle u,v\right\rangle+y`,
+		},
+		{
+			name:  "unclosed bracket",
+			input: "(a",
+			want:  `\left(a\right.`,
+		},
+		{
+			name:  "rewritten brackets",
+			input: "abs(x) + norm(v) + floor x + Abs y",
+			want:  `\left|x\right|+\left\|v\right\|+\left\lfloor x\right\rfloor+\left|y\right|`,
+		},
+		{
+			name:  "accents",
+			input: "hat x + bar(x+y) + ul z + ubrace(1+2)_3",
+			want:  `\hat{x}+\overline{x+y}+\underline{z}+\underbrace{1+2}_{3}`,
+		},
+		{
+			name:  "functions",
+			input: "sin^2 x + f(x) + Sin y + sech z",
+			want:  `\sin^{2}x+f\left(x\right)+\operatorname{Sin}y+\operatorname{sech}z`,
+		},
+		{
+			name:  "limits",
+			input: "lim_(x->0) x + Lim_(n->oo) y",
+			want:  `\lim_{x\to0}x+\operatorname*{Lim}_{n\to\infty}y`,
+		},
+		{
+			name:  "pmatrix",
+			input: "((a,b),(c,d))",
+			want:  `\begin{pmatrix}a & b \\ c & d\end{pmatrix}`,
+		},
+		{
+			name:  "column lines",
+			input: "[(a,|,b),(c,|,d)]",
+			want:  `\left[\begin{array}{c|c}a & b \\ c & d\end{array}\right]`,
+		},
+		{
+			name:  "cases",
+			input: "{(1, x > 0),(0, x <= 0):}",
+			want:  `\left\{\begin{array}{ll}1 & x>0 \\ 0 & x\le0\end{array}\right.`,
+		},
+		{
+			name:  "text",
+			input: `"a & b_c" + text(~)`,
+			want:  `\text{a \& b\_c}+\text{\textasciitilde{}}`,
+		},
+		{
+			name:  "spaces",
+			input: "a and b",
+			want:  `a\text{ and }b`,
+		},
+		{
+			name:  "fonts",
+			input: "bbb R + RR + cc(AB) + bb x",
+			want:  `\mathbb{R}+\mathbb{R}+\mathcal{AB}+\mathbf{x}`,
+		},
+		{
+			name:  "color",
+			input: "color(red)(x+1) y",
+			want:  `{\color{red}x+1}y`,
+		},
+		{
+			name:  "id and class",
+			input: "id(a)(x) class(big) y",
+			want:  `\htmlId{a}{x}\htmlClass{big}{y}`,
+		},
+		{
+			name:  "missing arguments",
+			input: "frac(a)",
+			want:  `\frac{a}{}`,
+		},
+		{
+			name:  "escaped symbols",
+			input: "50% x^2^3",
+			want:  `50\%x^{2}\hat{}3`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _ := parser.Parse(tt.input)
+			if got := Node(node); got != tt.want {
+				t.Errorf("Node() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("(a+b)^2 = a^2+2ab+b^2\nx & <= 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `\begin{align*}\left(a+b\right)^{2}&=a^{2}+2ab+b^{2} \\ x&\le1\end{align*}`
+	if got := Node(block); got != want {
+		t.Errorf("Node() = %s, want %s", got, want)
+	}
+}
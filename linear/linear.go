@@ -0,0 +1,482 @@
+// Package linear renders syntax trees as a single line of Unicode text, for
+// places that cannot show MathML such as logs, emails and chat messages.
+//
+// Symbols are written with their output, e.g. ≤, → or α, scripts with
+// superscript and subscript characters when they have one (x², aᵢ) and
+// with ^ and _ otherwise, roots with √ and fractions with the fraction slash
+// ⁄.  Brackets are added around compound operands: √(x+1), (a+b)⁄c.
+//
+// The letters and digits in the argument of a font symbol such as bb or bbb
+// are replaced with the Mathematical Alphanumeric Symbols of the font, e.g. 𝐱
+// or ℝ.  EquationArray nodes give one line per equation, padded so that the
+// lines are aligned.
+//
+// With the ASCII option, the output contains only ASCII characters: symbols
+// are written with their ASCIIMath input, e.g. <= or alpha, and fonts are
+// ignored.
+package linear
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Options control the characters used in the output.
+type Options struct {
+	// ASCII restricts the output to ASCII characters, for terminals that
+	// cannot show Unicode.
+	ASCII bool
+
+	// font is true in the argument of a font symbol, whose letters and
+	// digits are replaced with those of the font.
+	font bool
+}
+
+// Node returns the Unicode text for a syntax tree.
+func Node(node ast.Node) string {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its Unicode text.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the text for a syntax tree.
+func (o Options) Node(node ast.Node) string {
+	if block, ok := node.(*ast.EquationArray); ok {
+		return o.equationArray(block)
+	}
+	return o.sprint(node)
+}
+
+func (o Options) sprint(node ast.Node) string {
+	switch n := node.(type) {
+	case *ast.Row:
+		return o.row(n.Items)
+	case *ast.Constant:
+		return o.symbol(n.Token.Symbol)
+	case *ast.Error:
+		return o.symbol(n.Token.Symbol)
+	case *ast.Placeholder:
+		return o.placeholder()
+	case *ast.Text:
+		return n.Value
+	case *ast.Unary:
+		return o.unary(n)
+	case *ast.Binary:
+		return o.binary(n)
+	case *ast.Style:
+		return o.style(n)
+	case *ast.Frac:
+		return o.frac(n.Num, n.Den)
+	case *ast.FunctionApplication:
+		return o.row([]ast.Node{n.Func, n.Arg})
+	case *ast.Script:
+		return o.script(n)
+	case *ast.Fenced:
+		return o.fenced(n.Open, o.sprint(n.Body), n.Close)
+	case *ast.Matrix:
+		rows := make([]string, len(n.Rows))
+		for i, row := range n.Rows {
+			cells := make([]string, len(row.Cells))
+			for j, cell := range row.Cells {
+				cells[j] = o.sprint(cell)
+			}
+			rows[i] = o.fenced(row.Open, strings.Join(cells, ", "), row.Close)
+		}
+		return o.fenced(n.Open, strings.Join(rows, ", "), n.Close)
+	case *ast.EquationArray:
+		return o.equationArray(n)
+	}
+	panic(fmt.Sprintf("unexpected node type %T", node))
+}
+
+// symbol returns the text for a symbol: its output, or in ASCII mode its
+// output if it is ASCII and its input otherwise.
+func (o Options) symbol(sym *scanner.Symbol) string {
+	if o.ASCII && !isASCII(sym.Output()) {
+		if str, ok := asciiBrackets[sym.Output()]; ok {
+			return str
+		}
+		return sym.Input()
+	}
+	return sym.Output()
+}
+
+// asciiBrackets are the brackets written differently in ASCII mode.
+var asciiBrackets = map[string]string{"\u2329": "<", "\u232A": ">"}
+
+func (o Options) placeholder() string {
+	if o.ASCII {
+		return "?"
+	}
+	return "□"
+}
+
+// row joins the text of items, with spaces around operators and relations
+// and between items that would otherwise run together.
+func (o Options) row(items []ast.Node) string {
+	var b strings.Builder
+	var prev ast.Node
+	prevStr := ""
+	space := false
+	for _, item := range items {
+		str := o.sprint(item)
+		switch {
+		case str == "":
+			continue
+		case isSpaced(item) && !isUnarySign(prev, item):
+			if b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = true
+		case isComma(item):
+			space = true
+		default:
+			if space || prevStr != "" && (!o.joins(prevStr, str) || isOperator(prev) && !isFenced(item)) {
+				b.WriteByte(' ')
+			}
+			space = false
+		}
+		b.WriteString(str)
+		prev, prevStr = item, str
+	}
+	return b.String()
+}
+
+// spacedOutputs are the outputs of the operators, relations and arrows that
+// are written with spaces around them.
+var spacedOutputs = map[string]bool{
+	"+": true, "-": true, "=": true, "<": true, ">": true, "⋅": true,
+	"×": true, "÷": true, "±": true, "∘": true, "⊕": true, "⊗": true,
+	"⊙": true, "∧": true, "∨": true, "∩": true, "∪": true, "\\": true,
+	"≠": true, ":=": true, "≤": true, "≥": true, "≺": true, "≻": true,
+	"⪯": true, "⪰": true, "∈": true, "∉": true, "⊂": true, "⊃": true,
+	"⊆": true, "⊇": true, "≡": true, "≅": true, "≈": true, "∝": true,
+	"⇒": true, "⇔": true, "⊢": true, "⊨": true, "↑": true, "↓": true,
+	"→": true, "↣": true, "↠": true, "⤖": true, "↦": true, "←": true,
+	"↔": true, "⇐": true,
+}
+
+func isSpaced(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	if !ok {
+		return false
+	}
+	return c.Token.Type() == scanner.SPACE || c.Token.Type() == scanner.CONST && spacedOutputs[c.Token.Symbol.Output()]
+}
+
+func isComma(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == ","
+}
+
+// isUnarySign returns true if op is a sign in front of an operand, as in -x
+// or a = -b.
+func isUnarySign(prev, op ast.Node) bool {
+	switch op.(*ast.Constant).Token.Input() {
+	case "-", "+", "+-":
+		return prev == nil || isSpaced(prev) || isComma(prev)
+	}
+	return false
+}
+
+// isOperator returns true if node is a function or a large operator, with or
+// without scripts, which is separated from its operand, e.g. in sin² x.
+func isOperator(node ast.Node) bool {
+	if script, ok := node.(*ast.Script); ok {
+		node = script.Base
+	}
+	c, ok := node.(*ast.Constant)
+	if !ok {
+		return false
+	}
+	sym := c.Token.Symbol
+	return sym.IsFunc() && len(sym.Output()) > 1 || sym.Type() == scanner.UNDEROVER || sym.Input() == "int" || sym.Input() == "oint"
+}
+
+func isFenced(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Fenced:
+		return !n.Invisible()
+	case *ast.Matrix:
+		return true
+	}
+	return false
+}
+
+// joins returns true if right can follow left without a space, e.g. in 2x,
+// xy or f(x) but not in sin x or 2 3.  Letters and digits of a font also join,
+// as 𝕪𝟚 is not read as a name.
+func (o Options) joins(left, right string) bool {
+	last, _ := utf8.DecodeLastRuneInString(left)
+	first, _ := utf8.DecodeRuneInString(right)
+	switch {
+	case !isAlnum(last) || !isAlnum(first):
+		return true
+	case unicode.IsDigit(last) && unicode.IsLetter(first):
+		return true
+	case o.font && unicode.IsLetter(last) && unicode.IsDigit(first):
+		return true
+	}
+	return width(left) == 1 && width(right) == 1 && unicode.IsLetter(last) && unicode.IsLetter(first)
+}
+
+func isAlnum(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// width returns the number of characters in s, not counting combining marks.
+func width(s string) int {
+	n := 0
+	for _, r := range s {
+		if !unicode.Is(unicode.Mn, r) {
+			n++
+		}
+	}
+	return n
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// operand returns the node inside the brackets of an argument, a script or
+// an operand of /, which are removed as in ASCIIMathML.
+func operand(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		node = fenced.Body
+	}
+	if row, ok := node.(*ast.Row); ok && len(row.Items) == 1 {
+		node = row.Items[0]
+	}
+	return node
+}
+
+// group returns the text of an operand, in brackets unless it is a single
+// symbol or already has brackets.
+func (o Options) group(node ast.Node) string {
+	node = operand(node)
+	str := o.sprint(node)
+	switch node.(type) {
+	case *ast.Constant, *ast.Text, *ast.Placeholder, *ast.Fenced, *ast.Matrix:
+		return str
+	}
+	return "(" + str + ")"
+}
+
+func (o Options) frac(num, den ast.Node) string {
+	if o.ASCII {
+		return o.group(num) + "/" + o.group(den)
+	}
+	return o.group(num) + "⁄" + o.group(den)
+}
+
+// roots are the Unicode characters for roots of small index.
+var roots = map[string]string{"2": "√", "3": "∛", "4": "∜"}
+
+func (o Options) unary(n *ast.Unary) string {
+	sym := n.Op.Symbol
+	if _, ok := n.Arg.(*ast.Placeholder); ok && sym.IsFunc() {
+		return o.symbol(sym)
+	}
+	if o.ASCII {
+		return sym.Input() + "(" + o.sprint(operand(n.Arg)) + ")"
+	}
+	switch lr := sym.RewriteLeftRight(); {
+	case sym.Input() == "sqrt":
+		return "√" + o.group(n.Arg)
+	case lr != [2]string{}:
+		return lr[0] + o.sprint(operand(n.Arg)) + lr[1]
+	case accents[sym.Input()] != "":
+		if str := o.sprint(operand(n.Arg)); width(str) == 1 || sym.Input() == "bar" || sym.Input() == "ul" {
+			return combine(str, accents[sym.Input()])
+		}
+	case sym.Input() == "cancel":
+		return combine(o.sprint(operand(n.Arg)), "̶")
+	}
+	return sym.Input() + "(" + o.sprint(operand(n.Arg)) + ")"
+}
+
+// accents maps accents to the combining characters that draw them.  The
+// overline of bar and the underline of ul join up over several characters
+// and the others are only used on a single character.
+var accents = map[string]string{
+	"hat":   "̂",
+	"bar":   "̅",
+	"vec":   "⃗",
+	"dot":   "̇",
+	"ddot":  "̈",
+	"tilde": "̃",
+	"ul":    "̲",
+}
+
+// combine adds mark after each character of s that is not a space.
+func combine(s, mark string) string {
+	var b strings.Builder
+	for _, r := range s {
+		b.WriteRune(r)
+		if !unicode.IsSpace(r) && !unicode.Is(unicode.Mn, r) {
+			b.WriteString(mark)
+		}
+	}
+	return b.String()
+}
+
+func (o Options) binary(n *ast.Binary) string {
+	switch n.Op.Input() {
+	case "frac":
+		return o.frac(n.Arg1, n.Arg2)
+	case "root":
+		if o.ASCII {
+			break
+		}
+		index := o.sprint(operand(n.Arg1))
+		if root, ok := roots[index]; ok {
+			return root + o.group(n.Arg2)
+		}
+		if sup, ok := convert(index, superscripts); ok {
+			return sup + "√" + o.group(n.Arg2)
+		}
+	}
+	return n.Op.Input() + "(" + o.sprint(operand(n.Arg1)) + ")(" + o.sprint(operand(n.Arg2)) + ")"
+}
+
+// alphabets gives the first capital letter, small letter and digit of the
+// Mathematical Alphanumeric Symbols for the values of mathvariant.  Fonts
+// with holes in their alphabet use the codes of their symbol instead.
+var alphabets = map[string][3]rune{
+	"bold":          {0x1D400, 0x1D41A, 0x1D7CE},
+	"double-struck": {0, 0, 0x1D7D8},
+	"sans-serif":    {0x1D5A0, 0x1D5BA, 0x1D7E2},
+	"monospace":     {0x1D670, 0x1D68A, 0x1D7F6},
+}
+
+func (o Options) style(n *ast.Style) string {
+	if o.ASCII || ast.StyleAttr(n.Op) != "mathvariant" {
+		return o.sprint(operand(n.Arg))
+	}
+	o.font = true
+	str := o.sprint(operand(n.Arg))
+	codes := n.Op.Symbol.Codes()
+	alphabet := alphabets[n.Value()]
+	var b strings.Builder
+	for _, r := range str {
+		switch {
+		case r >= 'A' && r <= 'Z' && codes != nil:
+			b.WriteString(codes[r-'A'])
+		case r >= 'a' && r <= 'z' && codes != nil:
+			b.WriteString(codes[r-'a'+26])
+		case r >= 'A' && r <= 'Z' && alphabet[0] != 0:
+			b.WriteRune(alphabet[0] + r - 'A')
+		case r >= 'a' && r <= 'z' && alphabet[1] != 0:
+			b.WriteRune(alphabet[1] + r - 'a')
+		case r >= '0' && r <= '9' && alphabet[2] != 0:
+			b.WriteRune(alphabet[2] + r - '0')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var superscripts = map[rune]rune{
+	'0': '⁰', '1': '¹', '2': '²', '3': '³', '4': '⁴', '5': '⁵', '6': '⁶',
+	'7': '⁷', '8': '⁸', '9': '⁹', '+': '⁺', '-': '⁻', '=': '⁼', '(': '⁽',
+	')': '⁾', 'n': 'ⁿ', 'i': 'ⁱ',
+}
+
+var subscripts = map[rune]rune{
+	'0': '₀', '1': '₁', '2': '₂', '3': '₃', '4': '₄', '5': '₅', '6': '₆',
+	'7': '₇', '8': '₈', '9': '₉', '+': '₊', '-': '₋', '=': '₌', '(': '₍',
+	')': '₎', 'a': 'ₐ', 'e': 'ₑ', 'h': 'ₕ', 'i': 'ᵢ', 'j': 'ⱼ', 'k': 'ₖ',
+	'l': 'ₗ', 'm': 'ₘ', 'n': 'ₙ', 'o': 'ₒ', 'p': 'ₚ', 'r': 'ᵣ', 's': 'ₛ',
+	't': 'ₜ', 'u': 'ᵤ', 'v': 'ᵥ', 'x': 'ₓ',
+}
+
+// convert replaces each character of s with its entry in chars.  It returns
+// false if a character has no entry.
+func convert(s string, chars map[rune]rune) (string, bool) {
+	var b strings.Builder
+	for _, r := range s {
+		c, ok := chars[r]
+		if !ok {
+			return "", false
+		}
+		b.WriteRune(c)
+	}
+	return b.String(), s != ""
+}
+
+func (o Options) script(n *ast.Script) string {
+	str := o.sprint(n.Base)
+	if n.Sub != nil {
+		str += o.scriptText(n.Sub, "_", subscripts)
+	}
+	if n.Sup != nil {
+		str += o.scriptText(n.Sup, "^", superscripts)
+	}
+	return str
+}
+
+// scriptText returns the text of a script: script characters if there are
+// some for all of its characters, and op followed by the script otherwise.
+func (o Options) scriptText(node ast.Node, op string, chars map[rune]rune) string {
+	if !o.ASCII {
+		if _, ok := node.(*ast.Placeholder); !ok {
+			if str, ok := convert(strings.Replace(o.sprint(operand(node)), " ", "", -1), chars); ok {
+				return str
+			}
+		}
+	}
+	return op + o.group(node)
+}
+
+// fenced returns body between brackets, omitting invisible and missing ones.
+func (o Options) fenced(open scanner.Token, body string, close scanner.Token) string {
+	if !open.Symbol.Invisible() {
+		body = o.symbol(open.Symbol) + body
+	}
+	if close.IsValid() && !close.Symbol.Invisible() {
+		body += o.symbol(close.Symbol)
+	}
+	return body
+}
+
+// equationArray returns one line per equation, with the left hand sides
+// padded to the same width.
+func (o Options) equationArray(n *ast.EquationArray) string {
+	lefts := make([]string, len(n.Lines))
+	max := 0
+	for i, line := range n.Lines {
+		lefts[i] = o.sprint(line.Left)
+		if w := width(lefts[i]); w > max {
+			max = w
+		}
+	}
+	lines := make([]string, len(n.Lines))
+	for i, line := range n.Lines {
+		right := o.sprint(line.Right)
+		if right != "" {
+			right = " " + right
+		}
+		lines[i] = strings.TrimRight(strings.Repeat(" ", max-width(lefts[i]))+lefts[i]+right, " ")
+	}
+	return strings.Join(lines, "\n")
+}
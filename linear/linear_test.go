@@ -0,0 +1,131 @@
+package linear
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantASCII string
+	}{
+		{
+			name:      "operators",
+			input:     "x^2+1 <= y -> z",
+			want:      "x² + 1 ≤ y → z",
+			wantASCII: "x^2 + 1 <= y -> z",
+		},
+		{
+			name:      "signs",
+			input:     "-x + 1 = -y",
+			want:      "-x + 1 = -y",
+			wantASCII: "-x + 1 = -y",
+		},
+		{
+			name:      "juxtaposition",
+			input:     "2 x y + 2 3 + alpha beta",
+			want:      "2xy + 2 3 + αβ",
+			wantASCII: "2xy + 2 3 + alpha beta",
+		},
+		{
+			name:      "scripts",
+			input:     "sum_(i=1)^n x_i^2",
+			want:      "∑ᵢ₌₁ⁿ xᵢ²",
+			wantASCII: "sum_(i = 1)^n x_i^2",
+		},
+		{
+			name:      "scripts without characters",
+			input:     "x^-1 + e^(i pi) + y_b",
+			want:      "x⁻¹ + e^(iπ) + y_b",
+			wantASCII: "x^(-1) + e^(i pi) + y_b",
+		},
+		{
+			name:      "functions",
+			input:     "sin^2 x + log_2 x + f(x)",
+			want:      "sin² x + log₂ x + f(x)",
+			wantASCII: "sin^2 x + log_2 x + f(x)",
+		},
+		{
+			name:      "fractions",
+			input:     "frac(a)(b) + (a+b)/c",
+			want:      "a⁄b + (a + b)⁄c",
+			wantASCII: "a/b + (a + b)/c",
+		},
+		{
+			name:      "roots",
+			input:     "sqrt x + sqrt(x+1) + root(3)(x) + root(n)(y)",
+			want:      "√x + √(x + 1) + ∛x + ⁿ√y",
+			wantASCII: "sqrt(x) + sqrt(x + 1) + root(3)(x) + root(n)(y)",
+		},
+		{
+			name:      "brackets",
+			input:     "abs(x) + norm(v) + (: a, b :) + {: c :}",
+			want:      "|x| + ∥v∥ + 〈a, b〉 + c",
+			wantASCII: "abs(x) + norm(v) + <a, b> + c",
+		},
+		{
+			name:      "accents",
+			input:     "hat x + bar(AB) + vec(AB)",
+			want:      "x̂ + A̅B̅ + vec(AB)",
+			wantASCII: "hat(x) + bar(AB) + vec(AB)",
+		},
+		{
+			name:      "matrix",
+			input:     "[(a,b),(c,d)]",
+			want:      "[(a, b), (c, d)]",
+			wantASCII: "[(a, b), (c, d)]",
+		},
+		{
+			name:      "fonts",
+			input:     "bbb R + bb(x+1) + cc(AB) + fr g + RR + bbb(xy2)",
+			want:      "ℝ + 𝐱 + 𝟏 + 𝒜ℬ + 𝔤 + ℝ + 𝕩𝕪𝟚",
+			wantASCII: "R + x + 1 + AB + g + RR + xy 2",
+		},
+		{
+			name:      "color",
+			input:     "color(red)(x) + 1",
+			want:      "x + 1",
+			wantASCII: "x + 1",
+		},
+		{
+			name:      "text",
+			input:     `"area" = a and b`,
+			want:      "area = a and b",
+			wantASCII: "area = a and b",
+		},
+		{
+			name:      "missing arguments",
+			input:     "frac(a)",
+			want:      "a⁄□",
+			wantASCII: "a/?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _ := parser.Parse(tt.input)
+			if got := Node(node); got != tt.want {
+				t.Errorf("Node() = %q, want %q", got, tt.want)
+			}
+			if got := (Options{ASCII: true}).Node(node); got != tt.wantASCII {
+				t.Errorf("ASCII Node() = %q, want %q", got, tt.wantASCII)
+			}
+		})
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("(a+b)^2 = (a+b)(a+b)\n= a^2+2ab+b^2\nx & <= 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "(a + b)² = (a + b)(a + b)\n" +
+		"         = a² + 2ab + b²\n" +
+		"       x ≤ 1"
+	if got := Node(block); got != want {
+		t.Errorf("Node() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,465 @@
+// Package mathml renders syntax trees as presentation MathML.
+//
+// The output is the element tree that ASCIIMathML.js builds for the same
+// input, so that pages converted on the server look the same as pages
+// converted in the browser.  This includes the quirks of ASCIIMathML:
+// brackets around arguments, scripts and operands of / are removed, accents
+// are mover or munder elements, abs, norm, floor and ceil are written with
+// their brackets, {: and :} are not rendered, and the letters in the argument
+// of bbb, cc and fr are replaced with the characters of their alphabet.
+//
+// Style nodes are rendered as mstyle (or mrow for id and class) elements with
+// their attributes, and EquationArray nodes as an mtable with two columns
+// aligned at the alignment point.
+package mathml
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Options control the math and mstyle elements around the rendered
+// expression.  The zero value gives the output of ASCIIMathML with its
+// mathcolor option set to "".
+type Options struct {
+	// Inline removes the displaystyle="true" attribute that ASCIIMathML
+	// sets by default.
+	Inline bool
+
+	// MathColor is the mathcolor attribute of the mstyle element, e.g.
+	// "blue".  It is omitted if empty.
+	MathColor string
+
+	// Namespace adds the MathML namespace declaration to the math element.
+	Namespace bool
+}
+
+// Node returns the MathML for a syntax tree with the default options.
+func Node(node ast.Node) string {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its MathML with the
+// default options.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the MathML for a syntax tree.
+func (o Options) Node(node ast.Node) string {
+	style := &element{name: "mstyle"}
+	if o.MathColor != "" {
+		style.attr("mathcolor", o.MathColor)
+	}
+	if !o.Inline {
+		style.attr("displaystyle", "true")
+	}
+	if row, ok := node.(*ast.Row); ok {
+		style.add(items(row.Items))
+	} else {
+		style.add(render(node))
+	}
+	math := &element{name: "math"}
+	if o.Namespace {
+		math.attr("xmlns", "http://www.w3.org/1998/Math/MathML")
+	}
+	math.add(style)
+	var b strings.Builder
+	math.write(&b)
+	return b.String()
+}
+
+// An element is a node of the MathML tree.  As in the DOM, it can also be a
+// text node or a fragment, whose children are added in its place.
+type element struct {
+	name     string // "" for a fragment, "#text" for a text node
+	attrs    [][2]string
+	text     string
+	children []*element
+}
+
+func newElement(name string, children ...*element) *element {
+	e := &element{name: name}
+	for _, child := range children {
+		e.add(child)
+	}
+	return e
+}
+
+// leaf returns an element containing text, such as <mi>x</mi>.
+func leaf(name, text string) *element {
+	return &element{name: name, children: []*element{{name: "#text", text: text}}}
+}
+
+func fragment(children ...*element) *element {
+	return newElement("", children...)
+}
+
+func (e *element) attr(name, value string) {
+	e.attrs = append(e.attrs, [2]string{name, value})
+}
+
+func (e *element) add(child *element) {
+	if child.name == "" {
+		e.children = append(e.children, child.children...)
+	} else {
+		e.children = append(e.children, child)
+	}
+}
+
+// textValue returns the text of the first child of e, which is nodeValue of
+// firstChild in the DOM, or "" if there is none.
+func (e *element) textValue() string {
+	if len(e.children) == 0 || e.children[0].name != "#text" {
+		return ""
+	}
+	return e.children[0].text
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func (e *element) write(b *strings.Builder) {
+	if e.name == "#text" {
+		escaper.WriteString(b, e.text)
+		return
+	}
+	b.WriteString("<" + e.name)
+	for _, a := range e.attrs {
+		b.WriteString(" " + a[0] + `="`)
+		escaper.WriteString(b, a[1])
+		b.WriteString(`"`)
+	}
+	if len(e.children) == 0 {
+		b.WriteString("/>")
+		return
+	}
+	b.WriteString(">")
+	for _, child := range e.children {
+		child.write(b)
+	}
+	b.WriteString("</" + e.name + ">")
+}
+
+// removeBrackets removes the outer brackets of an argument, a script or an
+// operand of /, as AMremoveBrackets does.
+func removeBrackets(e *element) {
+	if e.name != "mrow" || len(e.children) == 0 {
+		return
+	}
+	switch e.children[0].textValue() {
+	case "(", "[", "{":
+		e.children = e.children[1:]
+	}
+	if len(e.children) == 0 {
+		return
+	}
+	switch e.children[len(e.children)-1].textValue() {
+	case ")", "]", "}":
+		e.children = e.children[:len(e.children)-1]
+	}
+}
+
+// items renders the items of a row as a fragment.
+func items(nodes []ast.Node) *element {
+	frag := fragment()
+	for _, node := range nodes {
+		frag.add(render(node))
+	}
+	return frag
+}
+
+// symbol renders a constant symbol.
+func symbol(tok scanner.Token) *element {
+	sym := tok.Symbol
+	switch sym.Type() {
+	case scanner.SPACE:
+		return newElement("mrow", space(), leaf(sym.Tag(), sym.Output()), space())
+	case scanner.LEFTRIGHT:
+		// A "|" that does not start an absolute value is a divides sign.
+		return newElement("mrow", leaf("mo", "∣"))
+	case scanner.INFIX:
+		return leaf("mo", sym.Output())
+	}
+	return leaf(sym.Tag(), sym.Output())
+}
+
+func space() *element {
+	e := &element{name: "mspace"}
+	e.attr("width", "1ex")
+	return e
+}
+
+// missing is the box that ASCIIMathML shows in place of a missing script or
+// operand of /.
+func missing() *element {
+	return leaf("mo", "□")
+}
+
+// arg renders an argument, a script or an operand of /.
+func arg(node ast.Node) *element {
+	if _, ok := node.(*ast.Placeholder); ok {
+		return missing()
+	}
+	e := render(node)
+	removeBrackets(e)
+	return e
+}
+
+func render(node ast.Node) *element {
+	switch n := node.(type) {
+	case *ast.Row:
+		return newElement("mrow", items(n.Items))
+	case *ast.Constant:
+		return symbol(n.Token)
+	case *ast.Error:
+		return symbol(n.Token)
+	case *ast.Placeholder:
+		return missing()
+	case *ast.Text:
+		return text(n)
+	case *ast.Unary:
+		return unary(n)
+	case *ast.Binary:
+		return binary(n)
+	case *ast.Style:
+		return style(n)
+	case *ast.Frac:
+		return newElement("mfrac", arg(n.Num), arg(n.Den))
+	case *ast.FunctionApplication:
+		return newElement("mrow", render(n.Func), render(n.Arg))
+	case *ast.Script:
+		return script(n)
+	case *ast.Fenced:
+		return fenced(n.Open, items(n.Body.Items), n.Close)
+	case *ast.Matrix:
+		return fenced(n.Open, matrix(n), n.Close)
+	case *ast.EquationArray:
+		return equationArray(n)
+	}
+	panic(fmt.Sprintf("unexpected node type %T", node))
+}
+
+func text(n *ast.Text) *element {
+	row := newElement("mrow")
+	if strings.HasPrefix(n.Value, " ") {
+		row.add(space())
+	}
+	row.add(leaf(n.Token.Symbol.Tag(), n.Value))
+	if strings.HasSuffix(n.Value, " ") {
+		row.add(space())
+	}
+	return row
+}
+
+func unary(n *ast.Unary) *element {
+	sym := n.Op.Symbol
+	var a *element
+	if _, ok := n.Arg.(*ast.Placeholder); ok {
+		if sym.Tag() == "mi" || sym.Tag() == "mo" {
+			return leaf(sym.Tag(), sym.Output())
+		}
+		a = &element{name: "mi"}
+	} else {
+		a = arg(n.Arg)
+	}
+	switch {
+	case sym.Input() == "sqrt":
+		return newElement(sym.Tag(), a)
+	case sym.RewriteLeftRight() != [2]string{}:
+		lr := sym.RewriteLeftRight()
+		return newElement("mrow", leaf("mo", lr[0]), a, leaf("mo", lr[1]))
+	case sym.Input() == "cancel":
+		e := newElement(sym.Tag(), a)
+		e.attr("notation", "updiagonalstrike")
+		return e
+	case sym.Acc():
+		acc := leaf("mo", sym.Output())
+		if sym.Input() == "vec" && singleChar(a) {
+			acc.attr("stretchy", "false")
+		}
+		return newElement(sym.Tag(), a, acc)
+	}
+	return newElement(sym.Tag(), a)
+}
+
+// singleChar returns true if the argument of vec is a single character, in
+// which case ASCIIMathML makes the arrow not stretchy.  Lengths are counted
+// in UTF-16 code units as in JavaScript.
+func singleChar(e *element) bool {
+	if e.name == "mrow" && len(e.children) == 1 {
+		e = e.children[0]
+	}
+	text := e.textValue()
+	return text != "" && len(utf16.Encode([]rune(text))) == 1
+}
+
+func binary(n *ast.Binary) *element {
+	sym := n.Op.Symbol
+	if _, ok := n.Arg1.(*ast.Placeholder); ok {
+		return leaf("mo", sym.Input())
+	}
+	if _, ok := n.Arg2.(*ast.Placeholder); ok {
+		// ASCIIMathML shows the symbol and parses its first argument again
+		// as an ordinary expression.
+		return fragment(leaf("mo", sym.Input()), render(n.Arg1))
+	}
+	a1, a2 := arg(n.Arg1), arg(n.Arg2)
+	switch {
+	case sym.Input() == "frac":
+		return newElement(sym.Tag(), a1, a2)
+	case sym.Input() == "root" || sym.Output() == "stackrel":
+		return newElement(sym.Tag(), a2, a1)
+	}
+	return newElement(sym.Tag(), a1)
+}
+
+func style(n *ast.Style) *element {
+	sym := n.Op.Symbol
+	var a *element
+	if _, ok := n.Arg.(*ast.Placeholder); !ok {
+		a = arg(n.Arg)
+	} else if sym.Type() == scanner.BINARY {
+		return leaf("mo", sym.Input())
+	} else {
+		a = &element{name: "mi"}
+	}
+	if codes := sym.Codes(); codes != nil {
+		a = replaceLetters(a, codes)
+	}
+	e := newElement(sym.Tag(), a)
+	names := make([]string, 0, len(n.Attrs))
+	for name := range n.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		e.attr(name, n.Attrs[name])
+	}
+	return e
+}
+
+// replaceLetters replaces the letters of mi elements in the argument of bbb,
+// cc or fr with codes.  As in ASCIIMathML, each mi element becomes a bare
+// text node.
+func replaceLetters(e *element, codes []string) *element {
+	replace := func(mi *element) *element {
+		var b strings.Builder
+		for _, c := range mi.textValue() {
+			switch {
+			case c >= 'A' && c <= 'Z':
+				b.WriteString(codes[c-'A'])
+			case c >= 'a' && c <= 'z':
+				b.WriteString(codes[c-'a'+26])
+			default:
+				b.WriteRune(c)
+			}
+		}
+		return &element{name: "#text", text: b.String()}
+	}
+	if e.name == "mi" {
+		return replace(e)
+	}
+	for i, child := range e.children {
+		if child.name == "mi" {
+			e.children[i] = replace(child)
+		}
+	}
+	return e
+}
+
+func script(n *ast.Script) *element {
+	base := render(n.Base)
+	underOver := n.Limits != ast.NoLimits
+	switch {
+	case n.Sub != nil && n.Sup != nil:
+		name := "msubsup"
+		if underOver {
+			name = "munderover"
+		}
+		// The mrow keeps a sum from stretching.
+		return newElement("mrow", newElement(name, base, arg(n.Sub), arg(n.Sup)))
+	case n.Sub != nil:
+		name := "msub"
+		if underOver {
+			name = "munder"
+		}
+		return newElement(name, base, arg(n.Sub))
+	}
+	name := "msup"
+	if underOver {
+		name = "mover"
+	}
+	return newElement(name, base, arg(n.Sup))
+}
+
+// fenced renders body between brackets, omitting invisible and missing ones.
+func fenced(open scanner.Token, body *element, close scanner.Token) *element {
+	row := newElement("mrow")
+	if !open.Symbol.Invisible() {
+		row.add(leaf("mo", open.Symbol.Output()))
+	}
+	row.add(body)
+	if close.IsValid() && !close.Symbol.Invisible() {
+		row.add(leaf("mo", close.Symbol.Output()))
+	}
+	return row
+}
+
+// matrix renders the mtable of a matrix.  As in ASCIIMathML, a cell made of
+// a single "|" between two commas draws a line between the columns around
+// it rather than being a cell.
+func matrix(n *ast.Matrix) *element {
+	table := newElement("mtable")
+	var lines []string
+	for i, row := range n.Rows {
+		tr := newElement("mtr")
+		for j, cell := range row.Cells {
+			if j > 0 && j < len(row.Cells)-1 && isColumnLine(cell) {
+				if i == 0 {
+					lines[len(lines)-1] = "solid"
+				}
+				continue
+			}
+			tr.add(newElement("mtd", items(cell.Items)))
+			if i == 0 {
+				lines = append(lines, "none")
+			}
+		}
+		table.add(tr)
+	}
+	table.attr("columnlines", strings.Join(lines, " "))
+	if n.Close.IsValid() && n.Close.Symbol.Invisible() {
+		table.attr("columnalign", "left")
+	}
+	return table
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
+
+func equationArray(n *ast.EquationArray) *element {
+	table := newElement("mtable")
+	table.attr("columnalign", "right left")
+	table.attr("columnspacing", "0em")
+	for _, line := range n.Lines {
+		table.add(newElement("mtr",
+			newElement("mtd", items(line.Left.Items)),
+			newElement("mtd", items(line.Right.Items))))
+	}
+	return table
+}
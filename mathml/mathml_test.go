@@ -0,0 +1,85 @@
+package mathml
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+// TestFixtures checks the output against the cases in
+// testdata/asciimathml.txt.
+func TestFixtures(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/asciimathml.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+	n := 0
+	for i := 0; i < len(lines); i++ {
+		if lines[i] == "" {
+			continue
+		}
+		if i+1 == len(lines) {
+			t.Fatalf("missing output for %q", lines[i])
+		}
+		input, want := lines[i], lines[i+1]
+		i++
+		n++
+		t.Run(input, func(t *testing.T) {
+			node, _ := parser.Parse(input)
+			if got := Node(node); got != want {
+				t.Errorf("Node() = %s\nwant %s", got, want)
+			}
+		})
+	}
+	if n == 0 {
+		t.Error("no fixtures")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{
+			name: "inline",
+			opts: Options{Inline: true},
+			want: `<math><mstyle><mi>x</mi></mstyle></math>`,
+		},
+		{
+			name: "color and namespace",
+			opts: Options{MathColor: "blue", Namespace: true},
+			want: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mstyle mathcolor="blue" displaystyle="true"><mi>x</mi></mstyle></math>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _ := parser.Parse("x")
+			if got := tt.opts.Node(node); got != tt.want {
+				t.Errorf("Node() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("x = 1\n= y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<math><mstyle displaystyle="true"><mtable columnalign="right left" columnspacing="0em">` +
+		`<mtr><mtd><mi>x</mi></mtd><mtd><mo>=</mo><mn>1</mn></mtd></mtr>` +
+		`<mtr><mtd/><mtd><mo>=</mo><mi>y</mi></mtd></mtr></mtable></mstyle></math>`
+	if got := Node(block); got != want {
+		t.Errorf("Node() = %s, want %s", got, want)
+	}
+}
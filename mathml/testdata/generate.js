@@ -0,0 +1,240 @@
+// Regenerates asciimathml.txt by running ASCIIMathML.js on its inputs.
+//
+// Usage: node generate.js path/to/ASCIIMathML.js
+//
+// The inputs and comments of asciimathml.txt are kept, the outputs are
+// replaced with those of the library and the header records its version.
+// The library is run with mathcolor set to "", the default displaystyle and
+// no title on the math elements, in a small DOM with only what it uses.
+
+"use strict";
+
+const fs = require("fs");
+const path = require("path");
+const vm = require("vm");
+
+const MATHML = "http://www.w3.org/1998/Math/MathML";
+
+class Node {
+  constructor(nodeType, nodeName, nodeValue) {
+    this.nodeType = nodeType;
+    this.nodeName = nodeName;
+    this.nodeValue = nodeValue;
+    this.childNodes = [];
+    this.parentNode = null;
+    this.attributes = [];
+  }
+  get firstChild() {
+    return this.childNodes[0] || null;
+  }
+  get lastChild() {
+    return this.childNodes[this.childNodes.length - 1] || null;
+  }
+  get nextSibling() {
+    return this.sibling(1);
+  }
+  get previousSibling() {
+    return this.sibling(-1);
+  }
+  get tagName() {
+    return this.nodeName;
+  }
+  get localName() {
+    return this.nodeName;
+  }
+  get data() {
+    return this.nodeValue;
+  }
+  get textContent() {
+    if (this.nodeType === 3) {
+      return this.nodeValue;
+    }
+    return this.childNodes.map((c) => c.textContent).join("");
+  }
+  sibling(d) {
+    if (!this.parentNode) {
+      return null;
+    }
+    const siblings = this.parentNode.childNodes;
+    return siblings[siblings.indexOf(this) + d] || null;
+  }
+  hasChildNodes() {
+    return this.childNodes.length > 0;
+  }
+  // nodes returns the nodes that inserting node adds: its children if it is
+  // a fragment.
+  nodes(node) {
+    const nodes = node.nodeType === 11 ? node.childNodes.slice() : [node];
+    for (const n of nodes) {
+      if (n.parentNode) {
+        n.parentNode.removeChild(n);
+      }
+    }
+    return nodes;
+  }
+  insertBefore(node, ref) {
+    const nodes = this.nodes(node);
+    let i = ref ? this.childNodes.indexOf(ref) : this.childNodes.length;
+    for (const n of nodes) {
+      n.parentNode = this;
+      this.childNodes.splice(i++, 0, n);
+    }
+    return node;
+  }
+  appendChild(node) {
+    return this.insertBefore(node, null);
+  }
+  removeChild(node) {
+    const i = this.childNodes.indexOf(node);
+    if (i < 0) {
+      throw new Error("removeChild: not a child");
+    }
+    this.childNodes.splice(i, 1);
+    node.parentNode = null;
+    return node;
+  }
+  replaceChild(node, old) {
+    this.insertBefore(node, old);
+    return this.removeChild(old);
+  }
+  cloneNode(deep) {
+    const clone = new Node(this.nodeType, this.nodeName, this.nodeValue);
+    clone.attributes = this.attributes.map((a) => ({ name: a.name, value: a.value }));
+    if (deep) {
+      for (const c of this.childNodes) {
+        clone.appendChild(c.cloneNode(true));
+      }
+    }
+    return clone;
+  }
+  getAttribute(name) {
+    const a = this.attributes.find((a) => a.name === name);
+    return a ? a.value : null;
+  }
+  hasAttribute(name) {
+    return this.getAttribute(name) !== null;
+  }
+  setAttribute(name, value) {
+    const a = this.attributes.find((a) => a.name === name);
+    if (a) {
+      a.value = String(value);
+    } else {
+      this.attributes.push({ name: name, value: String(value) });
+    }
+  }
+  removeAttribute(name) {
+    this.attributes = this.attributes.filter((a) => a.name !== name);
+  }
+}
+
+const document = {
+  createElementNS: (ns, name) => new Node(1, name, null),
+  createElement: (name) => new Node(1, name, null),
+  createTextNode: (text) => new Node(3, "#text", String(text)),
+  createDocumentFragment: () => new Node(11, "#document-fragment", null),
+  getElementById: () => null,
+  getElementsByTagName: () => [],
+  body: null,
+};
+
+function escape(s, quote) {
+  s = s.replace(/&/g, "&amp;").replace(/</g, "&lt;").replace(/>/g, "&gt;");
+  return quote ? s.replace(/"/g, "&quot;") : s;
+}
+
+// serialize writes a node as XML, with empty elements written <e/>.
+function serialize(node) {
+  if (node.nodeType === 3) {
+    return escape(node.nodeValue, false);
+  }
+  const children = node.childNodes.map(serialize).join("");
+  if (node.nodeType === 11) {
+    return children;
+  }
+  const attrs = node.attributes.map((a) => ` ${a.name}="${escape(a.value, true)}"`).join("");
+  if (children === "") {
+    return `<${node.nodeName}${attrs}/>`;
+  }
+  return `<${node.nodeName}${attrs}>${children}</${node.nodeName}>`;
+}
+
+// load runs the library and returns its parseMath function.
+function load(file) {
+  let source = fs.readFileSync(file, "utf8");
+  // Give access to the variables of the closure the library is wrapped in,
+  // if it is.
+  const end = source.lastIndexOf("})();");
+  if (end >= 0) {
+    source = source.slice(0, end) + "asciimath.evaluate = function (s) { return eval(s); };\n" + source.slice(end);
+  }
+  const window = { addEventListener: () => {}, attachEvent: () => {} };
+  const context = vm.createContext({
+    window: window,
+    document: document,
+    navigator: { appName: "Node", userAgent: "node" },
+    alert: (msg) => {
+      throw new Error(msg);
+    },
+    setTimeout: () => {},
+    console: console,
+  });
+  window.document = document;
+  vm.runInContext(source, context, { filename: file });
+  const evaluate = context.asciimath && context.asciimath.evaluate
+    ? context.asciimath.evaluate
+    : (s) => vm.runInContext(s, context);
+  evaluate(
+    'mathcolor = ""; displaystyle = true; showasciiformulaonhover = false;' +
+      'if (typeof initSymbols == "function") initSymbols(); else AMinitSymbols();'
+  );
+  return evaluate("parseMath");
+}
+
+// version returns the version in the header comment of the library.
+function version(file) {
+  const m = /ASCIIMathML\.js\s*[=\-]*\s*(?:\n.*?)*?\b[Vv]ersion\s+([^\n]*)/.exec(fs.readFileSync(file, "utf8"));
+  if (!m) {
+    throw new Error("no version in " + file);
+  }
+  return m[1].trim();
+}
+
+function main() {
+  if (process.argv.length !== 3) {
+    console.error("usage: node generate.js path/to/ASCIIMathML.js");
+    process.exit(2);
+  }
+  const lib = process.argv[2];
+  const parseMath = load(lib);
+  const corpus = path.join(__dirname, "asciimathml.txt");
+  const lines = fs.readFileSync(corpus, "utf8").split("\n");
+
+  // The header is the comment at the top, up to the first blank line.
+  let i = 0;
+  while (i < lines.length && lines[i].startsWith("#")) {
+    i++;
+  }
+  const out = [
+    `# MathML generated by ASCIIMathML.js version ${version(lib)} with`,
+    `# mathcolor set to "" and the default displaystyle, serialized with empty`,
+    `# elements written <e/>.  Regenerate it with testdata/generate.js when the`,
+    `# version changes.`,
+    `#`,
+    `# Each case is an input line followed by the expected output on the next`,
+    `# line.  Cases are separated by blank lines and lines starting with # are`,
+    `# comments.`,
+  ];
+  for (; i < lines.length; i++) {
+    const line = lines[i];
+    out.push(line);
+    if (line === "" || line.startsWith("#")) {
+      continue;
+    }
+    out.push(serialize(parseMath(line, false)));
+    // Skip the old output.
+    i++;
+  }
+  fs.writeFileSync(corpus, out.join("\n"));
+}
+
+main();
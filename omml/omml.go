@@ -0,0 +1,533 @@
+// Package omml renders syntax trees as Office Math Markup Language, the math
+// of Word documents, so that equations stay editable once exported to .docx.
+//
+// Fractions become m:f, scripts m:sSub, m:sSup and m:sSubSup, roots m:rad,
+// brackets m:d, matrices m:m between brackets and functions such as sin
+// m:func.  Sums, products, integrals and the other big operators become
+// m:nary elements whose body is the rest of the term that follows them, up to
+// the next operator, and lim, min and max and the braces of ubrace and
+// obrace take their limits below or above with m:limLow and m:limUpp.
+// Accents are m:acc, m:bar or m:groupChr elements.
+//
+// Style nodes set the m:scr and m:sty properties of the runs inside them,
+// and color the w:color property.  Ids and classes have no equivalent and
+// are ignored.  EquationArray nodes become m:eqArr elements aligned at their
+// alignment point.
+//
+// Node returns an m:oMath element, which can be put inside a paragraph of
+// text, and Paragraph a w:p paragraph holding a displayed equation.
+package omml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Expr parses an ASCIIMath expression and returns its OMML.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the m:oMath element for a syntax tree.  The m prefix is the
+// one declared by the document element of word/document.xml, for the
+// namespace http://schemas.openxmlformats.org/officeDocument/2006/math.
+func Node(node ast.Node) string {
+	var p printer
+	p.WriteString("<m:oMath>")
+	p.node(node)
+	p.WriteString("</m:oMath>")
+	return p.String()
+}
+
+// Paragraph returns a w:p paragraph of word/document.xml made of the syntax
+// tree as a displayed equation.
+func Paragraph(node ast.Node) string {
+	return "<w:p><m:oMathPara>" + Node(node) + "</m:oMathPara></w:p>"
+}
+
+// A printer writes OMML for syntax trees.  Its fields are the properties of
+// the runs it writes.
+type printer struct {
+	strings.Builder
+	scr   string // m:scr, e.g. "double-struck"
+	sty   string // m:sty, "b" for bold
+	color string // w:color, e.g. "FF0000"
+	aln   bool   // the next run is the alignment point of an m:eqArr
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// run writes an m:r element for text, which is upright rather than italic
+// if plain is true.
+func (p *printer) run(text string, plain bool) {
+	p.WriteString("<m:r>")
+	sty := p.sty
+	if sty == "" && plain {
+		sty = "p"
+	}
+	if p.scr != "" || sty != "" || p.aln {
+		p.WriteString("<m:rPr>")
+		if p.scr != "" {
+			p.WriteString(`<m:scr m:val="` + p.scr + `"/>`)
+		}
+		if sty != "" {
+			p.WriteString(`<m:sty m:val="` + sty + `"/>`)
+		}
+		if p.aln {
+			p.WriteString("<m:aln/>")
+			p.aln = false
+		}
+		p.WriteString("</m:rPr>")
+	}
+	if p.color != "" {
+		p.WriteString(`<w:rPr><w:color w:val="` + p.color + `"/></w:rPr>`)
+	}
+	if strings.HasPrefix(text, " ") || strings.HasSuffix(text, " ") {
+		p.WriteString(`<m:t xml:space="preserve">`)
+	} else {
+		p.WriteString("<m:t>")
+	}
+	escaper.WriteString(p, text)
+	p.WriteString("</m:t></m:r>")
+}
+
+// open writes the start tag of an element and its properties, if any.
+func (p *printer) open(name string, props ...string) {
+	p.WriteString("<m:" + name + ">")
+	if len(props) > 0 {
+		p.WriteString("<m:" + name + "Pr>" + strings.Join(props, "") + "</m:" + name + "Pr>")
+	}
+}
+
+func (p *printer) close(name string) {
+	p.WriteString("</m:" + name + ">")
+}
+
+// elem writes an element containing node, e.g. <m:num>...</m:num>.
+func (p *printer) elem(name string, node ast.Node) {
+	p.open(name)
+	p.arg(node)
+	p.close(name)
+}
+
+func prop(name, val string) string {
+	return "<m:" + name + ` m:val="` + escaper.Replace(val) + `"/>`
+}
+
+// arg writes an argument, a script or an operand of /, removing the
+// brackets around it as ASCIIMathML does.
+func (p *printer) arg(node ast.Node) {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		node = fenced.Body
+	}
+	if node != nil {
+		p.node(node)
+	}
+}
+
+func (p *printer) node(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.Row:
+		p.items(n.Items)
+	case *ast.Constant:
+		p.symbol(n.Token.Symbol)
+	case *ast.Error:
+		p.symbol(n.Token.Symbol)
+	case *ast.Placeholder:
+		// An empty element shows the placeholder box of Word.
+	case *ast.Text:
+		p.run(n.Value, true)
+	case *ast.Unary:
+		p.unary(n)
+	case *ast.Binary:
+		p.binary(n)
+	case *ast.Style:
+		p.style(n)
+	case *ast.Frac:
+		p.open("f")
+		p.elem("num", n.Num)
+		p.elem("den", n.Den)
+		p.close("f")
+	case *ast.FunctionApplication:
+		if c, ok := n.Func.(*ast.Constant); ok && c.Token.Symbol.Tag() == "mo" {
+			p.open("func")
+			p.elem("fName", n.Func)
+			p.open("e")
+			p.node(n.Arg)
+			p.close("e")
+			p.close("func")
+		} else {
+			p.node(n.Func)
+			p.node(n.Arg)
+		}
+	case *ast.Script:
+		if _, ok := naryChar(n); ok {
+			p.nary(n, nil)
+		} else {
+			p.script(n)
+		}
+	case *ast.Fenced:
+		if n.Invisible() {
+			p.node(n.Body)
+		} else {
+			p.delimited(n.Open, n.Close, func() { p.node(n.Body) })
+		}
+	case *ast.Matrix:
+		p.delimited(n.Open, n.Close, func() { p.matrix(n) })
+	case *ast.EquationArray:
+		p.open("eqArr")
+		for _, line := range n.Lines {
+			p.open("e")
+			p.node(line.Left)
+			p.aln = true
+			p.node(line.Right)
+			p.aln = false
+			p.close("e")
+		}
+		p.close("eqArr")
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+}
+
+// items writes the items of a row.  A big operator takes the items after it
+// as its body, up to the next operator.
+func (p *printer) items(items []ast.Node) {
+	for i := 0; i < len(items); i++ {
+		if _, ok := naryChar(items[i]); !ok {
+			p.node(items[i])
+			continue
+		}
+		j := i + 1
+		for j < len(items) && !endsBody(items[j]) {
+			j++
+		}
+		p.nary(items[i], items[i+1:j])
+		i = j - 1
+	}
+}
+
+// endsBody returns true if node is an operator, which ends the body of the
+// big operator before it.
+func endsBody(node ast.Node) bool {
+	if _, ok := naryChar(node); ok {
+		return false
+	}
+	switch n := node.(type) {
+	case *ast.Constant:
+		return n.Token.Symbol.Tag() == "mo"
+	case *ast.Error:
+		return true
+	}
+	return false
+}
+
+// naryChar returns the character of a big operator, on its own or with
+// limits.
+func naryChar(node ast.Node) (string, bool) {
+	if s, ok := node.(*ast.Script); ok {
+		node = s.Base
+	}
+	c, ok := node.(*ast.Constant)
+	if !ok {
+		return "", false
+	}
+	switch out := c.Token.Symbol.Output(); out {
+	case "∑", "∏", "⋀", "⋁", "⋂", "⋃", "∫", "∮":
+		return out, true
+	}
+	return "", false
+}
+
+// nary writes a big operator with its limits, if any, and body.
+func (p *printer) nary(op ast.Node, body []ast.Node) {
+	chr, _ := naryChar(op)
+	var sub, sup ast.Node
+	if s, ok := op.(*ast.Script); ok {
+		sub, sup = s.Sub, s.Sup
+	}
+	props := []string{prop("chr", chr)}
+	if chr == "∫" || chr == "∮" {
+		props = append(props, prop("limLoc", "subSup"))
+	} else {
+		props = append(props, prop("limLoc", "undOvr"))
+	}
+	if sub == nil {
+		props = append(props, prop("subHide", "1"))
+	}
+	if sup == nil {
+		props = append(props, prop("supHide", "1"))
+	}
+	p.open("nary", props...)
+	p.elem("sub", sub)
+	p.elem("sup", sup)
+	p.open("e")
+	p.items(body)
+	p.close("e")
+	p.close("nary")
+}
+
+// symbol writes a constant symbol.  Functions and other words such as lim
+// and mod are upright.
+func (p *printer) symbol(sym *scanner.Symbol) {
+	out := sym.Output()
+	if sym.Type() == scanner.LEFTRIGHT && out == "|" {
+		// A "|" that does not start an absolute value is a divides sign.
+		out = "∣"
+	}
+	p.run(out, sym.Tag() == "mo" && isWord(out) || sym.Tag() == "mtext")
+}
+
+func isWord(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return len(s) > 1
+}
+
+// accents maps the inputs of accents to the combining characters of m:acc
+// elements.
+var accents = map[string]string{
+	"hat": "̂", "tilde": "̃", "vec": "⃗", "dot": "̇",
+	"ddot": "̈", "overarc": "̑",
+}
+
+func (p *printer) unary(n *ast.Unary) {
+	sym := n.Op.Symbol
+	if lr := sym.RewriteLeftRight(); lr != [2]string{} {
+		p.open("d", prop("begChr", lr[0]), prop("endChr", lr[1]))
+		p.elem("e", n.Arg)
+		p.close("d")
+		return
+	}
+	switch sym.Input() {
+	case "sqrt":
+		p.open("rad", prop("degHide", "1"))
+		p.elem("deg", nil)
+		p.elem("e", n.Arg)
+		p.close("rad")
+	case "bar":
+		p.open("bar", prop("pos", "top"))
+		p.elem("e", n.Arg)
+		p.close("bar")
+	case "ul":
+		p.open("bar", prop("pos", "bot"))
+		p.elem("e", n.Arg)
+		p.close("bar")
+	case "ubrace":
+		p.open("groupChr", prop("chr", sym.Output()), prop("pos", "bot"), prop("vertJc", "top"))
+		p.elem("e", n.Arg)
+		p.close("groupChr")
+	case "obrace":
+		p.open("groupChr", prop("chr", sym.Output()), prop("pos", "top"), prop("vertJc", "bot"))
+		p.elem("e", n.Arg)
+		p.close("groupChr")
+	case "cancel":
+		p.open("borderBox", prop("hideTop", "1"), prop("hideBot", "1"),
+			prop("hideLeft", "1"), prop("hideRight", "1"), prop("strikeBLTR", "1"))
+		p.elem("e", n.Arg)
+		p.close("borderBox")
+	default:
+		if chr, ok := accents[sym.Input()]; ok {
+			p.open("acc", prop("chr", chr))
+			p.elem("e", n.Arg)
+			p.close("acc")
+			return
+		}
+		p.symbol(sym)
+		p.arg(n.Arg)
+	}
+}
+
+func (p *printer) binary(n *ast.Binary) {
+	switch n.Op.Input() {
+	case "frac":
+		p.open("f")
+		p.elem("num", n.Arg1)
+		p.elem("den", n.Arg2)
+		p.close("f")
+	case "root":
+		p.open("rad")
+		p.elem("deg", n.Arg1)
+		p.elem("e", n.Arg2)
+		p.close("rad")
+	case "stackrel", "overset":
+		p.open("limUpp")
+		p.elem("e", n.Arg2)
+		p.elem("lim", n.Arg1)
+		p.close("limUpp")
+	case "underset":
+		p.open("limLow")
+		p.elem("e", n.Arg2)
+		p.elem("lim", n.Arg1)
+		p.close("limLow")
+	default:
+		p.symbol(n.Op.Symbol)
+		p.arg(n.Arg1)
+		p.arg(n.Arg2)
+	}
+}
+
+// scripts maps the values of the mathvariant attribute to the values of the
+// m:scr property.
+var scripts = map[string]string{
+	"double-struck": "double-struck",
+	"script":        "script",
+	"fraktur":       "fraktur",
+	"sans-serif":    "sans-serif",
+	"monospace":     "monospace",
+}
+
+// colors gives the RGB values of the color names of CSS that Word documents
+// use most.  Other colors must be given as #rrggbb.
+var colors = map[string]string{
+	"black": "000000", "white": "FFFFFF", "red": "FF0000", "green": "008000",
+	"blue": "0000FF", "yellow": "FFFF00", "orange": "FFA500",
+	"purple": "800080", "gray": "808080", "grey": "808080",
+	"brown": "A52A2A", "cyan": "00FFFF", "magenta": "FF00FF",
+	"pink": "FFC0CB", "navy": "000080", "teal": "008080",
+	"maroon": "800000", "olive": "808000", "lime": "00FF00",
+}
+
+func (p *printer) style(n *ast.Style) {
+	scr, sty, color := p.scr, p.sty, p.color
+	switch ast.StyleAttr(n.Op) {
+	case "mathvariant":
+		if n.Value() == "bold" {
+			p.sty = "b"
+		} else {
+			p.scr = scripts[n.Value()]
+		}
+	case "mathcolor":
+		v := n.Value()
+		if c, ok := colors[strings.ToLower(v)]; ok {
+			p.color = c
+		} else if len(v) == 7 && v[0] == '#' {
+			p.color = strings.ToUpper(v[1:])
+		}
+	}
+	p.arg(n.Arg)
+	p.scr, p.sty, p.color = scr, sty, color
+}
+
+func (p *printer) script(n *ast.Script) {
+	switch {
+	case n.Limits != ast.NoLimits && n.Sub != nil && n.Sup != nil:
+		p.open("limUpp")
+		p.open("e")
+		p.open("limLow")
+		p.elem("e", n.Base)
+		p.elem("lim", n.Sub)
+		p.close("limLow")
+		p.close("e")
+		p.elem("lim", n.Sup)
+		p.close("limUpp")
+	case n.Limits != ast.NoLimits && n.Sub != nil:
+		p.open("limLow")
+		p.elem("e", n.Base)
+		p.elem("lim", n.Sub)
+		p.close("limLow")
+	case n.Limits != ast.NoLimits:
+		p.open("limUpp")
+		p.elem("e", n.Base)
+		p.elem("lim", n.Sup)
+		p.close("limUpp")
+	case n.Sub != nil && n.Sup != nil:
+		p.open("sSubSup")
+		p.elem("e", n.Base)
+		p.elem("sub", n.Sub)
+		p.elem("sup", n.Sup)
+		p.close("sSubSup")
+	case n.Sub != nil:
+		p.open("sSub")
+		p.elem("e", n.Base)
+		p.elem("sub", n.Sub)
+		p.close("sSub")
+	default:
+		p.open("sSup")
+		p.elem("e", n.Base)
+		p.elem("sup", n.Sup)
+		p.close("sSup")
+	}
+}
+
+// delimited writes an m:d element with the brackets open and close around
+// the body written by body.  Invisible and missing brackets are empty.
+func (p *printer) delimited(open, close scanner.Token, body func()) {
+	beg, end := open.Symbol.Output(), ""
+	if close.IsValid() {
+		end = close.Symbol.Output()
+	}
+	if open.Symbol.Invisible() {
+		beg = ""
+	}
+	if close.IsValid() && close.Symbol.Invisible() {
+		end = ""
+	}
+	var props []string
+	if beg != "(" {
+		props = append(props, prop("begChr", beg))
+	}
+	if end != ")" {
+		props = append(props, prop("endChr", end))
+	}
+	p.open("d", props...)
+	p.open("e")
+	body()
+	p.close("e")
+	p.close("d")
+}
+
+// matrix writes the m:m element of a matrix.  Word matrices have no lines
+// between columns, so the "|" cells that draw them in ASCIIMathML are left
+// out.  Cases are aligned left.
+func (p *printer) matrix(n *ast.Matrix) {
+	if n.Close.IsValid() && n.Close.Symbol.Invisible() {
+		p.open("m", "<m:mcs><m:mc>"+"<m:mcPr>"+prop("count", fmt.Sprint(columns(n.Rows[0])))+
+			prop("mcJc", "left")+"</m:mcPr></m:mc></m:mcs>")
+	} else {
+		p.open("m")
+	}
+	for _, row := range n.Rows {
+		p.open("mr")
+		for j, cell := range row.Cells {
+			if j > 0 && j < len(row.Cells)-1 && isColumnLine(cell) {
+				continue
+			}
+			p.open("e")
+			p.node(cell)
+			p.close("e")
+		}
+		p.close("mr")
+	}
+	p.close("m")
+}
+
+// columns returns the number of cells of row, not counting column lines.
+func columns(row *ast.MatrixRow) int {
+	count := 0
+	for j, cell := range row.Cells {
+		if !(j > 0 && j < len(row.Cells)-1 && isColumnLine(cell)) {
+			count++
+		}
+	}
+	return count
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
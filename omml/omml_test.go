@@ -0,0 +1,120 @@
+package omml
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "fraction",
+			input: "frac(1)(2)",
+			want:  `<m:f><m:num><m:r><m:t>1</m:t></m:r></m:num><m:den><m:r><m:t>2</m:t></m:r></m:den></m:f>`,
+		},
+		{
+			name:  "scripts",
+			input: "x_i^2 - y^(n)",
+			want: `<m:sSubSup><m:e><m:r><m:t>x</m:t></m:r></m:e><m:sub><m:r><m:t>i</m:t></m:r></m:sub><m:sup><m:r><m:t>2</m:t></m:r></m:sup></m:sSubSup>` +
+				`<m:r><m:t>-</m:t></m:r><m:sSup><m:e><m:r><m:t>y</m:t></m:r></m:e><m:sup><m:r><m:t>n</m:t></m:r></m:sup></m:sSup>`,
+		},
+		{
+			name:  "roots",
+			input: "sqrt x root(3)(y)",
+			want: `<m:rad><m:radPr><m:degHide m:val="1"/></m:radPr><m:deg></m:deg><m:e><m:r><m:t>x</m:t></m:r></m:e></m:rad>` +
+				`<m:rad><m:deg><m:r><m:t>3</m:t></m:r></m:deg><m:e><m:r><m:t>y</m:t></m:r></m:e></m:rad>`,
+		},
+		{
+			name:  "sum",
+			input: "sum_(i=1)^n i^2 + 1",
+			want: `<m:nary><m:naryPr><m:chr m:val="∑"/><m:limLoc m:val="undOvr"/></m:naryPr>` +
+				`<m:sub><m:r><m:t>i</m:t></m:r><m:r><m:t>=</m:t></m:r><m:r><m:t>1</m:t></m:r></m:sub><m:sup><m:r><m:t>n</m:t></m:r></m:sup>` +
+				`<m:e><m:sSup><m:e><m:r><m:t>i</m:t></m:r></m:e><m:sup><m:r><m:t>2</m:t></m:r></m:sup></m:sSup></m:e></m:nary>` +
+				`<m:r><m:t>+</m:t></m:r><m:r><m:t>1</m:t></m:r>`,
+		},
+		{
+			name:  "integral",
+			input: "int x dx",
+			want: `<m:nary><m:naryPr><m:chr m:val="∫"/><m:limLoc m:val="subSup"/><m:subHide m:val="1"/><m:supHide m:val="1"/></m:naryPr>` +
+				`<m:sub></m:sub><m:sup></m:sup><m:e><m:r><m:t>x</m:t></m:r><m:r><m:t>d</m:t></m:r><m:r><m:t>x</m:t></m:r></m:e></m:nary>`,
+		},
+		{
+			name:  "limit and function",
+			input: "lim_(x->0) sin x",
+			want: `<m:limLow><m:e><m:r><m:rPr><m:sty m:val="p"/></m:rPr><m:t>lim</m:t></m:r></m:e><m:lim><m:r><m:t>x</m:t></m:r><m:r><m:t>→</m:t></m:r><m:r><m:t>0</m:t></m:r></m:lim></m:limLow>` +
+				`<m:func><m:fName><m:r><m:rPr><m:sty m:val="p"/></m:rPr><m:t>sin</m:t></m:r></m:fName><m:e><m:r><m:t>x</m:t></m:r></m:e></m:func>`,
+		},
+		{
+			name:  "brackets",
+			input: "(a, b] + abs(x)",
+			want: `<m:d><m:dPr><m:endChr m:val="]"/></m:dPr><m:e><m:r><m:t>a</m:t></m:r><m:r><m:t>,</m:t></m:r><m:r><m:t>b</m:t></m:r></m:e></m:d>` +
+				`<m:r><m:t>+</m:t></m:r><m:d><m:dPr><m:begChr m:val="|"/><m:endChr m:val="|"/></m:dPr><m:e><m:r><m:t>x</m:t></m:r></m:e></m:d>`,
+		},
+		{
+			name:  "matrix",
+			input: "[[1, 2], [3, 4]]",
+			want: `<m:d><m:dPr><m:begChr m:val="["/><m:endChr m:val="]"/></m:dPr><m:e><m:m>` +
+				`<m:mr><m:e><m:r><m:t>1</m:t></m:r></m:e><m:e><m:r><m:t>2</m:t></m:r></m:e></m:mr>` +
+				`<m:mr><m:e><m:r><m:t>3</m:t></m:r></m:e><m:e><m:r><m:t>4</m:t></m:r></m:e></m:mr></m:m></m:e></m:d>`,
+		},
+		{
+			name:  "cases",
+			input: "{(1, x), (0, y):}",
+			want: `<m:d><m:dPr><m:begChr m:val="{"/><m:endChr m:val=""/></m:dPr><m:e><m:m>` +
+				`<m:mPr><m:mcs><m:mc><m:mcPr><m:count m:val="2"/><m:mcJc m:val="left"/></m:mcPr></m:mc></m:mcs></m:mPr>` +
+				`<m:mr><m:e><m:r><m:t>1</m:t></m:r></m:e><m:e><m:r><m:t>x</m:t></m:r></m:e></m:mr>` +
+				`<m:mr><m:e><m:r><m:t>0</m:t></m:r></m:e><m:e><m:r><m:t>y</m:t></m:r></m:e></m:mr></m:m></m:e></m:d>`,
+		},
+		{
+			name:  "accents",
+			input: "hat x bar y ubrace(1)_3",
+			want: `<m:acc><m:accPr><m:chr m:val="̂"/></m:accPr><m:e><m:r><m:t>x</m:t></m:r></m:e></m:acc>` +
+				`<m:bar><m:barPr><m:pos m:val="top"/></m:barPr><m:e><m:r><m:t>y</m:t></m:r></m:e></m:bar>` +
+				`<m:limLow><m:e><m:groupChr><m:groupChrPr><m:chr m:val="⏟"/><m:pos m:val="bot"/><m:vertJc m:val="top"/></m:groupChrPr>` +
+				`<m:e><m:r><m:t>1</m:t></m:r></m:e></m:groupChr></m:e><m:lim><m:r><m:t>3</m:t></m:r></m:lim></m:limLow>`,
+		},
+		{
+			name:  "styles",
+			input: `bb x bbb R color(red)(y) "if "`,
+			want: `<m:r><m:rPr><m:sty m:val="b"/></m:rPr><m:t>x</m:t></m:r>` +
+				`<m:r><m:rPr><m:scr m:val="double-struck"/></m:rPr><m:t>R</m:t></m:r>` +
+				`<m:r><w:rPr><w:color w:val="FF0000"/></w:rPr><m:t>y</m:t></m:r>` +
+				`<m:r><m:rPr><m:sty m:val="p"/></m:rPr><m:t xml:space="preserve">if </m:t></m:r>`,
+		},
+		{
+			name:  "escaping",
+			input: "a < b",
+			want:  `<m:r><m:t>a</m:t></m:r><m:r><m:t>&lt;</m:t></m:r><m:r><m:t>b</m:t></m:r>`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Expr(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := "<m:oMath>" + test.want + "</m:oMath>"; got != want {
+				t.Errorf("Expr() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestParagraph(t *testing.T) {
+	block, err := parser.ParseBlock("x &= 1\n&= y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<w:p><m:oMathPara><m:oMath><m:eqArr>` +
+		`<m:e><m:r><m:t>x</m:t></m:r><m:r><m:rPr><m:aln/></m:rPr><m:t>=</m:t></m:r><m:r><m:t>1</m:t></m:r></m:e>` +
+		`<m:e><m:r><m:rPr><m:aln/></m:rPr><m:t>=</m:t></m:r><m:r><m:t>y</m:t></m:r></m:e>` +
+		`</m:eqArr></m:oMath></m:oMathPara></w:p>`
+	if got := Paragraph(block); got != want {
+		t.Errorf("Paragraph() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"context"
+	"strings"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// ParseBlock parses a block of ASCIIMath expressions, one per line, such as
+// the steps of a derivation.  Blank lines are ignored.
+//
+// Each line is split at its alignment point, which is an explicit "&" marker
+// if the line has one outside brackets, or else its first relation symbol
+// outside brackets: = < > or a binary relation symbol of AMsymbols such as
+// <= or -=.  So the lines of
+//
+//	(a+b)^2 = (a+b)(a+b)
+//	        = a^2 + 2ab + b^2
+//
+// are aligned at their "=".  Errors are reported as in Parse, with offsets in
+// the whole block.
+func ParseBlock(input string) (*ast.EquationArray, error) {
+	return ParseBlockContext(context.Background(), input, Options{})
+}
+
+// ParseBlockContext is like ParseBlock but enforces the limits in opts and
+// stops if ctx is done, as ParseContext does.  The limits apply to the whole
+// block.
+func ParseBlockContext(ctx context.Context, input string, opts Options) (block *ast.EquationArray, err error) {
+	tokens, err := scanner.New().ScanContext(ctx, input, scanner.Options{
+		MaxInputLength: opts.MaxInputLength,
+		MaxTokens:      opts.MaxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tokens = expandDefinitions(tokens)
+	p := &parser{ctx: ctx, opts: opts}
+	defer func() {
+		if r := recover(); r != nil {
+			b, ok := r.(bailout)
+			if !ok {
+				panic(r)
+			}
+			block, err = nil, b.err
+		}
+	}()
+	block = &ast.EquationArray{}
+	for start := 0; start < len(input); {
+		end := strings.IndexByte(input[start:], '\n')
+		if end == -1 {
+			end = len(input)
+		} else {
+			end += start
+		}
+		// The line is parsed on its own, but the parser sees the input up
+		// to its end so that offsets are in the whole block.
+		n := 0
+		for n < len(tokens) && tokens[n].Pos < end {
+			n++
+		}
+		if n > 0 {
+			p.input, p.tokens, p.pos, p.depth = input[:end], tokens[:n], 0, 0
+			// The positions kept in memo are those of the previous line.
+			p.memo, p.trying = nil, 0
+			block.Lines = append(block.Lines, splitLine(p.parseExpr(false)))
+			tokens = tokens[n:]
+		}
+		start = end + 1
+	}
+	if len(p.diags) > 0 {
+		return block, p.diags
+	}
+	return block, nil
+}
+
+// splitLine splits row at its alignment point.
+func splitLine(row *ast.Row) *ast.EquationLine {
+	for i, item := range row.Items {
+		if c, ok := item.(*ast.Constant); ok && c.Token.Input() == "&" {
+			return &ast.EquationLine{
+				Left:   newRow(row.Items[:i]),
+				Marker: c.Token,
+				Right:  newRow(row.Items[i+1:]),
+			}
+		}
+	}
+	for i, item := range row.Items {
+		if c, ok := item.(*ast.Constant); ok && ast.IsRelation(c.Token) {
+			return &ast.EquationLine{Left: newRow(row.Items[:i]), Right: newRow(row.Items[i:])}
+		}
+	}
+	return &ast.EquationLine{Left: row, Right: &ast.Row{}}
+}
+
+// newRow returns a row made of items, which does not share their backing
+// array.  As in the rest of the parser, Items is nil in an empty row.
+func newRow(items []ast.Node) *ast.Row {
+	return &ast.Row{Items: append([]ast.Node(nil), items...)}
+}
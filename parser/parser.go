@@ -0,0 +1,657 @@
+// Package parser implements a parser for ASCIIMath expressions, following the
+// algorithm of ASCIIMathML.js.
+package parser
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// A Diagnostic reports a problem found in input[Pos:End], with a suggested
+// fix.
+type Diagnostic struct {
+	Pos int
+	End int
+	Msg string
+	Fix Fix
+}
+
+func (d *Diagnostic) Error() string {
+	return fmt.Sprintf("%d: %s", d.Pos, d.Msg)
+}
+
+// A Fix is a suggested edit of the input, replacing input[Pos:End] with Text.
+type Fix struct {
+	Msg  string
+	Pos  int
+	End  int
+	Text string
+}
+
+// Apply returns the input with the fix applied.
+func (f Fix) Apply(input string) string {
+	return input[:f.Pos] + f.Text + input[f.End:]
+}
+
+// Diagnostics is a list of diagnostics.  It implements the error interface.
+type Diagnostics []*Diagnostic
+
+func (l Diagnostics) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Parse parses an ASCIIMath expression.  The returned node is an *ast.Row.
+//
+// The parser never gives up on an expression: unexpected tokens become
+// ast.Error nodes and missing arguments ast.Placeholder nodes.  Each problem
+// is described by a Diagnostic, and the syntax tree is returned together with
+// a Diagnostics error if there are any.
+func Parse(input string) (ast.Node, error) {
+	return ParseContext(context.Background(), input, Options{})
+}
+
+// Options limit the resources used to parse untrusted input.  A zero value
+// means no limit.
+type Options struct {
+	MaxInputLength int // maximum length of the input in bytes
+	MaxTokens      int // maximum number of tokens
+	MaxDepth       int // maximum nesting depth of expressions
+}
+
+// workFactor is the number of tokens that the parser may go through for each
+// token allowed by MaxTokens, including those it goes through again after
+// backtracking.
+var workFactor = 8
+
+// A TooMuchWorkError is returned when parsing goes through more tokens than
+// allowed, which can only happen with a lot of backtracking.  Pos is the
+// offset of the token that goes over the limit.
+type TooMuchWorkError struct {
+	Pos int
+	Max int
+}
+
+func (e *TooMuchWorkError) Error() string {
+	return fmt.Sprintf("%d: too much work, maximum is %d tokens", e.Pos, e.Max)
+}
+
+// A NestingTooDeepError is returned when expressions are nested more deeply
+// than allowed.  Pos is the offset of the token that goes over the limit.
+type NestingTooDeepError struct {
+	Pos int
+	Max int
+}
+
+func (e *NestingTooDeepError) Error() string {
+	return fmt.Sprintf("%d: nesting too deep, maximum is %d", e.Pos, e.Max)
+}
+
+// ParseContext is like Parse but enforces the limits in opts and stops if ctx
+// is done before the end of parsing.  When a limit is exceeded, it returns a
+// nil node and a *scanner.InputTooLongError, *scanner.TooManyTokensError,
+// *NestingTooDeepError or *TooMuchWorkError.  The work is limited to a small
+// multiple of MaxTokens.  When ctx is done, it returns a nil node and a
+// *scanner.CanceledError.
+func ParseContext(ctx context.Context, input string, opts Options) (node ast.Node, err error) {
+	tokens, err := scanner.New().ScanContext(ctx, input, scanner.Options{
+		MaxInputLength: opts.MaxInputLength,
+		MaxTokens:      opts.MaxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{ctx: ctx, opts: opts, input: input, tokens: expandDefinitions(tokens)}
+	defer func() {
+		if r := recover(); r != nil {
+			b, ok := r.(bailout)
+			if !ok {
+				panic(r)
+			}
+			node, err = nil, b.err
+		}
+	}()
+	node = p.parseExpr(false)
+	if len(p.diags) > 0 {
+		return node, p.diags
+	}
+	return node, nil
+}
+
+type parser struct {
+	ctx    context.Context
+	opts   Options
+	input  string
+	tokens []scanner.Token
+	pos    int // index of the next token
+	depth  int // bracket nesting depth
+	nest   int // expression nesting depth
+	count  int // number of tokens gone through, including backtracking
+	diags  Diagnostics
+	// trying is the number of "|" being tried as the start of an absolute
+	// value, during which the results of parseSexpr are kept in memo.
+	trying int
+	memo   map[memoKey]*memoEntry
+	peak   int // deepest expression nesting depth reached
+}
+
+// A memoKey is the position of a parseSexpr, or of the body of a "|" if
+// body is true.  Their results only depend on the next token and on whether
+// it is inside brackets.
+type memoKey struct {
+	pos    int
+	inside bool
+	body   bool
+}
+
+// A memoEntry is the result of a parseSexpr or of the body of a "|", kept so
+// that the expressions after a "|" are parsed once when it turns out not to
+// open an absolute value.
+type memoEntry struct {
+	node   ast.Node
+	pos    int         // position after the node
+	diags  Diagnostics // problems found in the node
+	height int         // nesting depth of the node
+}
+
+// A bailout is raised with panic to stop parsing with an error.
+type bailout struct {
+	err error
+}
+
+// enter increases the nesting depth of expressions, stopping if it goes over
+// the limit.  It must be paired with leave.
+func (p *parser) enter() {
+	p.nest++
+	if p.nest > p.peak {
+		p.peak = p.nest
+	}
+	if max := p.opts.MaxDepth; max > 0 && p.nest > max {
+		pos := len(p.input)
+		if tok := p.peek(); tok != nil {
+			pos = tok.Pos
+		}
+		panic(bailout{&NestingTooDeepError{Pos: pos, Max: max}})
+	}
+}
+
+func (p *parser) leave() {
+	p.nest--
+}
+
+// expandDefinitions replaces DEFINITION symbols such as dx with the tokens
+// they stand for.  The new tokens take the location of the symbol they
+// replace.
+func expandDefinitions(tokens []scanner.Token) []scanner.Token {
+	var expanded []scanner.Token
+	for _, tok := range tokens {
+		if tok.Type() != scanner.DEFINITION {
+			expanded = append(expanded, tok)
+			continue
+		}
+		defTokens, _ := scanner.New().Scan(tok.Symbol.Output())
+		for _, defTok := range defTokens {
+			defTok.Pos, defTok.End = tok.Pos, tok.End
+			expanded = append(expanded, defTok)
+		}
+	}
+	return expanded
+}
+
+// diagnose records a problem in input[pos:end], with a suggested fix.
+func (p *parser) diagnose(pos, end int, fix Fix, format string, args ...interface{}) {
+	p.diags = append(p.diags, &Diagnostic{
+		Pos: pos,
+		End: end,
+		Msg: fmt.Sprintf(format, args...),
+		Fix: fix,
+	})
+}
+
+// unexpected consumes the next token and returns an error node for it.
+func (p *parser) unexpected() ast.Node {
+	tok := p.next()
+	fix := Fix{Msg: fmt.Sprintf("remove %q", tok.Input()), Pos: tok.Pos, End: tok.End}
+	p.diagnose(tok.Pos, tok.End, fix, "unexpected %q", tok.Input())
+	return &ast.Error{Token: tok}
+}
+
+// missing returns a placeholder at offset at for a missing argument of op.
+func (p *parser) missing(op scanner.Token, at int, what string) ast.Node {
+	fix := Fix{Msg: "insert " + what, Pos: at, End: at, Text: "()"}
+	p.diagnose(op.Pos, op.End, fix, "missing %s for %q", what, op.Input())
+	return &ast.Placeholder{At: at}
+}
+
+func (p *parser) peek() *scanner.Token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *parser) next() scanner.Token {
+	p.step()
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok
+}
+
+// step counts a token gone through, stopping if the work goes over the limit
+// or if the context is done.
+func (p *parser) step() {
+	if max := workFactor * p.opts.MaxTokens; max > 0 && p.count >= max {
+		pos := len(p.input)
+		if tok := p.peek(); tok != nil {
+			pos = tok.Pos
+		}
+		panic(bailout{&TooMuchWorkError{Pos: pos, Max: max}})
+	}
+	if p.count%scanner.CheckInterval == 0 {
+		select {
+		case <-p.ctx.Done():
+			panic(bailout{&scanner.CanceledError{Err: p.ctx.Err()}})
+		default:
+		}
+	}
+	p.count++
+}
+
+// afterInfix returns true if the last token consumed was an INFIX symbol.
+func (p *parser) afterInfix() bool {
+	return p.pos > 0 && p.tokens[p.pos-1].Type() == scanner.INFIX
+}
+
+// parseExpr parses E ::= IE | I/I.  Inside brackets it stops before the
+// first right bracket, and also before the first "|" if stopAtBar is true.
+func (p *parser) parseExpr(stopAtBar bool) *ast.Row {
+	row := &ast.Row{}
+	for {
+		if stopAtBar {
+			// The rest of the row is the body of a "|" that does not
+			// open an absolute value.
+			if e := p.recall(memoKey{pos: p.pos, inside: true, body: true}); e != nil {
+				row.Items = append(row.Items, e.node.(*ast.Row).Items...)
+				return row
+			}
+		}
+		node := p.parseIexpr()
+		if node == nil {
+			return row
+		}
+		if tok := p.peek(); tok != nil && tok.Input() == "/" {
+			slash := p.next()
+			den := p.parseIexpr()
+			if den == nil {
+				den = p.missing(slash, slash.End, "denominator")
+			}
+			node = &ast.Frac{Num: node, Slash: slash, Den: den}
+		}
+		row.Items = append(row.Items, node)
+		if tok := p.peek(); stopAtBar && tok != nil && tok.Type() == scanner.LEFTRIGHT {
+			return row
+		}
+	}
+}
+
+// parseIexpr parses I ::= S_S | S^S | S_S^S | S.
+func (p *parser) parseIexpr() ast.Node {
+	first := p.peek()
+	base := p.parseSexpr()
+	if base == nil {
+		return nil
+	}
+	tok := p.peek()
+	if tok == nil || tok.Type() != scanner.INFIX || tok.Input() == "/" {
+		return base
+	}
+	script := &ast.Script{Base: base, Limits: ast.LimitsFor(*first)}
+	op := p.next()
+	arg := p.parseArg(op, op.End)
+	if op.Input() == "_" {
+		script.Sub = arg
+		if tok := p.peek(); tok != nil && tok.Input() == "^" {
+			sup := p.next()
+			script.Sup = p.parseArg(sup, sup.End)
+		}
+	} else {
+		script.Sup = arg
+	}
+	if _, ok := base.(*ast.Constant); ok && first.Symbol.IsFunc() {
+		// As in ASCIIMathML, sin^2 x and f^2(x) are function applications
+		// but f^2 x and (sin x)^2 y are not.
+		next := p.peek()
+		if next != nil && next.Type() != scanner.INFIX && next.Type() != scanner.RIGHTBRACKET &&
+			(len(first.Input()) > 1 || next.Type() == scanner.LEFTBRACKET) {
+			p.enter()
+			defer p.leave()
+			return &ast.FunctionApplication{Func: script, Arg: p.parseIexpr()}
+		}
+	}
+	return script
+}
+
+// parseSexpr parses S ::= c | lEr | uS | bSS.  It returns nil at the end of
+// the input or when the next token closes the current bracket.
+func (p *parser) parseSexpr() ast.Node {
+	return p.memoize(memoKey{pos: p.pos, inside: p.depth > 0}, p.parseSexprOnce)
+}
+
+// recall returns the result kept in memo for key and moves past it, or nil
+// if there is none.  A result that goes over the nesting limit here is not
+// used, so that it is parsed again to report it.
+func (p *parser) recall(key memoKey) *memoEntry {
+	e, ok := p.memo[key]
+	if !ok {
+		return nil
+	}
+	if max := p.opts.MaxDepth; max > 0 && p.nest+e.height > max {
+		return nil
+	}
+	p.step()
+	p.pos = e.pos
+	p.diags = append(p.diags, e.diags...)
+	return e
+}
+
+// memoize returns the result of parse for key.  While a "|" is tried as the
+// start of an absolute value, the results are kept so that the same tokens
+// are not parsed again after backtracking, which would take exponential time
+// for input like |(|(|(.
+func (p *parser) memoize(key memoKey, parse func() ast.Node) ast.Node {
+	if e := p.recall(key); e != nil {
+		return e.node
+	}
+	if p.trying == 0 {
+		return parse()
+	}
+	ndiags, peak := len(p.diags), p.peak
+	p.peak = p.nest
+	node := parse()
+	e := &memoEntry{node: node, pos: p.pos, height: p.peak - p.nest}
+	e.diags = append(e.diags, p.diags[ndiags:]...)
+	if p.peak < peak {
+		p.peak = peak
+	}
+	if p.memo == nil {
+		p.memo = map[memoKey]*memoEntry{}
+	}
+	p.memo[key] = e
+	return node
+}
+
+func (p *parser) parseSexprOnce() ast.Node {
+	tok := p.peek()
+	if tok == nil || tok.Type() == scanner.RIGHTBRACKET && p.depth > 0 {
+		return nil
+	}
+	p.enter()
+	defer p.leave()
+	if tok.Input() == "-" && p.afterInfix() && tok.End < len(p.input) && p.input[tok.End] != ' ' {
+		// As in ASCIIMathML, x^-1 means x^(-1)
+		minus := &ast.Constant{Token: p.next()}
+		arg := p.parseSexpr()
+		if arg == nil {
+			return minus
+		}
+		return &ast.Row{Items: []ast.Node{minus, arg}}
+	}
+	switch tok.Type() {
+	case scanner.LEFTBRACKET:
+		return p.parseFenced()
+	case scanner.LEFTRIGHT:
+		return p.parseLeftRight()
+	case scanner.RIGHTBRACKET:
+		return p.unexpected()
+	case scanner.TEXT:
+		return p.parseText()
+	case scanner.UNARY, scanner.UNARYUNDEROVER:
+		if tok.Symbol.IsFunc() {
+			return p.parseFunc()
+		}
+		op := p.next()
+		if name := op.Symbol.AttrName(); name != "" {
+			attrs := map[string]string{name: op.Symbol.AttrValue()}
+			return &ast.Style{Op: op, Attrs: attrs, Arg: p.parseArg(op, op.End)}
+		}
+		return &ast.Unary{Op: op, Arg: p.parseArg(op, op.End)}
+	case scanner.BINARY:
+		if ast.StyleAttr(*tok) != "" {
+			return p.parseStyle()
+		}
+		op := p.next()
+		arg1 := p.parseArg(op, op.End)
+		arg2 := p.parseArg(op, arg1.End())
+		return &ast.Binary{Op: op, Arg1: arg1, Arg2: arg2}
+	default:
+		return &ast.Constant{Token: p.next()}
+	}
+}
+
+// parseFenced parses a left bracket, an expression and a matching right
+// bracket.  Any right bracket matches any left bracket, so that e.g. [a,b)
+// is valid.  A missing right bracket is reported and the group closed at the
+// end of the input.
+func (p *parser) parseFenced() ast.Node {
+	open := p.next()
+	p.depth++
+	body := p.parseExpr(false)
+	p.depth--
+	fenced := &ast.Fenced{Open: open, Body: body}
+	if p.peek() == nil {
+		end := fenced.End()
+		close := closingBrackets[open.Input()]
+		fix := Fix{Msg: fmt.Sprintf("insert %q", close), Pos: end, End: end, Text: close}
+		p.diagnose(open.Pos, open.End, fix, "missing closing bracket for %q", open.Input())
+	} else {
+		fenced.Close = p.next()
+		if matrix := toMatrix(fenced); matrix != nil {
+			return matrix
+		}
+	}
+	return fenced
+}
+
+// toMatrix returns the matrix written as n, or nil if n is not a matrix.  As
+// in ASCIIMathML, brackets contain a matrix if they contain comma separated
+// rows with the same number of cells, which are either all between ( and )
+// or all between [ and ].  Rows between ( and ) are not allowed inside { and
+// }.  A single row is a matrix only if it has more than one cell.
+func toMatrix(n *ast.Fenced) *ast.Matrix {
+	items := n.Body.Items
+	if len(items)%2 == 0 {
+		return nil
+	}
+	last, ok := items[len(items)-1].(*ast.Fenced)
+	if !ok {
+		return nil
+	}
+	left, right := last.Open.Input(), last.Close.Input()
+	if !(left == "(" && right == ")" && n.Close.Input() != "}" || left == "[" && right == "]") {
+		return nil
+	}
+	matrix := &ast.Matrix{Open: n.Open, Close: n.Close}
+	for i := 0; i < len(items); i += 2 {
+		fenced, ok := items[i].(*ast.Fenced)
+		if !ok || fenced.Open.Input() != left || fenced.Close.Input() != right {
+			return nil
+		}
+		if i+1 < len(items) && !isComma(items[i+1]) {
+			return nil
+		}
+		row := &ast.MatrixRow{Open: fenced.Open, Close: fenced.Close}
+		cell := &ast.Row{}
+		for _, item := range fenced.Body.Items {
+			if isComma(item) {
+				row.Cells = append(row.Cells, cell)
+				cell = &ast.Row{}
+			} else {
+				cell.Items = append(cell.Items, item)
+			}
+		}
+		row.Cells = append(row.Cells, cell)
+		if i > 0 && len(row.Cells) != matrix.Columns() {
+			return nil
+		}
+		matrix.Rows = append(matrix.Rows, row)
+	}
+	if len(matrix.Rows) == 1 && matrix.Columns() == 1 {
+		return nil
+	}
+	return matrix
+}
+
+func isComma(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == ","
+}
+
+// parseLeftRight parses a "|", which can be either side of an absolute value
+// or a divides sign.  As in ASCIIMathML it opens an absolute value if the
+// expression that follows it ends with another "|" that is not followed by a
+// comma.  Otherwise it is a constant.
+func (p *parser) parseLeftRight() ast.Node {
+	open := p.next()
+	pos, ndiags := p.pos, len(p.diags)
+	p.depth++
+	p.trying++
+	body := p.memoize(memoKey{pos: pos, inside: true, body: true}, func() ast.Node {
+		return p.parseExpr(true)
+	}).(*ast.Row)
+	p.trying--
+	p.depth--
+	if tok := p.peek(); tok != nil && tok.Type() == scanner.LEFTRIGHT {
+		close := p.next()
+		if tok := p.peek(); tok == nil || tok.Input() != "," {
+			return &ast.Fenced{Open: open, Body: body, Close: close}
+		}
+	}
+	p.pos, p.diags = pos, p.diags[:ndiags]
+	return &ast.Constant{Token: open}
+}
+
+var closingBrackets = map[string]string{
+	"(":  ")",
+	"[":  "]",
+	"{":  "}",
+	"|:": ":|",
+	"(:": ":)",
+	"<<": ">>",
+	"{:": ":}",
+}
+
+// parseFunc parses a function symbol such as sin or f and its argument if it
+// has one.  As in ASCIIMathML, a function has no argument if it is followed
+// by a script, a fraction, a "|" or a comma, and single letter functions only
+// take an argument in brackets: f(x) is a function application but f x is
+// not.
+func (p *parser) parseFunc() ast.Node {
+	fn := &ast.Constant{Token: p.next()}
+	next := p.peek()
+	if next == nil {
+		return fn
+	}
+	switch next.Input() {
+	case "^", "_", "/", "|", ",":
+		return fn
+	}
+	if len(fn.Token.Input()) == 1 && p.input[next.Pos] != '(' {
+		return fn
+	}
+	arg := p.parseSexpr()
+	if arg == nil {
+		return fn
+	}
+	return &ast.FunctionApplication{Func: fn, Arg: arg}
+}
+
+// parseArg parses the argument of op, which should be present at offset at.
+func (p *parser) parseArg(op scanner.Token, at int) ast.Node {
+	arg := p.parseSexpr()
+	if arg == nil {
+		arg = p.missing(op, at, "argument")
+	}
+	return arg
+}
+
+// parseText parses "..." or text(...).  As in ASCIIMathML the text extends to
+// the first closing delimiter, brackets inside it are not matched.
+func (p *parser) parseText() ast.Node {
+	tok := p.next()
+	if tok.Input() == `"` {
+		value, end := p.readRaw(tok, tok.End, '"')
+		return &ast.Text{Token: tok, Value: value, EndPos: end}
+	}
+	start, delim := p.rawStart()
+	if delim == 0 {
+		fix := Fix{Msg: "insert text", Pos: tok.End, End: tok.End, Text: "()"}
+		p.diagnose(tok.Pos, tok.End, fix, "missing text for %q", tok.Input())
+		return &ast.Text{Token: tok, EndPos: tok.End}
+	}
+	value, end := p.readRaw(tok, start, delim)
+	return &ast.Text{Token: tok, Value: value, EndPos: end}
+}
+
+// parseStyle parses color, id or class followed by an attribute value and an
+// expression.  As in ASCIIMathML the value is read like a text, e.g. the
+// value of color(#00ff00)(x) is #00ff00.
+func (p *parser) parseStyle() ast.Node {
+	op := p.next()
+	var value string
+	end := op.End
+	if start, delim := p.rawStart(); delim != 0 {
+		value, end = p.readRaw(op, start, delim)
+	} else {
+		fix := Fix{Msg: "insert value", Pos: op.End, End: op.End, Text: "()"}
+		p.diagnose(op.Pos, op.End, fix, "missing value for %q", op.Input())
+	}
+	attrs := map[string]string{ast.StyleAttr(op): value}
+	return &ast.Style{Op: op, Attrs: attrs, Arg: p.parseArg(op, end)}
+}
+
+// rawStart returns the offset following the next token if it starts with (,
+// [ or {, and the matching closing delimiter.  It returns 0 as delimiter
+// otherwise.
+func (p *parser) rawStart() (int, byte) {
+	next := p.peek()
+	if next == nil {
+		return 0, 0
+	}
+	switch p.input[next.Pos] {
+	case '(':
+		return next.Pos + 1, ')'
+	case '[':
+		return next.Pos + 1, ']'
+	case '{':
+		return next.Pos + 1, '}'
+	}
+	return 0, 0
+}
+
+// readRaw reads the input from start to the first delim and skips the tokens
+// up to it.  It returns the input read and the offset following delim.  A
+// missing delim is reported for tok and the input is read to its end.
+func (p *parser) readRaw(tok scanner.Token, start int, delim byte) (string, int) {
+	length := strings.IndexByte(p.input[start:], delim)
+	if length == -1 {
+		end := len(p.input)
+		fix := Fix{Msg: fmt.Sprintf("insert %q", delim), Pos: end, End: end, Text: string(delim)}
+		p.diagnose(tok.Pos, end, fix, "missing closing %q for %q", delim, tok.Input())
+		p.pos = len(p.tokens)
+		return p.input[start:], end
+	}
+	end := start + length + 1
+	for p.pos < len(p.tokens) && p.tokens[p.pos].Pos < end {
+		p.pos++
+	}
+	return p.input[start : end-1], end
+}
@@ -0,0 +1,729 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// dump returns a compact representation of a syntax tree for tests.
+func dump(n ast.Node) string {
+	switch n := n.(type) {
+	case *ast.Row:
+		items := make([]string, len(n.Items))
+		for i, item := range n.Items {
+			items[i] = dump(item)
+		}
+		return "[" + strings.Join(items, " ") + "]"
+	case *ast.Constant:
+		return n.Token.Input()
+	case *ast.Error:
+		return "!" + n.Token.Input()
+	case *ast.Placeholder:
+		return "?"
+	case *ast.Text:
+		return fmt.Sprintf("%q", n.Value)
+	case *ast.Unary:
+		return fmt.Sprintf("(%s %s)", n.Op.Input(), dump(n.Arg))
+	case *ast.Style:
+		return fmt.Sprintf("(%s[%s] %s)", n.Op.Input(), n.Value(), dump(n.Arg))
+	case *ast.Binary:
+		return fmt.Sprintf("(%s %s %s)", n.Op.Input(), dump(n.Arg1), dump(n.Arg2))
+	case *ast.Frac:
+		return fmt.Sprintf("(/ %s %s)", dump(n.Num), dump(n.Den))
+	case *ast.FunctionApplication:
+		return fmt.Sprintf("(apply %s %s)", dump(n.Func), dump(n.Arg))
+	case *ast.Script:
+		op := "^"
+		args := dump(n.Base)
+		switch {
+		case n.Sub != nil && n.Sup != nil:
+			op = "_^"
+			args += " " + dump(n.Sub) + " " + dump(n.Sup)
+		case n.Sub != nil:
+			op = "_"
+			args += " " + dump(n.Sub)
+		default:
+			args += " " + dump(n.Sup)
+		}
+		return fmt.Sprintf("(%s %s)", op, args)
+	case *ast.Fenced:
+		return fmt.Sprintf("%s%s%s", n.Open.Input(), dump(n.Body), n.Close.Input())
+	case *ast.Matrix:
+		rows := make([]string, len(n.Rows))
+		for i, row := range n.Rows {
+			rows[i] = dump(row)
+		}
+		return fmt.Sprintf("(matrix %s %s %s)", n.Open.Input(), strings.Join(rows, " "), n.Close.Input())
+	case *ast.MatrixRow:
+		cells := make([]string, len(n.Cells))
+		for i, cell := range n.Cells {
+			cells[i] = dump(cell)
+		}
+		return n.Open.Input() + strings.Join(cells, ",") + n.Close.Input()
+	case *ast.EquationArray:
+		lines := make([]string, len(n.Lines))
+		for i, line := range n.Lines {
+			lines[i] = dump(line)
+		}
+		return strings.Join(lines, "\n")
+	case *ast.EquationLine:
+		marker := ""
+		if n.Marker.IsValid() {
+			marker = "& "
+		}
+		return fmt.Sprintf("%s %s%s", dump(n.Left), marker, dump(n.Right))
+	case nil:
+		return "nil"
+	default:
+		return fmt.Sprintf("<%T>", n)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  "[]",
+		},
+		{
+			name:  "simple expression",
+			input: "2xx3+4",
+			want:  "[2 xx 3 + 4]",
+		},
+		{
+			name:  "brackets",
+			input: "(a+b)c",
+			want:  "[([a + b]) c]",
+		},
+		{
+			name:  "invisible brackets",
+			input: "{:a:}",
+			want:  "[{:[a]:}]",
+		},
+		{
+			name:  "unary",
+			input: "sqrt x",
+			want:  "[(sqrt x)]",
+		},
+		{
+			name:  "binary",
+			input: "frac(a)(b)",
+			want:  "[(frac ([a]) ([b]))]",
+		},
+		{
+			name:  "fraction",
+			input: "a^2/b",
+			want:  "[(/ (^ a 2) b)]",
+		},
+		{
+			name:  "subscript and superscript",
+			input: "x_i^2",
+			want:  "[(_^ x i 2)]",
+		},
+		{
+			name:  "negative exponent",
+			input: "x^-1",
+			want:  "[(^ x [- 1])]",
+		},
+		{
+			name:  "function with argument in brackets",
+			input: "f(x)+sin(x)",
+			want:  "[(apply f ([x])) + (apply sin ([x]))]",
+		},
+		{
+			name:  "function without brackets",
+			input: "sin x+f x",
+			want:  "[(apply sin x) + f x]",
+		},
+		{
+			name:  "function with superscript",
+			input: "sin^2 x+f^2(x)+f^2 x",
+			want:  "[(apply (^ sin 2) x) + (apply (^ f 2) ([x])) + (^ f 2) x]",
+		},
+		{
+			name:  "applied function with superscript",
+			input: "sin x^2 + 1",
+			want:  "[(^ (apply sin x) 2) + 1]",
+		},
+		{
+			name:  "function with subscript",
+			input: "log_2 x",
+			want:  "[(apply (_ log 2) x)]",
+		},
+		{
+			name:  "function without argument",
+			input: "(f, g) + sin/x + h(cos)",
+			want:  "[([f , g]) + (/ sin x) + h ([cos])]",
+		},
+		{
+			name:  "nested functions",
+			input: "ln sin x",
+			want:  "[(apply ln (apply sin x))]",
+		},
+		{
+			name:  "quoted text",
+			input: `"a (b" c`,
+			want:  `["a (b" c]`,
+		},
+		{
+			name:  "text",
+			input: "text(a [b)c",
+			want:  `["a [b" c]`,
+		},
+		{
+			name:  "font",
+			input: "bb x + cc(AB)",
+			want:  "[(bb[bold] x) + (cc[script] ([A B]))]",
+		},
+		{
+			name:  "color",
+			input: "color(#f00)(x+1) y",
+			want:  "[(color[#f00] ([x + 1])) y]",
+		},
+		{
+			name:  "class and id",
+			input: "class[a b]{id{x}y}",
+			want:  "[(class[a b] {[(id[x] y)]})]",
+		},
+		{
+			name:  "definition",
+			input: "int x dx",
+			want:  "[int x {:[d x]:}]",
+		},
+		{
+			name:    "unmatched right bracket",
+			input:   "a)",
+			want:    "[a !)]",
+			wantErr: true,
+		},
+		{
+			name:  "any right bracket closes any left bracket",
+			input: "[a,b)",
+			want:  "[[[a , b])]",
+		},
+		{
+			name:    "missing right bracket",
+			input:   "(a",
+			want:    "[([a]]",
+			wantErr: true,
+		},
+		{
+			name:    "missing right brackets",
+			input:   "{:(a+b",
+			want:    "[{:[([a + b]]]",
+			wantErr: true,
+		},
+		{
+			name:  "absolute value",
+			input: "|x|+1",
+			want:  "[|[x]| + 1]",
+		},
+		{
+			name:  "nested absolute values",
+			input: "||x||",
+			want:  "[|[|[x]|]|]",
+		},
+		{
+			name:  "absolute value in brackets",
+			input: "(|x|)",
+			want:  "[([|[x]|])]",
+		},
+		{
+			name:  "divides",
+			input: "a|b",
+			want:  "[a | b]",
+		},
+		{
+			name:  "divides in brackets",
+			input: "(a|b)",
+			want:  "[([a | b])]",
+		},
+		{
+			name:  "bar followed by comma",
+			input: "{x|x>0|,y}",
+			want:  "[{[x | x > 0 | , y]}]",
+		},
+		{
+			name:    "bars in unclosed brackets",
+			input:   "|(a|b",
+			want:    "[| ([a | b]]",
+			wantErr: true,
+		},
+		{
+			name:  "bars as arguments",
+			input: "|sqrt|x|,y|",
+			want:  "[| (sqrt |) x |[, y]|]",
+		},
+		{
+			name:  "matrix",
+			input: "[(a,b),(c,d)]",
+			want:  "[(matrix [ ([a],[b]) ([c],[d]) ])]",
+		},
+		{
+			name:  "column vector",
+			input: "((x),(y+1))",
+			want:  "[(matrix ( ([x]) ([y + 1]) ))]",
+		},
+		{
+			name:  "single row matrix",
+			input: "[[1,2,3]]",
+			want:  "[(matrix [ [[1],[2],[3]] ])]",
+		},
+		{
+			name:  "cases",
+			input: "{(x, x>=0),(-x, x<0):}",
+			want:  "[(matrix { ([x],[x >= 0]) ([- x],[x < 0]) :})]",
+		},
+		{
+			name:  "rows with different lengths",
+			input: "[(a,b),(c)]",
+			want:  "[[[([a , b]) , ([c])]]]",
+		},
+		{
+			name:  "rows with different brackets",
+			input: "[(a,b),[c,d]]",
+			want:  "[[[([a , b]) , [[c , d]]]]]",
+		},
+		{
+			name:  "round rows in braces",
+			input: "{(a,b),(c,d)}",
+			want:  "[{[([a , b]) , ([c , d])]}]",
+		},
+		{
+			name:  "single cell",
+			input: "((x))",
+			want:  "[([([x])])]",
+		},
+		{
+			name:    "missing color",
+			input:   "color x y",
+			want:    "[(color[] x) y]",
+			wantErr: true,
+		},
+		{
+			name:    "missing argument",
+			input:   "sqrt",
+			want:    "[(sqrt ?)]",
+			wantErr: true,
+		},
+		{
+			name:    "missing second argument",
+			input:   "frac(a)",
+			want:    "[(frac ([a]) ?)]",
+			wantErr: true,
+		},
+		{
+			name:    "missing denominator",
+			input:   "(a/)",
+			want:    "[([(/ a ?)])]",
+			wantErr: true,
+		},
+		{
+			name:    "missing superscript",
+			input:   "e^",
+			want:    "[(^ e ?)]",
+			wantErr: true,
+		},
+		{
+			name:  "stray infix",
+			input: "_x + x^2^3 + a/b/c",
+			want:  "[_ x + (^ x 2) ^ 3 + (/ a b) / c]",
+		},
+		{
+			name:    "unterminated text",
+			input:   `"abc`,
+			want:    `["abc"]`,
+			wantErr: true,
+		},
+		{
+			name:    "text without brackets",
+			input:   "text x",
+			want:    `["" x]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if dump(got) != tt.want {
+				t.Errorf("Parse() = %s, want %s", dump(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_limits(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		want        string
+		wantLimits  ast.Limits
+		wantInline  bool
+		wantDisplay bool
+	}{
+		{
+			name:        "sum",
+			input:       "sum_(i=1)^n",
+			want:        "(_^ sum ([i = 1]) n)",
+			wantLimits:  ast.MovableLimits,
+			wantDisplay: true,
+		},
+		{
+			name:        "lim",
+			input:       "lim_(x->0)",
+			want:        "(_ lim ([x -> 0]))",
+			wantLimits:  ast.MovableLimits,
+			wantDisplay: true,
+		},
+		{
+			name:        "bigcup",
+			input:       "uuu^n",
+			want:        "(^ uuu n)",
+			wantLimits:  ast.MovableLimits,
+			wantDisplay: true,
+		},
+		{
+			name:        "ubrace",
+			input:       "ubrace(x+y)_(n)",
+			want:        "(_ (ubrace ([x + y])) ([n]))",
+			wantLimits:  ast.FixedLimits,
+			wantInline:  true,
+			wantDisplay: true,
+		},
+		{
+			name:       "ordinary script",
+			input:      "x_1^2",
+			want:       "(_^ x 1 2)",
+			wantLimits: ast.NoLimits,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			script, ok := got.(*ast.Row).Items[0].(*ast.Script)
+			if !ok {
+				t.Fatalf("Parse() = %s, want a script", dump(got))
+			}
+			if dump(script) != tt.want {
+				t.Errorf("Parse() = %s, want %s", dump(script), tt.want)
+			}
+			if script.Limits != tt.wantLimits {
+				t.Errorf("Limits = %v, want %v", script.Limits, tt.wantLimits)
+			}
+			if script.UnderOver(false) != tt.wantInline {
+				t.Errorf("UnderOver(false) = %v, want %v", script.UnderOver(false), tt.wantInline)
+			}
+			if script.UnderOver(true) != tt.wantDisplay {
+				t.Errorf("UnderOver(true) = %v, want %v", script.UnderOver(true), tt.wantDisplay)
+			}
+		})
+	}
+}
+
+func TestParse_diagnostics(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      []string
+		wantFixed string
+	}{
+		{
+			name:      "unmatched right bracket",
+			input:     "a)+b",
+			want:      []string{`1-2: unexpected ")"`},
+			wantFixed: "a+b",
+		},
+		{
+			name:      "missing right bracket",
+			input:     "a+(b-[c)",
+			want:      []string{`2-3: missing closing bracket for "("`},
+			wantFixed: "a+(b-[c))",
+		},
+		{
+			name:      "missing argument",
+			input:     "1+sqrt",
+			want:      []string{`2-6: missing argument for "sqrt"`},
+			wantFixed: "1+sqrt()",
+		},
+		{
+			name:      "missing second argument",
+			input:     "frac(a)",
+			want:      []string{`0-4: missing argument for "frac"`},
+			wantFixed: "frac(a)()",
+		},
+		{
+			name:      "missing root",
+			input:     "root(3)",
+			want:      []string{`0-4: missing argument for "root"`},
+			wantFixed: "root(3)()",
+		},
+		{
+			name:      "missing argument after color",
+			input:     "color(red)",
+			want:      []string{`0-5: missing argument for "color"`},
+			wantFixed: "color(red)()",
+		},
+		{
+			name:      "missing color",
+			input:     "color x",
+			want:      []string{`0-5: missing value for "color"`},
+			wantFixed: "color() x",
+		},
+		{
+			name:      "missing denominator",
+			input:     "(1/)",
+			want:      []string{`2-3: missing denominator for "/"`},
+			wantFixed: "(1/())",
+		},
+		{
+			name:      "unterminated text",
+			input:     `x "abc`,
+			want:      []string{`2-6: missing closing '"' for "\""`},
+			wantFixed: `x "abc"`,
+		},
+		{
+			name:  "several problems",
+			input: "a)+(b",
+			want:  []string{`1-2: unexpected ")"`, `3-4: missing closing bracket for "("`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := Parse(tt.input)
+			if node == nil {
+				t.Fatalf("Parse() = nil")
+			}
+			diags, ok := err.(Diagnostics)
+			if !ok {
+				t.Fatalf("Parse() error = %v, want Diagnostics", err)
+			}
+			var got []string
+			for _, d := range diags {
+				got = append(got, fmt.Sprintf("%d-%d: %s", d.Pos, d.End, d.Msg))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() diagnostics = %q, want %q", got, tt.want)
+			}
+			if tt.wantFixed == "" {
+				return
+			}
+			fixed := diags[0].Fix.Apply(tt.input)
+			if fixed != tt.wantFixed {
+				t.Errorf("Fix.Apply() = %q, want %q", fixed, tt.wantFixed)
+			}
+			if _, err := Parse(fixed); err != nil {
+				t.Errorf("Parse(%q) error = %v", fixed, err)
+			}
+		})
+	}
+}
+
+// TestParse_placeholders checks that a missing argument is placed after the
+// arguments before it.
+func TestParse_placeholders(t *testing.T) {
+	for _, input := range []string{"frac(a)", "root(3)", "color(red)", "x_1^"} {
+		node, _ := Parse(input)
+		item := node.(*ast.Row).Items[0]
+		var at int
+		ast.Inspect(item, func(n ast.Node) bool {
+			if ph, ok := n.(*ast.Placeholder); ok {
+				at = ph.At
+			}
+			return true
+		})
+		if at != len(input) || item.End() != len(input) {
+			t.Errorf("Parse(%q): placeholder at %d, end %d, want %d", input, at, item.End(), len(input))
+		}
+	}
+}
+
+// TestParse_bars checks that a "|" that does not open an absolute value does
+// not make the parser go through the expression after it again.
+func TestParse_bars(t *testing.T) {
+	for _, input := range []string{
+		strings.Repeat("|(", 22),
+		strings.Repeat("|(", 1000),
+		strings.Repeat("|sqrt", 1000),
+		strings.Repeat("|x|,", 1000),
+	} {
+		start := time.Now()
+		Parse(input)
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("Parse(%.10q...) took %v", input, d)
+		}
+	}
+}
+
+func TestParseBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "derivation",
+			input: "(a+b)^2 = (a+b)(a+b)\n  = a^2+2ab+b^2\n",
+			want:  "[(^ ([a + b]) 2)] [= ([a + b]) ([a + b])]\n[] [= (^ a 2) + 2 a b + (^ b 2)]",
+		},
+		{
+			name:  "first relation outside brackets",
+			input: "f(x=1) <= 2 < 3",
+			want:  "[(apply f ([x = 1]))] [<= 2 < 3]",
+		},
+		{
+			name:  "explicit marker",
+			input: "x & := y\n\nx+1 &",
+			want:  "[x] & [:= y]\n[x + 1] & []",
+		},
+		{
+			name:  "no alignment point",
+			input: "a+b\n=c",
+			want:  "[a + b] []\n[] [= c]",
+		},
+		{
+			name:  "bars on an earlier line",
+			input: "|a|b\n(x y)\n|a+b|=c\n(x) = y",
+			want:  "[|[a]| b] []\n[([x y])] []\n[|[a + b]|] [= c]\n[([x])] [= y]",
+		},
+		{
+			name:    "errors",
+			input:   "a = b\nc = (d",
+			want:    "[a] [= b]\n[c] [= ([d]]",
+			wantErr: `10: missing closing bracket for "("`,
+		},
+		{
+			name:    "unterminated text",
+			input:   "a = \"b\n= c",
+			want:    "[a] [= \"b\"]\n[] [= c]",
+			wantErr: `4: missing closing '"' for "\""`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBlock(tt.input)
+			if (err != nil || tt.wantErr != "") && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ParseBlock() error = %v, want %q", err, tt.wantErr)
+			}
+			if dump(got) != tt.want {
+				t.Errorf("ParseBlock() = %s, want %s", dump(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	long := strings.Repeat("sqrt ", 1000) + "x"
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		input   string
+		opts    Options
+		wantErr string
+	}{
+		{
+			name:  "within limits",
+			ctx:   context.Background(),
+			input: "sqrt((x))",
+			opts:  Options{MaxInputLength: 9, MaxTokens: 6, MaxDepth: 4},
+		},
+		{
+			name:    "input too long",
+			ctx:     context.Background(),
+			input:   "sqrt((x))",
+			opts:    Options{MaxInputLength: 8},
+			wantErr: "input too long: 9 bytes, maximum is 8",
+		},
+		{
+			name:    "too many tokens",
+			ctx:     context.Background(),
+			input:   "sqrt((x))",
+			opts:    Options{MaxTokens: 5},
+			wantErr: "8: too many tokens, maximum is 5",
+		},
+		{
+			name:    "nested brackets",
+			ctx:     context.Background(),
+			input:   "sqrt((x))",
+			opts:    Options{MaxDepth: 3},
+			wantErr: "6: nesting too deep, maximum is 3",
+		},
+		{
+			name:    "nested functions",
+			ctx:     context.Background(),
+			input:   "sin^2 sin^2 sin^2 x",
+			opts:    Options{MaxDepth: 3},
+			wantErr: "18: nesting too deep, maximum is 3",
+		},
+		{
+			name:    "deep input",
+			ctx:     context.Background(),
+			input:   long,
+			opts:    Options{MaxDepth: 100},
+			wantErr: "500: nesting too deep, maximum is 100",
+		},
+		{
+			name:    "canceled",
+			ctx:     canceled,
+			input:   "x",
+			wantErr: "canceled: context canceled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := ParseContext(tt.ctx, tt.input, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil || node == nil {
+					t.Errorf("ParseContext() = %v, %v", node, err)
+				}
+				return
+			}
+			if node != nil || err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ParseContext() = %v, %v, want nil, %s", node, err, tt.wantErr)
+			}
+		})
+	}
+	var canceledErr *scanner.CanceledError
+	if _, err := ParseContext(canceled, "x", Options{}); !errors.As(err, &canceledErr) {
+		t.Errorf("ParseContext() error = %v, want *scanner.CanceledError", err)
+	}
+}
+
+func TestParseContext_work(t *testing.T) {
+	// Each "|" is tried as an absolute value and goes through the
+	// following "_" or "^" again.
+	input := strings.Repeat("|x_|y^", 10) + "z"
+	opts := Options{MaxTokens: 61}
+	if _, err := ParseContext(context.Background(), input, opts); err != nil {
+		t.Fatalf("ParseContext() error = %v", err)
+	}
+	defer func(f int) { workFactor = f }(workFactor)
+	workFactor = 1
+	_, err := ParseContext(context.Background(), input, opts)
+	var workErr *TooMuchWorkError
+	if !errors.As(err, &workErr) || workErr.Max != 61 {
+		t.Errorf("ParseContext() error = %v, want *TooMuchWorkError", err)
+	}
+}
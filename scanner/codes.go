@@ -0,0 +1,34 @@
+package scanner
+
+// codes are the AMbbb, AMcal and AMfrk tables of ASCIIMathML: the letters
+// A-Z then a-z of the double-struck, script and fraktur alphabets.
+var codes = [][]string{
+	AMbbb: alphabet(0x1D538, 0x1D552, map[byte]rune{
+		'C': 'ℂ', 'H': 'ℍ', 'N': 'ℕ', 'P': 'ℙ', 'Q': 'ℚ', 'R': 'ℝ', 'Z': 'ℤ',
+	}),
+	AMcal: alphabet(0x1D49C, 0x1D4B6, map[byte]rune{
+		'B': 'ℬ', 'E': 'ℰ', 'F': 'ℱ', 'H': 'ℋ', 'I': 'ℐ', 'L': 'ℒ', 'M': 'ℳ', 'R': 'ℛ',
+		'e': 'ℯ', 'g': 'ℊ', 'o': 'ℴ',
+	}),
+	AMfrk: alphabet(0x1D504, 0x1D51E, map[byte]rune{
+		'C': 'ℭ', 'H': 'ℌ', 'I': 'ℑ', 'R': 'ℜ', 'Z': 'ℨ',
+	}),
+}
+
+// alphabet returns the letters of a Mathematical Alphanumeric Symbols
+// alphabet starting at upper and lower.  Letters that Unicode encodes in the
+// Letterlike Symbols block instead are given by holes.
+func alphabet(upper, lower rune, holes map[byte]rune) []string {
+	letters := make([]string, 52)
+	for i := range letters {
+		c, r := byte('A'+i), upper+rune(i)
+		if i >= 26 {
+			c, r = byte('a'+i-26), lower+rune(i-26)
+		}
+		if hole, ok := holes[c]; ok {
+			r = hole
+		}
+		letters[i] = string(r)
+	}
+	return letters
+}
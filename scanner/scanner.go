@@ -1,9 +1,12 @@
 package scanner
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 type Scanner struct {
@@ -11,27 +14,113 @@ type Scanner struct {
 }
 
 func (s *Scanner) Tokenise(input string) ([]string, error) {
-	var tokens []string
-	start := 0
-	end := 0
-	for _, match := range s.ptn.FindAllStringIndex(input, len(input)) {
-		start = match[0]
-		if end != start {
+	tokens, err := s.Scan(input)
+	if err != nil {
+		return nil, err
+	}
+	strs := make([]string, len(tokens))
+	for i, tok := range tokens {
+		strs[i] = input[tok.Pos:tok.End]
+	}
+	return strs, nil
+}
+
+// Scan splits the input into tokens, skipping whitespace.
+func (s *Scanner) Scan(input string) ([]Token, error) {
+	return s.ScanContext(context.Background(), input, Options{})
+}
+
+// Options limit the resources used to scan untrusted input.  A zero value
+// means no limit.
+type Options struct {
+	MaxInputLength int // maximum length of the input in bytes
+	MaxTokens      int // maximum number of tokens, not counting whitespace
+}
+
+// An InputTooLongError is returned when the input is longer than allowed.
+type InputTooLongError struct {
+	Length int
+	Max    int
+}
+
+func (e *InputTooLongError) Error() string {
+	return fmt.Sprintf("input too long: %d bytes, maximum is %d", e.Length, e.Max)
+}
+
+// A TooManyTokensError is returned when the input has more tokens than
+// allowed.  Pos is the offset of the first token over the limit.
+type TooManyTokensError struct {
+	Pos int
+	Max int
+}
+
+func (e *TooManyTokensError) Error() string {
+	return fmt.Sprintf("%d: too many tokens, maximum is %d", e.Pos, e.Max)
+}
+
+// A CanceledError is returned when processing was stopped because a context
+// was done.  Err is the error of the context.
+type CanceledError struct {
+	Err error
+}
+
+func (e *CanceledError) Error() string {
+	return "canceled: " + e.Err.Error()
+}
+
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
+// CheckInterval is the number of tokens processed between two checks for the
+// cancellation of a context.
+const CheckInterval = 256
+
+// ScanContext is like Scan but enforces the limits in opts and stops with a
+// CanceledError if ctx is done before the end of the input.
+func (s *Scanner) ScanContext(ctx context.Context, input string, opts Options) ([]Token, error) {
+	if opts.MaxInputLength > 0 && len(input) > opts.MaxInputLength {
+		return nil, &InputTooLongError{Length: len(input), Max: opts.MaxInputLength}
+	}
+	var tokens []Token
+	for start, count := 0, 0; start < len(input); count++ {
+		if count%CheckInterval == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, &CanceledError{Err: ctx.Err()}
+			default:
+			}
+		}
+		match := s.ptn.FindStringIndex(input[start:])
+		if match == nil {
 			return nil, errors.New("cannot tokenise")
 		}
-		end = match[1]
+		end := start + match[1]
 		firstByte := input[start]
-		if firstByte == ' ' || firstByte == '\t' || firstByte == '\n' || firstByte == '\r' {
-			continue
+		if firstByte != ' ' && firstByte != '\t' && firstByte != '\n' && firstByte != '\r' {
+			if opts.MaxTokens > 0 && len(tokens) == opts.MaxTokens {
+				return nil, &TooManyTokensError{Pos: start, Max: opts.MaxTokens}
+			}
+			tokens = append(tokens, Token{Symbol: Lookup(input[start:end]), Pos: start, End: end})
 		}
-		tokens = append(tokens, input[start:end])
-	}
-	if end != len(input) {
-		return nil, errors.New("cannot tokenise")
+		start = end
 	}
 	return tokens, nil
 }
 
+// New returns a Scanner recognising all the symbols in AMsymbols.
+func New() *Scanner {
+	defaultOnce.Do(func() {
+		defaultScanner = newScanner()
+	})
+	return defaultScanner
+}
+
+var (
+	defaultOnce    sync.Once
+	defaultScanner *Scanner
+)
+
 func newScanner() *Scanner {
 	options := []string{
 		`\s+`,
@@ -41,7 +130,7 @@ func newScanner() *Scanner {
 	for _, s := range AMsymbols {
 		options = append(options, regexp.QuoteMeta(s.input))
 	}
-	ptn := regexp.MustCompile(strings.Join(options, "|"))
+	ptn := regexp.MustCompile(`^(?:` + strings.Join(options, "|") + `)`)
 	ptn.Longest()
 	return &Scanner{
 		ptn: ptn,
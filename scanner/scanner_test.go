@@ -1,6 +1,8 @@
 package scanner
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -77,3 +79,65 @@ func TestScanner_Tokenise(t *testing.T) {
 		})
 	}
 }
+
+func TestScanner_ScanContext(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		input   string
+		opts    Options
+		want    int
+		wantErr string
+	}{
+		{
+			name:  "within limits",
+			ctx:   context.Background(),
+			input: "x + y",
+			opts:  Options{MaxInputLength: 5, MaxTokens: 3},
+			want:  3,
+		},
+		{
+			name:    "input too long",
+			ctx:     context.Background(),
+			input:   "x + y",
+			opts:    Options{MaxInputLength: 4},
+			wantErr: "input too long: 5 bytes, maximum is 4",
+		},
+		{
+			name:    "too many tokens",
+			ctx:     context.Background(),
+			input:   "x + y",
+			opts:    Options{MaxTokens: 2},
+			wantErr: "4: too many tokens, maximum is 2",
+		},
+		{
+			name:    "canceled",
+			ctx:     canceled,
+			input:   "x + y",
+			wantErr: "canceled: context canceled",
+		},
+	}
+	s := newScanner()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ScanContext(tt.ctx, tt.input, tt.opts)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("Scanner.ScanContext() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scanner.ScanContext() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("Scanner.ScanContext() = %d tokens, want %d", len(got), tt.want)
+			}
+		})
+	}
+	if _, err := s.ScanContext(canceled, "x", Options{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("errors.Is(%v, context.Canceled) = false", err)
+	}
+}
@@ -38,10 +38,12 @@ const (
 	UNARYUNDEROVER = 15
 )
 
+// Values of the codes field of symbols, naming the alphabets of AMbbb, AMcal
+// and AMfrk in ASCIIMathML.  Symbols without codes have 0.
 const (
-	AMbbb = 0
-	AMcal = 1
-	AMfrk = 2
+	AMbbb = iota + 1
+	AMcal
+	AMfrk
 )
 
 var AMquote = Symbol{input: "\"", tag: "mtext", output: "mbox", ttype: TEXT}
@@ -0,0 +1,101 @@
+package scanner
+
+// A Token is a symbol found in the input, together with its location.  Pos is
+// the byte offset of the first character of the token and End the offset just
+// after its last character.
+type Token struct {
+	Symbol *Symbol
+	Pos    int
+	End    int
+}
+
+// Input returns the input string of the token's symbol, or "" for the zero
+// Token.
+func (t Token) Input() string {
+	if t.Symbol == nil {
+		return ""
+	}
+	return t.Symbol.input
+}
+
+// Type returns the type of the token's symbol, or -1 for the zero Token.
+func (t Token) Type() int {
+	if t.Symbol == nil {
+		return -1
+	}
+	return t.Symbol.ttype
+}
+
+// IsValid returns true if the token is not the zero Token.
+func (t Token) IsValid() bool {
+	return t.Symbol != nil
+}
+
+// Lookup returns the symbol whose input is s.  If there is no such symbol, it
+// returns a symbol of type CONST, tagged "mn" if s is a number, "mi" if s is an
+// ASCII letter and "mo" otherwise, as ASCIIMathML does.
+func Lookup(s string) *Symbol {
+	if sym, ok := symbolsByInput[s]; ok {
+		return sym
+	}
+	tag := "mo"
+	switch c := s[0]; {
+	case c >= '0' && c <= '9':
+		tag = "mn"
+	case len(s) == 1 && (c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'):
+		tag = "mi"
+	}
+	return &Symbol{input: s, tag: tag, output: s, ttype: CONST}
+}
+
+var symbolsByInput = make(map[string]*Symbol, len(AMsymbols))
+
+func init() {
+	for i := range AMsymbols {
+		symbolsByInput[AMsymbols[i].input] = &AMsymbols[i]
+	}
+}
+
+func (s *Symbol) Input() string               { return s.input }
+func (s *Symbol) Tag() string                 { return s.tag }
+func (s *Symbol) Output() string              { return s.output }
+func (s *Symbol) Type() int                   { return s.ttype }
+func (s *Symbol) IsFunc() bool                { return s.isFunc }
+func (s *Symbol) RewriteLeftRight() [2]string { return s.rewriteLeftRight }
+func (s *Symbol) Tex() string                 { return s.tex }
+func (s *Symbol) Invisible() bool             { return s.invisible }
+func (s *Symbol) Acc() bool                   { return s.acc }
+func (s *Symbol) AttrName() string            { return s.atname }
+func (s *Symbol) AttrValue() string           { return s.atval }
+func (s *Symbol) NoTexCopy() bool             { return s.notexcopy }
+
+// Codes returns the characters that replace the letters A-Z and a-z in the
+// argument of a font symbol such as bbb, or nil if the letters are kept.
+func (s *Symbol) Codes() []string { return codes[s.codes] }
+
+var typeNames = []string{
+	CONST:          "CONST",
+	UNARY:          "UNARY",
+	BINARY:         "BINARY",
+	INFIX:          "INFIX",
+	LEFTBRACKET:    "LEFTBRACKET",
+	RIGHTBRACKET:   "RIGHTBRACKET",
+	SPACE:          "SPACE",
+	UNDEROVER:      "UNDEROVER",
+	DEFINITION:     "DEFINITION",
+	LEFTRIGHT:      "LEFTRIGHT",
+	TEXT:           "TEXT",
+	BIG:            "BIG",
+	LONG:           "LONG",
+	STRETCHY:       "STRETCHY",
+	MATRIX:         "MATRIX",
+	UNARYUNDEROVER: "UNARYUNDEROVER",
+}
+
+// TypeName returns the name of a token type, e.g. "CONST".
+func TypeName(ttype int) string {
+	if ttype < 0 || ttype >= len(typeNames) {
+		return ""
+	}
+	return typeNames[ttype]
+}
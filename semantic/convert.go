@@ -0,0 +1,467 @@
+package semantic
+
+import (
+	"fmt"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// An Error reports a part of the input that cannot be converted to an
+// expression, e.g. an operator without operands.
+type Error struct {
+	Pos int
+	End int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Pos, e.Msg)
+}
+
+// Parse parses an ASCIIMath expression and converts it to an expression
+// tree.
+func Parse(input string) (Expr, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return FromAST(node)
+}
+
+// FromAST converts a syntax tree to an expression tree, as described in the
+// package documentation.  It returns an *Error if the syntax tree contains
+// errors or placeholders or if operators are missing operands.
+func FromAST(node ast.Node) (expr Expr, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*Error)
+			if !ok {
+				panic(r)
+			}
+			expr, err = nil, e
+		}
+	}()
+	return convert(node), nil
+}
+
+// levels maps the output of operator symbols to their level.
+var levels = map[string]Level{
+	"⇔": Iff, // <=>
+	"⇒": Implies, "⇐": Implies, "⊢": Implies, "⊨": Implies,
+	"or": Or, "∨": Or,
+	"and": And, "∧": And,
+	"¬": Not,
+
+	"=": Relation, "≠": Relation, ":=": Relation, "<": Relation, "≤": Relation,
+	">": Relation, "≥": Relation, "≺": Relation, "≻": Relation,
+	"⪯": Relation, "⪰": Relation, "∈": Relation, "∉": Relation,
+	"⊂": Relation, "⊃": Relation, "⊆": Relation, "⊇": Relation,
+	"≡": Relation, "≅": Relation, "≈": Relation, "∝": Relation,
+	"|": Relation, "↑": Relation, "↓": Relation, "→": Relation,
+	"↣": Relation, "↠": Relation, "⤖": Relation, "↦": Relation,
+	"←": Relation, "↔": Relation,
+
+	"+": Additive, "-": Additive, "±": Additive, "∪": Additive,
+	"\\": Additive, "⊕": Additive,
+
+	"⋅": Multiplicative, "∗": Multiplicative, "⋆": Multiplicative,
+	"/": Multiplicative, "×": Multiplicative, "÷": Multiplicative,
+	"∘": Multiplicative, "⊗": Multiplicative, "⊙": Multiplicative,
+	"∩": Multiplicative, "mod": Multiplicative, "⋉": Multiplicative,
+	"⋊": Multiplicative, "⋈": Multiplicative,
+}
+
+// levelOf returns the level of node if it is an operator.
+func levelOf(node ast.Node) (Level, bool) {
+	c, ok := node.(*ast.Constant)
+	if !ok || c.Token.Symbol.Tag() == "mn" || c.Token.Symbol.Tag() == "mi" {
+		return 0, false
+	}
+	level, ok := levels[c.Token.Symbol.Output()]
+	return level, ok
+}
+
+// isSign returns true if node can be a prefix sign.
+func isSign(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	if !ok {
+		return false
+	}
+	switch c.Token.Input() {
+	case "-", "+", "+-":
+		return true
+	}
+	return false
+}
+
+// isBigOp returns true if tok is a big operator such as sum or int.
+func isBigOp(tok scanner.Token) bool {
+	switch tok.Type() {
+	case scanner.UNDEROVER:
+		return true
+	case scanner.CONST:
+		switch tok.Symbol.Output() {
+		case "∫", "∮": // int, oint
+			return true
+		}
+	}
+	return false
+}
+
+// bigOpOf returns the big operator token of node if it is a big operator or a
+// script of one.
+func bigOpOf(node ast.Node) (scanner.Token, bool) {
+	if s, ok := node.(*ast.Script); ok {
+		node = s.Base
+	}
+	if c, ok := node.(*ast.Constant); ok && isBigOp(c.Token) {
+		return c.Token, true
+	}
+	return scanner.Token{}, false
+}
+
+func fail(node ast.Node, format string, args ...interface{}) {
+	panic(&Error{Pos: node.Pos(), End: node.End(), Msg: fmt.Sprintf(format, args...)})
+}
+
+func spanOf(node ast.Node) Span {
+	return Span{From: node.Pos(), To: node.End()}
+}
+
+func join(a, b Expr) Span {
+	return Span{From: a.Pos(), To: b.End()}
+}
+
+// convert converts a node on its own.
+func convert(node ast.Node) Expr {
+	switch n := node.(type) {
+	case *ast.Row:
+		return convertItems(n.Items, n)
+	case *ast.Constant:
+		if _, ok := levelOf(n); ok {
+			fail(n, "missing operands for %q", n.Token.Input())
+		}
+		if _, ok := bigOpOf(n); ok {
+			return &BigOp{Span: spanOf(n), Op: n.Token}
+		}
+		if n.Token.Symbol.Tag() == "mn" {
+			return &Number{Span: spanOf(n), Token: n.Token}
+		}
+		return &Symbol{Span: spanOf(n), Token: n.Token}
+	case *ast.Text:
+		return &Opaque{Span: spanOf(n), Node: n}
+	case *ast.Error:
+		fail(n, "unexpected %q", n.Token.Input())
+	case *ast.Placeholder:
+		fail(n, "missing argument")
+	case *ast.Unary:
+		return &Call{
+			Span: spanOf(n),
+			Func: &Symbol{Span: Span{n.Op.Pos, n.Op.End}, Token: n.Op},
+			Args: []Expr{convert(n.Arg)},
+		}
+	case *ast.Style:
+		if n.Op.Symbol.AttrName() == "" {
+			return convert(n.Arg)
+		}
+		return &Call{
+			Span: spanOf(n),
+			Func: &Symbol{Span: Span{n.Op.Pos, n.Op.End}, Token: n.Op},
+			Args: []Expr{convert(n.Arg)},
+		}
+	case *ast.Binary:
+		if n.Op.Input() == "frac" {
+			return &Infix{Span: spanOf(n), Op: n.Op, Level: Multiplicative, Left: convert(n.Arg1), Right: convert(n.Arg2)}
+		}
+		return &Call{
+			Span: spanOf(n),
+			Func: &Symbol{Span: Span{n.Op.Pos, n.Op.End}, Token: n.Op},
+			Args: []Expr{convert(n.Arg1), convert(n.Arg2)},
+		}
+	case *ast.Frac:
+		return &Infix{Span: spanOf(n), Op: n.Slash, Level: Multiplicative, Left: convert(n.Num), Right: convert(n.Den)}
+	case *ast.FunctionApplication:
+		call := &Call{Span: spanOf(n), Func: convert(n.Func)}
+		if f, ok := n.Arg.(*ast.Fenced); ok && f.Open.Input() == "(" && f.Close.Input() == ")" {
+			call.Args = convertList(f.Body)
+		} else {
+			call.Args = []Expr{convert(n.Arg)}
+		}
+		return call
+	case *ast.Script:
+		if op, ok := bigOpOf(n); ok {
+			return convertBigOp(n, op)
+		}
+		var expr Expr = convert(n.Base)
+		if n.Sub != nil {
+			index := convert(n.Sub)
+			expr = &Subscript{Span: join(expr, index), Base: expr, Index: index}
+		}
+		if n.Sup != nil {
+			exp := convert(n.Sup)
+			expr = &Power{Span: join(expr, exp), Base: expr, Exponent: exp}
+		}
+		return expr
+	case *ast.Fenced:
+		return convertFenced(n)
+	case *ast.Matrix:
+		m := &Matrix{Span: spanOf(n), Open: n.Open, Close: n.Close}
+		for _, row := range n.Rows {
+			cells := make([]Expr, len(row.Cells))
+			for i, cell := range row.Cells {
+				cells[i] = convert(cell)
+			}
+			m.Rows = append(m.Rows, cells)
+		}
+		return m
+	}
+	fail(node, "unexpected %T", node)
+	return nil
+}
+
+func convertBigOp(n *ast.Script, op scanner.Token) *BigOp {
+	b := &BigOp{Span: spanOf(n), Op: op}
+	if n.Sub != nil {
+		b.Lower = convert(n.Sub)
+	}
+	if n.Sup != nil {
+		b.Upper = convert(n.Sup)
+	}
+	return b
+}
+
+func convertFenced(n *ast.Fenced) Expr {
+	if n.Invisible() {
+		items := n.Body.Items
+		if len(items) == 2 {
+			if d, ok := items[0].(*ast.Constant); ok && d.Token.Input() == "d" {
+				if v, ok := items[1].(*ast.Constant); ok && v.Token.Symbol.Tag() == "mi" {
+					return &Differential{Span: spanOf(n), Var: convert(v)}
+				}
+			}
+		}
+	}
+	if n.Open.Type() == scanner.LEFTRIGHT {
+		return &Call{
+			Span: spanOf(n),
+			Func: &Symbol{Span: Span{n.Open.Pos, n.Open.End}, Token: n.Open},
+			Args: []Expr{convert(n.Body)},
+		}
+	}
+	items := convertList(n.Body)
+	if len(items) == 1 {
+		switch n.Open.Input() {
+		case "(", "[", "{", "{:":
+			// The brackets are part of the grouped expression.
+			*items[0].span() = spanOf(n)
+			return items[0]
+		}
+	}
+	return &Tuple{Span: spanOf(n), Open: n.Open, Items: items, Close: n.Close}
+}
+
+// convertList converts the comma separated items of row.
+func convertList(row *ast.Row) []Expr {
+	var exprs []Expr
+	start := 0
+	for i, item := range row.Items {
+		if isComma(item) {
+			exprs = append(exprs, convertPart(row.Items[start:i], item))
+			start = i + 1
+		}
+	}
+	if start > 0 || len(row.Items) > 0 {
+		exprs = append(exprs, convertPart(row.Items[start:], row))
+	}
+	return exprs
+}
+
+// convertPart converts items between commas, reporting an empty list at
+// node.
+func convertPart(items []ast.Node, node ast.Node) Expr {
+	if len(items) == 0 {
+		fail(node, "missing expression")
+	}
+	p := &exprParser{items: items}
+	expr := p.parseIff()
+	if p.pos < len(items) {
+		fail(items[p.pos], "unexpected %q", tokenOf(items[p.pos]))
+	}
+	return expr
+}
+
+// convertItems converts the items of a row.  Comma separated items become a
+// Tuple without brackets.
+func convertItems(items []ast.Node, node ast.Node) Expr {
+	n := 0
+	for _, item := range items {
+		if isComma(item) {
+			n++
+		}
+	}
+	if n == 0 {
+		return convertPart(items, node)
+	}
+	return &Tuple{Span: spanOf(node), Items: convertList(&ast.Row{Items: items})}
+}
+
+func isComma(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == ","
+}
+
+func tokenOf(node ast.Node) string {
+	if c, ok := node.(*ast.Constant); ok {
+		return c.Token.Input()
+	}
+	return fmt.Sprintf("%T", node)
+}
+
+// An exprParser parses the items of a row by precedence.
+type exprParser struct {
+	items []ast.Node
+	pos   int
+}
+
+// peekOp returns the next item if it is an operator at the given level.
+func (p *exprParser) peekOp(level Level) (*ast.Constant, bool) {
+	if p.pos >= len(p.items) {
+		return nil, false
+	}
+	item := p.items[p.pos]
+	if l, ok := levelOf(item); !ok || l != level {
+		return nil, false
+	}
+	return item.(*ast.Constant), true
+}
+
+// binary parses a left associative level, whose operands are parsed by next.
+func (p *exprParser) binary(level Level, next func() Expr) Expr {
+	left := next()
+	for {
+		op, ok := p.peekOp(level)
+		if !ok {
+			return left
+		}
+		p.pos++
+		right := p.operand(op, next)
+		left = &Infix{Span: join(left, right), Op: op.Token, Level: level, Left: left, Right: right}
+	}
+}
+
+// operand parses the operand of op with next.
+func (p *exprParser) operand(op *ast.Constant, next func() Expr) Expr {
+	if p.pos >= len(p.items) {
+		fail(op, "missing operand for %q", op.Token.Input())
+	}
+	return next()
+}
+
+func (p *exprParser) parseIff() Expr {
+	return p.binary(Iff, p.parseImplies)
+}
+
+func (p *exprParser) parseImplies() Expr {
+	left := p.parseOr()
+	op, ok := p.peekOp(Implies)
+	if !ok {
+		return left
+	}
+	p.pos++
+	right := p.operand(op, p.parseImplies)
+	return &Infix{Span: join(left, right), Op: op.Token, Level: Implies, Left: left, Right: right}
+}
+
+func (p *exprParser) parseOr() Expr {
+	return p.binary(Or, p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() Expr {
+	return p.binary(And, p.parseNot)
+}
+
+func (p *exprParser) parseNot() Expr {
+	op, ok := p.peekOp(Not)
+	if !ok {
+		return p.parseRelation()
+	}
+	p.pos++
+	arg := p.operand(op, p.parseNot)
+	return &Prefix{Span: Span{op.Pos(), arg.End()}, Op: op.Token, Level: Not, Arg: arg}
+}
+
+func (p *exprParser) parseRelation() Expr {
+	operands := []Expr{p.parseAdditive()}
+	var ops []scanner.Token
+	chain := true
+	for {
+		op, ok := p.peekOp(Relation)
+		if !ok {
+			break
+		}
+		p.pos++
+		operands = append(operands, p.operand(op, p.parseAdditive))
+		ops = append(ops, op.Token)
+		chain = chain && ast.IsRelation(op.Token)
+	}
+	if len(ops) >= 2 && chain {
+		return &RelationChain{Span: join(operands[0], operands[len(operands)-1]), Operands: operands, Ops: ops}
+	}
+	left := operands[0]
+	for i, op := range ops {
+		right := operands[i+1]
+		left = &Infix{Span: join(left, right), Op: op, Level: Relation, Left: left, Right: right}
+	}
+	return left
+}
+
+func (p *exprParser) parseAdditive() Expr {
+	return p.binary(Additive, p.parseMultiplicative)
+}
+
+func (p *exprParser) parseMultiplicative() Expr {
+	return p.binary(Multiplicative, p.parseSign)
+}
+
+func (p *exprParser) parseSign() Expr {
+	if p.pos >= len(p.items) || !isSign(p.items[p.pos]) {
+		return p.parseImplicit()
+	}
+	op := p.items[p.pos].(*ast.Constant)
+	p.pos++
+	arg := p.operand(op, p.parseSign)
+	return &Prefix{Span: Span{op.Pos(), arg.End()}, Op: op.Token, Level: Sign, Arg: arg}
+}
+
+func (p *exprParser) parseImplicit() Expr {
+	left := p.parseAtom()
+	for p.pos < len(p.items) {
+		if _, ok := levelOf(p.items[p.pos]); ok {
+			break
+		}
+		right := p.parseAtom()
+		left = &Infix{Span: join(left, right), Level: Implicit, Left: left, Right: right}
+	}
+	return left
+}
+
+func (p *exprParser) parseAtom() Expr {
+	if p.pos >= len(p.items) {
+		fail(p.items[len(p.items)-1], "missing operand")
+	}
+	item := p.items[p.pos]
+	if _, ok := levelOf(item); ok {
+		fail(item, "unexpected %q", tokenOf(item))
+	}
+	p.pos++
+	expr := convert(item)
+	if b, ok := expr.(*BigOp); ok && p.pos < len(p.items) {
+		if _, ok := levelOf(p.items[p.pos]); !ok {
+			b.Body = p.parseMultiplicative()
+			b.To = b.Body.End()
+		}
+	}
+	return expr
+}
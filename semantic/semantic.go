@@ -0,0 +1,221 @@
+// Package semantic builds expression trees with the usual precedence and
+// associativity of operators from ASCIIMath syntax trees.
+//
+// The syntax tree built by the parser follows the presentation grammar of
+// ASCIIMathML, in which a+b*c is a flat row.  FromAST converts it to an
+// expression tree in which a+b*c is a sum whose right operand is a product.
+// Operators are recognised by their output in AMsymbols, so synonyms such as
+// <= and lt= are the same operator.  From loosest to tightest, the levels of
+// operators are:
+//
+//	Iff             <=>                                        left
+//	Implies         => |-- |==                                 right
+//	Or              or vv                                      left
+//	And             and ^^                                     left
+//	Not             not (prefix)
+//	Relation        = != < <= > >= -< >- in !in sub sube ...   chain
+//	                -= ~= ~~ prop :=
+//	                | -> |-> and other arrows                  left
+//	Additive        + - +- uu setminus o+                      left
+//	Multiplicative  * ** *** // xx -: @ ox o. nn mod ...       left
+//	                / and frac
+//	Sign            - + +- (prefix)
+//	Implicit        juxtaposition, as in 2x or a b            left
+//
+// Two or more relations in a row whose symbols are all = < > or binary
+// relation symbols of AMsymbols (the first two lines of Relation above) form
+// a RelationChain: 0 < x <= 1 is the chain of 0, x and 1.  Other relations, such
+// as arrows, are left associative.
+//
+// Other nodes are converted as follows:
+//
+//   - Numbers become Number and other constants Symbol.
+//   - Brackets around a single expression only group it, except for |x|
+//     which is a Call of "|".  Other brackets, and comma separated lists,
+//     become Tuple, e.g. (a, b) or [0, 1).
+//   - Scripts become Subscript and Power; x_i^2 is the power of x_i.
+//   - Function applications (sin x, f(x, y), log_2 x), unary symbols (sqrt x,
+//     abs x, hat x) and binary symbols other than frac (root(3)(x)) become
+//     Call.  Arguments of functions in round brackets are split at commas.
+//     Single letters which are not functions in ASCIIMathML are not
+//     functions: h(x) is the product of h and x.
+//   - Big operators (sum, prod, int, lim, uuu...) become BigOp, with the
+//     operand that follows at the multiplicative level as body: in
+//     sum_i a_i b_i + c, the body is a_i b_i.
+//   - dx, dy... and {:d x:} become Differential.
+//   - Matrices become Matrix.
+//   - Font symbols (bb x, cc A) become Call.  Colors, ids and classes are
+//     ignored: color(red)(x) is x.
+//   - Texts become Opaque.
+package semantic
+
+import (
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// An Expr is a node of an expression tree.  Its span is the part of the
+// input it was converted from.
+type Expr interface {
+	Pos() int
+	End() int
+	span() *Span
+}
+
+// A Span is the location of an expression in the input.
+type Span struct {
+	From int
+	To   int
+}
+
+func (s Span) Pos() int { return s.From }
+func (s Span) End() int { return s.To }
+
+func (s *Span) span() *Span { return s }
+
+// Level is the precedence level of an operator.  Higher levels bind tighter.
+type Level int
+
+const (
+	Iff Level = iota + 1
+	Implies
+	Or
+	And
+	Not
+	Relation
+	Additive
+	Multiplicative
+	Sign
+	Implicit
+)
+
+// A Number is a numeric literal.
+type Number struct {
+	Span
+	Token scanner.Token
+}
+
+// A Symbol is any other constant: a variable, a greek letter, oo...
+type Symbol struct {
+	Span
+	Token scanner.Token
+}
+
+// An Infix is a binary operator applied to two operands.  Op is the zero
+// Token for implicit multiplication.
+type Infix struct {
+	Span
+	Op    scanner.Token
+	Level Level
+	Left  Expr
+	Right Expr
+}
+
+// A RelationChain is a sequence of two or more relations sharing their
+// operands, e.g. 0 < x <= 1 or a = b = c.  Ops[i] relates Operands[i] and
+// Operands[i+1].
+type RelationChain struct {
+	Span
+	Operands []Expr
+	Ops      []scanner.Token
+}
+
+// Links returns the relations of the chain as Infix expressions: the links
+// of 0 < x <= 1 are 0 < x and x <= 1.
+func (n *RelationChain) Links() []*Infix {
+	links := make([]*Infix, len(n.Ops))
+	for i, op := range n.Ops {
+		left, right := n.Operands[i], n.Operands[i+1]
+		links[i] = &Infix{Span: join(left, right), Op: op, Level: Relation, Left: left, Right: right}
+	}
+	return links
+}
+
+// A Prefix is a sign or "not" applied to an operand.
+type Prefix struct {
+	Span
+	Op    scanner.Token
+	Level Level
+	Arg   Expr
+}
+
+// A Power is an expression with a superscript.
+type Power struct {
+	Span
+	Base     Expr
+	Exponent Expr
+}
+
+// A Subscript is an expression with a subscript.
+type Subscript struct {
+	Span
+	Base  Expr
+	Index Expr
+}
+
+// A Call is a function applied to its arguments.  Func is a Symbol for the
+// function symbol, e.g. sin or sqrt, or a Power or Subscript of it, e.g.
+// sin^2.
+type Call struct {
+	Span
+	Func Expr
+	Args []Expr
+}
+
+// A Tuple is a list of expressions between brackets, which are zero Tokens
+// for a comma separated list without brackets.
+type Tuple struct {
+	Span
+	Open  scanner.Token
+	Items []Expr
+	Close scanner.Token
+}
+
+// A Matrix is a matrix of expressions.
+type Matrix struct {
+	Span
+	Open  scanner.Token
+	Rows  [][]Expr
+	Close scanner.Token
+}
+
+// A BigOp is a big operator such as sum or lim with optional limits and
+// body.
+type BigOp struct {
+	Span
+	Op    scanner.Token
+	Lower Expr
+	Upper Expr
+	Body  Expr
+}
+
+// A Differential is d followed by a variable, as in dx.
+type Differential struct {
+	Span
+	Var Expr
+}
+
+// An Opaque is a part of the syntax tree with no meaning as an expression,
+// such as a text.
+type Opaque struct {
+	Span
+	Node ast.Node
+}
+
+// Name returns the token of the function symbol of n, or the zero Token if
+// the function is not a symbol.
+func (n *Call) Name() scanner.Token {
+	fn := n.Func
+	for {
+		switch f := fn.(type) {
+		case *Power:
+			fn = f.Base
+		case *Subscript:
+			fn = f.Base
+		case *Symbol:
+			return f.Token
+		default:
+			return scanner.Token{}
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package semantic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// dump returns a compact representation of an expression tree for tests.
+func dump(e Expr) string {
+	list := func(exprs []Expr) string {
+		strs := make([]string, len(exprs))
+		for i, expr := range exprs {
+			strs[i] = dump(expr)
+		}
+		return strings.Join(strs, " ")
+	}
+	switch e := e.(type) {
+	case *Number:
+		return e.Token.Input()
+	case *Symbol:
+		return e.Token.Input()
+	case *Infix:
+		op := e.Op.Input()
+		if op == "" {
+			op = "."
+		}
+		return fmt.Sprintf("(%s %s %s)", op, dump(e.Left), dump(e.Right))
+	case *RelationChain:
+		strs := []string{dump(e.Operands[0])}
+		for i, op := range e.Ops {
+			strs = append(strs, op.Input(), dump(e.Operands[i+1]))
+		}
+		return "(chain " + strings.Join(strs, " ") + ")"
+	case *Prefix:
+		return fmt.Sprintf("(%s %s)", e.Op.Input(), dump(e.Arg))
+	case *Power:
+		return fmt.Sprintf("(^ %s %s)", dump(e.Base), dump(e.Exponent))
+	case *Subscript:
+		return fmt.Sprintf("(_ %s %s)", dump(e.Base), dump(e.Index))
+	case *Call:
+		return fmt.Sprintf("(call %s %s)", dump(e.Func), list(e.Args))
+	case *Tuple:
+		return fmt.Sprintf("%s%s%s", e.Open.Input(), list(e.Items), e.Close.Input())
+	case *Matrix:
+		rows := make([]string, len(e.Rows))
+		for i, row := range e.Rows {
+			rows[i] = "[" + list(row) + "]"
+		}
+		return "(matrix " + strings.Join(rows, " ") + ")"
+	case *BigOp:
+		return fmt.Sprintf("(%s %s %s %s)", e.Op.Input(), dump(e.Lower), dump(e.Upper), dump(e.Body))
+	case *Differential:
+		return "(d " + dump(e.Var) + ")"
+	case *Opaque:
+		return fmt.Sprintf("<%T>", e.Node)
+	case nil:
+		return "nil"
+	}
+	return fmt.Sprintf("<%T>", e)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "precedence",
+			input: "a+b*c",
+			want:  "(+ a (* b c))",
+		},
+		{
+			name:  "left associativity",
+			input: "a-b+c -: d xx e",
+			want:  "(+ (- a b) (xx (-: c d) e))",
+		},
+		{
+			name:  "brackets",
+			input: "(a+b)*c",
+			want:  "(* (+ a b) c)",
+		},
+		{
+			name:  "implicit multiplication",
+			input: "2x y + 3 -: 4z",
+			want:  "(+ (. (. 2 x) y) (-: 3 (. 4 z)))",
+		},
+		{
+			name:  "fractions",
+			input: "a/b c + frac(1)(2)",
+			want:  "(+ (. (/ a b) c) (frac 1 2))",
+		},
+		{
+			name:  "signs",
+			input: "-a^2 + -2x = +- b",
+			want:  "(= (+ (- (^ a 2)) (- (. 2 x))) (+- b))",
+		},
+		{
+			name:  "negative exponent",
+			input: "x^-1",
+			want:  "(^ x (- 1))",
+		},
+		{
+			name:  "scripts",
+			input: "x_i^2 + x_(i+1)",
+			want:  "(+ (^ (_ x i) 2) (_ x (+ i 1)))",
+		},
+		{
+			name:  "relations",
+			input: "a+1 lt= b and c != d",
+			want:  "(and (lt= (+ a 1) b) (!= c d))",
+		},
+		{
+			name:  "relation chains",
+			input: "0 < x+1 lt= 1 and a = b = c",
+			want:  "(and (chain 0 < (+ x 1) lt= 1) (chain a = b = c))",
+		},
+		{
+			name:  "arrows are not chained",
+			input: "a -> b = c",
+			want:  "(= (-> a b) c)",
+		},
+		{
+			name:  "logic",
+			input: "not p or q => r => s <=> t",
+			want:  "(<=> (=> (or (not p) q) (=> r s)) t)",
+		},
+		{
+			name:  "functions",
+			input: "sin x + f(x, y) + log_2 x + sin^2 x",
+			want:  "(+ (+ (+ (call sin x) (call f x y)) (call (_ log 2) x)) (call (^ sin 2) x))",
+		},
+		{
+			name:  "not a function",
+			input: "h(x+1)",
+			want:  "(. h (+ x 1))",
+		},
+		{
+			name:  "unary and binary symbols",
+			input: "sqrt x + root(3)(y) + |z| + abs(w)",
+			want:  "(+ (+ (+ (call sqrt x) (call root 3 y)) (call | z)) (call abs w))",
+		},
+		{
+			name:  "tuples",
+			input: "(a, b) in [0, 1)",
+			want:  "(in (a b) [0 1))",
+		},
+		{
+			name:  "comma list",
+			input: "x, y+1",
+			want:  "x (+ y 1)",
+		},
+		{
+			name:  "sum",
+			input: "sum_(i=1)^n a_i b_i + c",
+			want:  "(+ (sum (= i 1) n (. (_ a i) (_ b i))) c)",
+		},
+		{
+			name:  "integral",
+			input: "int_0^1 x^2 dx",
+			want:  "(int 0 1 (. (^ x 2) (d x)))",
+		},
+		{
+			name:  "limit",
+			input: "lim_(x->0) sin x / x",
+			want:  "(lim (-> x 0) nil (/ (call sin x) x))",
+		},
+		{
+			name:  "matrix",
+			input: "[(1,a),(b+c,2)]",
+			want:  "(matrix [1 a] [(+ b c) 2])",
+		},
+		{
+			name:  "styles",
+			input: "bb v + color(red)(a+b) c",
+			want:  "(+ (call bb v) (. (+ a b) c))",
+		},
+		{
+			name:  "text",
+			input: `x "if" y`,
+			want:  "(. (. x <*ast.Text>) y)",
+		},
+		{
+			name:    "missing operand",
+			input:   "a+",
+			wantErr: `1: missing operand for "+"`,
+		},
+		{
+			name:    "operator alone",
+			input:   "x^=",
+			wantErr: `2: missing operands for "="`,
+		},
+		{
+			name:    "unexpected operator",
+			input:   "a*=b",
+			wantErr: `2: unexpected "="`,
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: "0: missing expression",
+		},
+		{
+			name:    "syntax error",
+			input:   "a+(b",
+			wantErr: `2: missing closing bracket for "("`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("Parse() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if dump(got) != tt.want {
+				t.Errorf("Parse() = %s, want %s", dump(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSpans(t *testing.T) {
+	e, err := Parse("a + b*(c-d)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := e.(*Infix)
+	if sum.Pos() != 0 || sum.End() != 11 {
+		t.Errorf("span = %d:%d, want 0:11", sum.Pos(), sum.End())
+	}
+	diff := sum.Right.(*Infix).Right
+	if diff.Pos() != 6 || diff.End() != 11 {
+		t.Errorf("span = %d:%d, want 6:11", diff.Pos(), diff.End())
+	}
+}
+
+func TestRelationChain_Links(t *testing.T) {
+	e, err := Parse("0 < x <= 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, ok := e.(*RelationChain)
+	if !ok {
+		t.Fatalf("Parse() = %T, want *RelationChain", e)
+	}
+	var got []string
+	for _, link := range chain.Links() {
+		got = append(got, fmt.Sprintf("%s %d:%d", dump(link), link.Pos(), link.End()))
+	}
+	want := []string{"(< 0 x) 0:5", "(<= x 1) 4:10"}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("Links() = %v, want %v", got, want)
+	}
+}
+
+// TestParse_nesting checks that relations are parsed once, however deeply
+// they are nested.
+func TestParse_nesting(t *testing.T) {
+	n := 200
+	input := strings.Repeat("a=(", n) + "a" + strings.Repeat(")", n)
+	start := time.Now()
+	e, err := Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Parse() took %v", d)
+	}
+	for i := 0; i < n; i++ {
+		eq, ok := e.(*Infix)
+		if !ok || eq.Level != Relation {
+			t.Fatalf("Parse() = %s at depth %d, want a relation", dump(e), i)
+		}
+		e = eq.Right
+	}
+	if dump(e) != "a" {
+		t.Errorf("Parse() = %s at depth %d, want a", dump(e), n)
+	}
+}
@@ -0,0 +1,196 @@
+package speech
+
+// English reads math in English, mostly as ClearSpeak does at the Verbose
+// level and as MathSpeak does at the Brief and Superbrief levels.
+var English = &Rules{
+	Symbols:  englishSymbols,
+	Phrases:  englishPhrases,
+	Fraction: englishFraction,
+}
+
+// englishSymbols has the words for the symbols of AMsymbols that are read
+// on their own, and for the characters that ASCIIMath passes through.
+var englishSymbols = map[string]string{
+	// Greek letters.
+	"alpha": "alpha", "beta": "beta", "chi": "chi", "delta": "delta",
+	"Delta": "cap delta", "epsi": "epsilon", "varepsilon": "epsilon",
+	"eta": "eta", "gamma": "gamma", "Gamma": "cap gamma", "iota": "iota",
+	"kappa": "kappa", "lambda": "lambda", "Lambda": "cap lambda",
+	"lamda": "lambda", "Lamda": "cap lambda", "mu": "mu", "nu": "nu",
+	"omega": "omega", "Omega": "cap omega", "phi": "phi", "varphi": "phi",
+	"Phi": "cap phi", "pi": "pi", "Pi": "cap pi", "psi": "psi",
+	"Psi": "cap psi", "rho": "rho", "sigma": "sigma", "Sigma": "cap sigma",
+	"tau": "tau", "theta": "theta", "vartheta": "theta",
+	"Theta": "cap theta", "upsilon": "upsilon", "xi": "xi", "Xi": "cap xi",
+	"zeta": "zeta",
+
+	// Operators.
+	"+": "plus", "-": "minus", "*": "times", "**": "star", "***": "star",
+	"//": "slash", "/": "slash", "\\\\": "set minus", "setminus": "set minus",
+	"xx": "times", "|><": "left semidirect product",
+	"><|": "right semidirect product", "|><|": "bowtie", "-:": "divided by",
+	"@": "composed with", "o+": "circle plus", "ox": "circle times",
+	"o.": "circle dot", "^^": "and", "vv": "or", "nn": "intersect",
+	"uu": "union", "+-": "plus or minus", "!": "factorial",
+
+	// Big operators.
+	"sum": "[the] sum", "prod": "[the] product", "^^^": "[the] logical and",
+	"vvv": "[the] logical or", "nnn": "[the] intersection",
+	"uuu": "[the] union", "int": "[the] integral",
+	"oint": "[the] contour integral", "lim": "[the] limit",
+	"Lim": "[the] limit", "min": "[the] minimum", "max": "[the] maximum",
+
+	// Relations.
+	"=": "equals", "!=": "[is] not equal to", ":=": "is defined as",
+	"<": "[is] less than", "lt": "[is] less than",
+	"<=": "[is] less than or equal to", "lt=": "[is] less than or equal to",
+	">": "[is] greater than", ">=": "[is] greater than or equal to",
+	"gt": "[is] greater than", "gt=": "[is] greater than or equal to",
+	"-<": "precedes", "-lt": "precedes", ">-": "succeeds", "-<=": "precedes or equals",
+	">-=": "succeeds or equals", "in": "[is] an element of",
+	"!in": "[is] not an element of", "sub": "[is] a subset of",
+	"sup": "[is] a superset of", "sube": "[is] a subset of or equal to",
+	"supe": "[is] a superset of or equal to", "-=": "[is] equivalent to",
+	"~=": "[is] congruent to", "~~": "[is] approximately equal to",
+	"prop": "[is] proportional to",
+
+	// Logic.
+	"and": "and", "or": "or", "if": "if", "not": "not", "=>": "implies",
+	"<=>": "if and only if", "AA": "for all", "EE": "there exists",
+	"_|_": "bottom", "TT": "top", "|--": "proves", "|==": "models",
+
+	// Brackets.
+	"(": "open paren", ")": "close paren", "[": "open bracket",
+	"]": "close bracket", "{": "open brace", "}": "close brace",
+	"|": "divides", ":|:": "divides", "|:": "open bar", ":|": "close bar",
+	"(:": "open angle bracket", ":)": "close angle bracket",
+	"<<": "open angle bracket", ">>": "close angle bracket", "{:": "",
+	":}": "",
+
+	// Miscellaneous symbols.
+	"del": "partial", "grad": "del", "O/": "[the] empty set",
+	"oo": "infinity", "aleph": "aleph", "...": "dot dot dot",
+	":.": "therefore", ":'": "because", "/_": "angle", "/_\\": "triangle",
+	"'": "prime", "\\ ": "", "frown": "frown", "quad": "", "qquad": "",
+	"cdots": "dot dot dot", "vdots": "vertical dots",
+	"ddots": "diagonal dots", "diamond": "diamond", "square": "square",
+	"|__": "left floor", "__|": "right floor", "|~": "left ceiling",
+	"~|": "right ceiling", "CC": "[the] complex numbers",
+	"NN": "[the] natural numbers", "QQ": "[the] rational numbers",
+	"RR": "[the] real numbers", "ZZ": "[the] integers",
+	",": "comma", ";": "semicolon", ":": "colon", ".": "point",
+	"%": "percent", "?": "question mark", "&": "",
+
+	// Functions.
+	"sin": "sine", "cos": "cosine", "tan": "tangent", "sinh": "hyperbolic sine",
+	"cosh": "hyperbolic cosine", "tanh": "hyperbolic tangent",
+	"cot": "cotangent", "sec": "secant", "csc": "cosecant",
+	"arcsin": "arc sine", "arccos": "arc cosine", "arctan": "arc tangent",
+	"coth": "hyperbolic cotangent", "sech": "hyperbolic secant",
+	"csch": "hyperbolic cosecant", "exp": "exponential", "log": "log",
+	"ln": "natural log", "det": "determinant", "dim": "dimension",
+	"mod": "mod", "gcd": "[the] greatest common divisor",
+	"lcm": "[the] least common multiple", "lub": "[the] least upper bound",
+	"glb": "[the] greatest lower bound", "Sin": "sine", "Cos": "cosine",
+	"Tan": "tangent", "Arcsin": "arc sine", "Arccos": "arc cosine",
+	"Arctan": "arc tangent", "Sinh": "hyperbolic sine",
+	"Cosh": "hyperbolic cosine", "Tanh": "hyperbolic tangent",
+	"Cot": "cotangent", "Sec": "secant", "Csc": "cosecant", "Log": "log",
+	"Ln": "natural log",
+
+	// Arrows.
+	"uarr": "up arrow", "darr": "down arrow", "rarr": "right arrow",
+	"->": "goes to", ">->": "injects into", "->>": "maps onto",
+	">->>": "maps bijectively to", "|->": "maps to", "larr": "left arrow",
+	"harr": "left right arrow", "rArr": "implies", "lArr": "is implied by",
+	"hArr": "if and only if",
+}
+
+var englishPhrases = map[string][3]string{
+	"capital":  {"cap %s", "cap %s", "%s"},
+	"negative": {"negative", "negative", "negative"},
+
+	"fraction":      {"the fraction with numerator %s and denominator %s", "start fraction %s over %s end fraction", "frac %s over %s end frac"},
+	"over":          {"%s over %s", "%s over %s", "%s over %s"},
+	"squared":       {"%s squared", "%s squared", "%s squared"},
+	"cubed":         {"%s cubed", "%s cubed", "%s cubed"},
+	"power":         {"%s to the power of %s", "%s to the %s", "%s sup %s"},
+	"end exponent":  {", end exponent", " end exponent", " end sup"},
+	"subscript":     {"%s sub %s", "%s sub %s", "%s sub %s"},
+	"end subscript": {", end subscript", " end sub", " end sub"},
+	"sqrt":          {"the square root of %s", "square root of %s", "root %s"},
+	"cube root":     {"the cube root of %[2]s", "cube root of %[2]s", "cube root %[2]s"},
+	"root":          {"the root of index %s of %s", "root index %s of %s", "index %s root %s"},
+	"end root":      {", end root", " end root", " end root"},
+
+	"limits":      {"%s from %s to %s", "%s from %s to %s", "%s from %s to %s"},
+	"lower limit": {"%s over %s", "%s over %s", "%s over %s"},
+	"upper limit": {"%s to %s", "%s to %s", "%s to %s"},
+	"approaches":  {"%s as %s approaches %s", "%s as %s approaches %s", "%s %s to %s"},
+	"above":       {"%s with %s above", "%s with %s above", "%s over %s"},
+	"below":       {"%s with %s below", "%s with %s below", "%s under %s"},
+	"apply":       {"%s of %s", "%s of %s", "%s %s"},
+
+	"abs":   {"the absolute value of %s", "absolute value %s end absolute value", "abs %s end abs"},
+	"norm":  {"the norm of %s", "norm %s end norm", "norm %s end norm"},
+	"floor": {"the floor of %s", "floor %s end floor", "floor %s end floor"},
+	"ceil":  {"the ceiling of %s", "ceiling %s end ceiling", "ceiling %s end ceiling"},
+
+	"hat":            {"%s hat", "%s hat", "%s hat"},
+	"bar":            {"%s bar", "%s bar", "%s bar"},
+	"vec":            {"vector %s", "vector %s", "vector %s"},
+	"dot":            {"%s dot", "%s dot", "%s dot"},
+	"ddot":           {"%s double dot", "%s double dot", "%s double dot"},
+	"tilde":          {"%s tilde", "%s tilde", "%s tilde"},
+	"overarc":        {"arc %s", "arc %s", "arc %s"},
+	"ul":             {"%s underlined", "%s underlined", "%s underlined"},
+	"ubrace":         {"%s with underbrace", "%s with underbrace", "%s underbrace"},
+	"obrace":         {"%s with overbrace", "%s with overbrace", "%s overbrace"},
+	"ubrace labeled": {"%s with underbrace labeled %s", "%s with underbrace labeled %s", "%s underbrace %s"},
+	"obrace labeled": {"%s with overbrace labeled %s", "%s with overbrace labeled %s", "%s overbrace %s"},
+	"cancel":         {"%s crossed out", "cancel %s end cancel", "cancel %s end cancel"},
+
+	"bold":          {"bold %s", "bold %s", "bold %s"},
+	"double-struck": {"double-struck %s", "double-struck %s", "double-struck %s"},
+	"script":        {"script %s", "script %s", "script %s"},
+	"fraktur":       {"fraktur %s", "fraktur %s", "fraktur %s"},
+	"sans-serif":    {"sans-serif %s", "sans-serif %s", "sans-serif %s"},
+	"monospace":     {"monospace %s", "monospace %s", "monospace %s"},
+
+	"matrix":          {"the %d by %d matrix", "%d by %d matrix", "%d by %d matrix"},
+	"end matrix":      {"end matrix", "end matrix", "end matrix"},
+	"determinant":     {"the %d by %d determinant", "%d by %d determinant", "%d by %d determinant"},
+	"end determinant": {"end determinant", "end determinant", "end determinant"},
+	"row":             {"row %d: %s", "row %d %s", "row %d %s"},
+	"cases":           {"%d cases", "%d cases", "%d cases"},
+	"end cases":       {"end cases", "end cases", "end cases"},
+	"case":            {"case %d: %s", "case %d %s", "case %d %s"},
+	"line":            {"line %d: %s", "%[2]s", "%[2]s"},
+}
+
+var cardinals = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+	"nine", "ten",
+}
+
+var ordinals = []string{
+	"", "", "half", "third", "fourth", "fifth", "sixth", "seventh", "eighth",
+	"ninth", "tenth",
+}
+
+// englishFraction reads the fractions of whole numbers up to ten as one
+// half, two thirds and so on.
+func englishFraction(num, den int) string {
+	if num < 1 || num > 10 || den < 2 || den > 10 {
+		return ""
+	}
+	ord := ordinals[den]
+	switch {
+	case num == 1:
+	case den == 2:
+		ord = "halves"
+	default:
+		ord += "s"
+	}
+	return cardinals[num] + " " + ord
+}
@@ -0,0 +1,476 @@
+// Package speech reads syntax trees aloud, as text for a screen reader or a
+// speech synthesizer: frac(1)(2) is "one half", x^2 is "x squared" and
+// sum_(i=1)^n is "the sum from i equals 1 to n".
+//
+// The words come from a set of Rules, which has the words for the symbols of
+// AMsymbols and the phrases for fractions, scripts, roots and the other nodes
+// of the syntax tree at three levels of verbosity.  At the Verbose level,
+// the phrases are those of ordinary speech, while the Brief and Superbrief
+// levels are shorter and say where fractions, roots and scripts end.  English
+// is the only set of rules for now.
+//
+// Style nodes for fonts are read as the name of the font before their
+// argument, except for the double-struck letters of number sets which are
+// read as their sets, and Style nodes for colors, ids and classes are read
+// as their argument.  EquationArray nodes are read line by line.
+package speech
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Verbosity is the level of detail of the words for an expression.
+type Verbosity int
+
+// The levels of verbosity, from the most to the least detailed.
+const (
+	Verbose Verbosity = iota
+	Brief
+	Superbrief
+)
+
+// Rules are the words of a language for math.
+type Rules struct {
+	// Symbols maps the inputs of symbols to their words.  Words in square
+	// brackets, such as the article of "[the] sum", are only said at the
+	// Verbose level.  Symbols that are not in it are read as their output,
+	// and capital letters with the "capital" phrase.
+	Symbols map[string]string
+	// Phrases maps the names of constructs to their format strings at each
+	// level of verbosity, taking the words for their parts.  Phrases that
+	// are not in it are those of English.
+	Phrases map[string][3]string
+	// Fraction returns the words for a fraction of whole numbers, such as
+	// "one half", or "" to read it as other fractions.
+	Fraction func(num, den int) string
+}
+
+// Options control the words for an expression.
+type Options struct {
+	Verbosity Verbosity
+	// Rules are the words to use, English if nil.
+	Rules *Rules
+}
+
+// Node returns the words for a syntax tree with the default options.
+func Node(node ast.Node) string {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its words with the
+// default options.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the words for a syntax tree.
+func (o Options) Node(node ast.Node) string {
+	s := speaker{rules: o.Rules, level: o.Verbosity}
+	if s.rules == nil {
+		s.rules = English
+	}
+	if s.level < Verbose || s.level > Superbrief {
+		s.level = Verbose
+	}
+	return tidy(s.node(node))
+}
+
+var punctuation = strings.NewReplacer(" ,", ",", " ;", ";", " :", ":")
+
+// tidy removes the spaces left around empty words and before punctuation.
+func tidy(s string) string {
+	return punctuation.Replace(strings.Join(strings.Fields(s), " "))
+}
+
+type speaker struct {
+	rules *Rules
+	level Verbosity
+}
+
+func join(words ...string) string {
+	return strings.Join(words, " ")
+}
+
+// phrase returns the phrase for key with the words of its parts.
+func (s speaker) phrase(key string, parts ...interface{}) string {
+	p, ok := s.rules.Phrases[key]
+	if !ok {
+		p = English.Phrases[key]
+	}
+	return fmt.Sprintf(p[s.level], parts...)
+}
+
+func (s speaker) hasPhrase(key string) bool {
+	_, ok := s.rules.Phrases[key]
+	_, en := English.Phrases[key]
+	return ok || en
+}
+
+// word returns the words of a symbol, with the words in square brackets at
+// the Verbose level only.
+func (s speaker) word(w string) string {
+	for {
+		i := strings.IndexByte(w, '[')
+		j := strings.IndexByte(w, ']')
+		if i < 0 || j < i {
+			return w
+		}
+		if s.level == Verbose {
+			w = w[:i] + w[i+1:j] + w[j+1:]
+		} else {
+			w = w[:i] + w[j+1:]
+		}
+	}
+}
+
+func (s speaker) node(node ast.Node) string {
+	switch n := node.(type) {
+	case *ast.Row:
+		return s.row(n.Items)
+	case *ast.Constant:
+		return s.symbol(n.Token.Symbol)
+	case *ast.Error:
+		return s.symbol(n.Token.Symbol)
+	case *ast.Placeholder:
+		return ""
+	case *ast.Text:
+		return strings.TrimSpace(n.Value)
+	case *ast.Unary:
+		return s.unary(n)
+	case *ast.Binary:
+		return s.binary(n)
+	case *ast.Style:
+		return s.style(n)
+	case *ast.Frac:
+		return s.frac(n.Num, n.Den)
+	case *ast.FunctionApplication:
+		arg := n.Arg
+		if f, ok := arg.(*ast.Fenced); ok && f.Removable() && simple(f.Body) {
+			arg = f.Body
+		}
+		if _, ok := arg.(*ast.Placeholder); ok {
+			return s.node(n.Func)
+		}
+		return s.phrase("apply", s.node(n.Func), s.node(arg))
+	case *ast.Script:
+		return s.script(n)
+	case *ast.Fenced:
+		return s.fenced(n)
+	case *ast.Matrix:
+		return s.matrix(n)
+	case *ast.EquationArray:
+		if len(n.Lines) == 1 {
+			return join(s.node(n.Lines[0].Left), s.node(n.Lines[0].Right))
+		}
+		lines := make([]string, len(n.Lines))
+		for i, line := range n.Lines {
+			lines[i] = s.phrase("line", i+1, join(s.node(line.Left), s.node(line.Right)))
+		}
+		return strings.Join(lines, "; ")
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+}
+
+// row reads items one after the other.  A minus sign in front of its operand
+// is read as negative.
+func (s speaker) row(items []ast.Node) string {
+	words := make([]string, len(items))
+	sign := true
+	for i, item := range items {
+		k, ok := item.(*ast.Constant)
+		if ok && sign && k.Token.Input() == "-" {
+			words[i] = s.phrase("negative")
+		} else {
+			words[i] = s.node(item)
+		}
+		sign = ok && isOperator(k.Token.Symbol)
+	}
+	return join(words...)
+}
+
+// isOperator returns true if sym is an operator, a relation or a word that
+// can be followed by a sign.
+func isOperator(sym *scanner.Symbol) bool {
+	switch {
+	case sym.Type() == scanner.SPACE:
+		return true
+	case sym.Tag() != "mo", sym.IsFunc(), sym.Type() == scanner.UNDEROVER, sym.Type() == scanner.RIGHTBRACKET:
+		return false
+	}
+	out := sym.Output()
+	return out != "′" && out != "!"
+}
+
+func (s speaker) symbol(sym *scanner.Symbol) string {
+	in := sym.Input()
+	if w, ok := s.rules.Symbols[in]; ok {
+		return s.word(w)
+	}
+	if len(in) == 1 && in[0] >= 'A' && in[0] <= 'Z' {
+		return s.phrase("capital", in)
+	}
+	return sym.Output()
+}
+
+// operand returns the node inside the brackets of an argument, a script or
+// an operand of /, which are removed as in ASCIIMathML.
+func operand(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		return fenced.Body
+	}
+	return node
+}
+
+// single returns the item of a row of one item, or node.
+func single(node ast.Node) ast.Node {
+	for {
+		row, ok := node.(*ast.Row)
+		if !ok || len(row.Items) != 1 {
+			return node
+		}
+		node = row.Items[0]
+	}
+}
+
+// simple returns true if node is read as a single word, which needs no
+// words around it to tell where it ends.
+func simple(node ast.Node) bool {
+	switch single(operand(node)).(type) {
+	case *ast.Constant, *ast.Text:
+		return true
+	}
+	return false
+}
+
+// number returns the value of a whole number.
+func number(node ast.Node) (int, bool) {
+	k, ok := single(operand(node)).(*ast.Constant)
+	if !ok || k.Token.Symbol.Tag() != "mn" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(k.Token.Input())
+	return n, err == nil
+}
+
+func (s speaker) frac(num, den ast.Node) string {
+	num, den = operand(num), operand(den)
+	a, ok1 := number(num)
+	b, ok2 := number(den)
+	if ok1 && ok2 && s.rules.Fraction != nil {
+		if w := s.rules.Fraction(a, b); w != "" {
+			return w
+		}
+	}
+	if simple(num) && simple(den) {
+		return s.phrase("over", s.node(num), s.node(den))
+	}
+	return s.phrase("fraction", s.node(num), s.node(den))
+}
+
+// ended returns the phrase for key, followed by the phrase for end if the
+// last part is not simple.
+func (s speaker) ended(key, end string, base string, last ast.Node) string {
+	w := s.phrase(key, base, s.node(last))
+	if !simple(last) {
+		w += s.phrase(end)
+	}
+	return w
+}
+
+func (s speaker) unary(n *ast.Unary) string {
+	sym := n.Op.Symbol
+	if _, ok := n.Arg.(*ast.Placeholder); ok && sym.IsFunc() {
+		return s.symbol(sym)
+	}
+	arg := operand(n.Arg)
+	switch in := sym.Input(); {
+	case in == "sqrt":
+		return s.sqrt(arg)
+	case sym.RewriteLeftRight() != [2]string{}:
+		return s.phrase(strings.ToLower(in), s.node(arg))
+	case sym.IsFunc():
+		return s.phrase("apply", s.symbol(sym), s.node(arg))
+	case s.hasPhrase(in):
+		return s.phrase(in, s.node(arg))
+	}
+	return join(s.symbol(sym), s.node(n.Arg))
+}
+
+func (s speaker) sqrt(arg ast.Node) string {
+	w := s.phrase("sqrt", s.node(arg))
+	if !simple(arg) {
+		w += s.phrase("end root")
+	}
+	return w
+}
+
+func (s speaker) binary(n *ast.Binary) string {
+	switch n.Op.Input() {
+	case "frac":
+		return s.frac(n.Arg1, n.Arg2)
+	case "root":
+		index, arg := operand(n.Arg1), operand(n.Arg2)
+		key := "root"
+		switch k, _ := number(index); k {
+		case 2:
+			return s.sqrt(arg)
+		case 3:
+			key = "cube root"
+		}
+		return s.ended(key, "end root", s.node(index), arg)
+	case "stackrel", "overset":
+		return s.phrase("above", s.node(operand(n.Arg2)), s.node(operand(n.Arg1)))
+	case "underset":
+		return s.phrase("below", s.node(operand(n.Arg2)), s.node(operand(n.Arg1)))
+	}
+	return join(s.symbol(n.Op.Symbol), s.node(n.Arg1), s.node(n.Arg2))
+}
+
+func (s speaker) style(n *ast.Style) string {
+	arg := operand(n.Arg)
+	font := n.Value()
+	if ast.StyleAttr(n.Op) != "mathvariant" || !s.hasPhrase(font) {
+		return s.node(arg)
+	}
+	if k, ok := single(arg).(*ast.Constant); ok && font == "double-struck" {
+		// bbb R is read as RR.
+		in := k.Token.Input()
+		if w, ok := s.rules.Symbols[in+in]; ok && len(in) == 1 {
+			return s.word(w)
+		}
+	}
+	return s.phrase(font, s.node(arg))
+}
+
+func (s speaker) script(n *ast.Script) string {
+	if k, ok := n.Base.(*ast.Constant); ok && isBigOp(k.Token.Symbol) {
+		return s.bigOp(k.Token.Symbol, n.Sub, n.Sup)
+	}
+	if u, ok := n.Base.(*ast.Unary); ok && n.Limits != ast.NoLimits {
+		// The limits of ubrace and obrace are their labels.
+		switch {
+		case u.Op.Input() == "ubrace" && n.Sub != nil && n.Sup == nil:
+			return s.phrase("ubrace labeled", s.node(operand(u.Arg)), s.node(operand(n.Sub)))
+		case u.Op.Input() == "obrace" && n.Sup != nil && n.Sub == nil:
+			return s.phrase("obrace labeled", s.node(operand(u.Arg)), s.node(operand(n.Sup)))
+		}
+	}
+	base := s.node(n.Base)
+	if n.Limits != ast.NoLimits {
+		if n.Sub != nil {
+			base = s.phrase("below", base, s.node(operand(n.Sub)))
+		}
+		if n.Sup != nil {
+			base = s.phrase("above", base, s.node(operand(n.Sup)))
+		}
+		return base
+	}
+	if n.Sub != nil {
+		base = s.ended("subscript", "end subscript", base, operand(n.Sub))
+	}
+	if n.Sup != nil {
+		switch k, _ := number(n.Sup); k {
+		case 2:
+			base = s.phrase("squared", base)
+		case 3:
+			base = s.phrase("cubed", base)
+		default:
+			base = s.ended("power", "end exponent", base, operand(n.Sup))
+		}
+	}
+	return base
+}
+
+// isBigOp returns true if the scripts of sym are its limits.
+func isBigOp(sym *scanner.Symbol) bool {
+	return sym.Type() == scanner.UNDEROVER || sym.Input() == "int" || sym.Input() == "oint"
+}
+
+// bigOp reads a big operator with its limits: the sum from i equals 1 to n,
+// the limit as x approaches 0.
+func (s speaker) bigOp(sym *scanner.Symbol, sub, sup ast.Node) string {
+	op := s.symbol(sym)
+	switch {
+	case sub != nil && sup != nil:
+		return s.phrase("limits", op, s.node(operand(sub)), s.node(operand(sup)))
+	case sub != nil:
+		if row, ok := operand(sub).(*ast.Row); ok {
+			for i, item := range row.Items {
+				if k, ok := item.(*ast.Constant); ok && k.Token.Symbol.Output() == "→" && i > 0 {
+					return s.phrase("approaches", op, s.row(row.Items[:i]), s.row(row.Items[i+1:]))
+				}
+			}
+		}
+		return s.phrase("lower limit", op, s.node(operand(sub)))
+	case sup != nil:
+		return s.phrase("upper limit", op, s.node(operand(sup)))
+	}
+	return op
+}
+
+func (s speaker) fenced(n *ast.Fenced) string {
+	if n.Open.Type() == scanner.LEFTRIGHT && n.Close.IsValid() && n.Close.Input() == n.Open.Input() {
+		return s.phrase("abs", s.node(n.Body))
+	}
+	open, close := s.symbol(n.Open.Symbol), ""
+	if n.Close.IsValid() {
+		close = s.symbol(n.Close.Symbol)
+	}
+	return join(open, s.node(n.Body), close)
+}
+
+// matrix reads a matrix row by row, cases case by case, and a matrix
+// between bars as a determinant.
+func (s speaker) matrix(n *ast.Matrix) string {
+	rows := make([]string, len(n.Rows))
+	cols := 0
+	for i, row := range n.Rows {
+		var cells []string
+		for j, cell := range row.Cells {
+			if j > 0 && j < len(row.Cells)-1 && isColumnLine(cell) {
+				continue
+			}
+			cells = append(cells, s.node(cell))
+		}
+		rows[i] = strings.Join(cells, ", ")
+		if i == 0 {
+			cols = len(cells)
+		}
+	}
+	var words []string
+	key, item := "matrix", "row"
+	switch {
+	case n.Open.Input() == "{" && n.Close.IsValid() && n.Close.Symbol.Invisible():
+		key, item = "cases", "case"
+		words = append(words, s.phrase(key, len(rows)))
+	case n.Open.Type() == scanner.LEFTRIGHT:
+		key = "determinant"
+		fallthrough
+	default:
+		words = append(words, s.phrase(key, len(rows), cols))
+	}
+	for i, row := range rows {
+		words = append(words, s.phrase(item, i+1, row))
+	}
+	words = append(words, s.phrase("end "+key))
+	return strings.Join(words, "; ")
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
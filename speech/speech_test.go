@@ -0,0 +1,184 @@
+package speech
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+func TestExpr(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "ordinal fractions",
+			input: "frac(1)(2) + 2/3 != 3/4",
+			want:  "one half plus two thirds is not equal to three fourths",
+		},
+		{
+			name:  "fractions",
+			input: "1/x + (a+b)/(c-d) + 1/12",
+			want:  "1 over x plus the fraction with numerator a plus b and denominator c minus d plus 1 over 12",
+		},
+		{
+			name:  "powers",
+			input: "x^2 + y^3 + e^x + e^(i pi)",
+			want:  "x squared plus y cubed plus e to the power of x plus e to the power of i pi, end exponent",
+		},
+		{
+			name:  "subscripts",
+			input: "x_i^2 + x_(i+1)",
+			want:  "x sub i squared plus x sub i plus 1, end subscript",
+		},
+		{
+			name:  "sum",
+			input: "sum_(i=1)^n",
+			want:  "the sum from i equals 1 to n",
+		},
+		{
+			name:  "limit",
+			input: "lim_(x->oo) 1/x = 0",
+			want:  "the limit as x approaches infinity 1 over x equals 0",
+		},
+		{
+			name:  "integral",
+			input: "int_0^1 f(x) dx",
+			want:  "the integral from 0 to 1 f of x d x",
+		},
+		{
+			name:  "functions",
+			input: "sin^2 x + ln(x+1)",
+			want:  "sine squared of x plus natural log of open paren x plus 1 close paren",
+		},
+		{
+			name:  "roots",
+			input: "-b +- sqrt(b^2-4ac) + root(3)(x) + root(n)(x)",
+			want:  "negative b plus or minus the square root of b squared minus 4 a c, end root plus the cube root of x plus the root of index n of x",
+		},
+		{
+			name:  "absolute values",
+			input: "|x| + abs(y) + norm(v) + floor(x)",
+			want:  "the absolute value of x plus the absolute value of y plus the norm of v plus the floor of x",
+		},
+		{
+			name:  "letters",
+			input: "A sub B nn Gamma",
+			want:  "cap A is a subset of cap B intersect cap gamma",
+		},
+		{
+			name:  "accents",
+			input: "hat x + vec v + ubrace(a+b)_n + stackrel(def)(=)",
+			want:  "x hat plus vector v plus a plus b with underbrace labeled n plus equals with d e f above",
+		},
+		{
+			name:  "styles",
+			input: `bbb R + bb x + color(red)(x) + "if" x`,
+			want:  "the real numbers plus bold x plus x plus if x",
+		},
+		{
+			name:  "matrix",
+			input: "[[a,b],[c,d]]",
+			want:  "the 2 by 2 matrix; row 1: a, b; row 2: c, d; end matrix",
+		},
+		{
+			name:  "column lines",
+			input: "[[1,|,2]]",
+			want:  "the 1 by 2 matrix; row 1: 1, 2; end matrix",
+		},
+		{
+			name:  "cases",
+			input: "{(x, if x >= 0),(-x, if x < 0):}",
+			want:  "2 cases; case 1: x, if x is greater than or equal to 0; case 2: negative x, if x is less than 0; end cases",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Expr(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestOptions_Node(t *testing.T) {
+	node, err := parser.Parse("sum_(i=1)^n sqrt(i+1) + (a+b)/c in RR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		verbosity Verbosity
+		want      string
+	}{
+		{Verbose, "the sum from i equals 1 to n the square root of i plus 1, end root plus the fraction with numerator a plus b and denominator c is an element of the real numbers"},
+		{Brief, "sum from i equals 1 to n square root of i plus 1 end root plus start fraction a plus b over c end fraction an element of real numbers"},
+		{Superbrief, "sum from i equals 1 to n root i plus 1 end root plus frac a plus b over c end frac an element of real numbers"},
+	}
+	for _, test := range tests {
+		if got := (Options{Verbosity: test.verbosity}).Node(node); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestOptions_Node_rules(t *testing.T) {
+	node, err := parser.Parse("x^2 <= 1/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := &Rules{
+		Symbols: map[string]string{"<=": "est inférieur ou égal à"},
+		Phrases: map[string][3]string{"squared": {"%s au carré", "%s au carré", "%s au carré"}},
+	}
+	want := "x au carré est inférieur ou égal à 1 over 2"
+	if got := (Options{Rules: rules}).Node(node); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	node, err := parser.ParseBlock("f(x) = x + 1\n= 1 + x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "line 1: f of x equals x plus 1; line 2: equals 1 plus x"
+	if got := Node(node); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// TestEnglish checks that the symbols of AMsymbols that are read on their
+// own have English words, and the others English phrases.
+func TestEnglish(t *testing.T) {
+	for i := range scanner.AMsymbols {
+		sym := &scanner.AMsymbols[i]
+		in := sym.Input()
+		switch {
+		case len(in) == 1 && in[0] >= 'a' && in[0] <= 'z':
+			// f and g are read as letters.
+		case sym.Type() == scanner.UNARY && (sym.AttrName() != "" || sym.RewriteLeftRight() != [2]string{}):
+			// Fonts are read with the phrase for their value, and abs,
+			// norm, floor and ceil with the phrase for their name.
+		case sym.Acc(), in == "sqrt", in == "cancel":
+			if _, ok := English.Phrases[in]; !ok {
+				t.Errorf("no phrase for %s", in)
+			}
+		case sym.IsFunc():
+			if _, ok := English.Symbols[in]; !ok {
+				t.Errorf("no words for %s", in)
+			}
+		}
+		switch sym.Type() {
+		case scanner.CONST, scanner.UNDEROVER, scanner.SPACE, scanner.LEFTBRACKET, scanner.RIGHTBRACKET, scanner.LEFTRIGHT:
+			if _, ok := English.Symbols[in]; !ok {
+				t.Errorf("no words for %s", in)
+			}
+		}
+	}
+}
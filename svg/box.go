@@ -0,0 +1,167 @@
+package svg
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A glyph is the outline of a character, in thousandths of an em with the y
+// axis pointing up, and its advance width and bounding box.
+type glyph struct {
+	advance, xmin, ymin, xmax, ymax int
+	path                            string
+}
+
+// A box is a rectangle lined up with the boxes next to it at its baseline.
+// Lengths are in thousandths of an em, the height above the baseline and the
+// depth below it.  The items of a box are drawn from its origin, the left end
+// of its baseline, with the y axis pointing down as in SVG.
+type box struct {
+	width, height, depth float64
+	italic               bool   // a slanted letter, superscripts are moved right
+	attrs                string // attributes of the group around the items
+	items                []item
+}
+
+// An item is a box, a glyph, a rule or a stroked path placed at x, y.
+type item struct {
+	x, y float64
+	box  *box
+
+	glyph          *glyph
+	scale, stretch float64 // horizontal and vertical scales of the glyph
+	slant          float64
+
+	rule [2]float64 // the width and height of a rule whose top left is at x, y
+
+	path      []segment // a stroked path relative to x, y
+	thickness float64
+}
+
+// A segment is a command of an SVG path and its points.
+type segment struct {
+	cmd byte
+	pts []float64
+}
+
+func (b *box) add(x, y float64, child *box) {
+	b.items = append(b.items, item{x: x, y: y, box: child})
+}
+
+// hbox puts boxes side by side.
+func hbox(boxes ...*box) *box {
+	b := &box{}
+	for _, child := range boxes {
+		b.add(b.width, 0, child)
+		b.width += child.width
+		b.height = math.Max(b.height, child.height)
+		b.depth = math.Max(b.depth, child.depth)
+	}
+	if len(boxes) == 1 {
+		b.italic = boxes[0].italic
+	}
+	return b
+}
+
+// kern returns an empty box of the given width.
+func kern(width float64) *box {
+	return &box{width: width}
+}
+
+// raise returns b moved up by dy.
+func raise(b *box, dy float64) *box {
+	r := &box{width: b.width, height: b.height + dy, depth: b.depth - dy}
+	r.add(0, -dy, b)
+	return r
+}
+
+// rule returns a box filled with a rule of the given width and thickness,
+// centered on y above the baseline.
+func rule(width, thickness, y float64) *box {
+	b := &box{width: width, height: y + thickness/2, depth: thickness/2 - y}
+	b.items = append(b.items, item{y: -y - thickness/2, rule: [2]float64{width, thickness}})
+	return b
+}
+
+func (b *box) stroke(thickness float64, path ...segment) {
+	b.items = append(b.items, item{path: path, thickness: thickness})
+}
+
+func moveTo(x, y float64) segment {
+	return segment{'M', []float64{x, y}}
+}
+
+func lineTo(x, y float64) segment {
+	return segment{'L', []float64{x, y}}
+}
+
+func quadTo(x1, y1, x, y float64) segment {
+	return segment{'Q', []float64{x1, y1, x, y}}
+}
+
+func curveTo(x1, y1, x2, y2, x, y float64) segment {
+	return segment{'C', []float64{x1, y1, x2, y2, x, y}}
+}
+
+// write writes the SVG elements drawing b with its origin at x, y.
+func (b *box) write(w *strings.Builder, x, y float64) {
+	if b.attrs != "" {
+		w.WriteString("<g" + b.attrs + ">")
+	}
+	for _, it := range b.items {
+		x, y := x+it.x, y+it.y
+		switch {
+		case it.box != nil:
+			it.box.write(w, x, y)
+		case it.glyph != nil:
+			if it.glyph.path == "" {
+				continue
+			}
+			w.WriteString(`<path d="` + it.glyph.path + `" transform="matrix(`)
+			w.WriteString(scale(it.scale) + " 0 " + scale(it.slant*it.stretch) + " " + scale(-it.stretch) + " " + num(x) + " " + num(y) + `)"/>`)
+		case it.path != nil:
+			w.WriteString(`<path d="`)
+			for i, seg := range it.path {
+				if i > 0 {
+					w.WriteByte(' ')
+				}
+				w.WriteByte(seg.cmd)
+				for j, v := range seg.pts {
+					if j > 0 {
+						w.WriteByte(' ')
+					}
+					if j%2 == 0 {
+						w.WriteString(num(x + v))
+					} else {
+						w.WriteString(num(y + v))
+					}
+				}
+			}
+			w.WriteString(`" fill="none" stroke="currentColor" stroke-width="` + num(it.thickness) + `"/>`)
+		default:
+			w.WriteString(`<rect x="` + num(x) + `" y="` + num(y) + `" width="` + num(it.rule[0]) + `" height="` + num(it.rule[1]) + `"/>`)
+		}
+	}
+	if b.attrs != "" {
+		w.WriteString("</g>")
+	}
+}
+
+// num formats a length to a tenth of a unit.
+func num(v float64) string {
+	v = math.Round(v*10) / 10
+	if v == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// scale formats a scale factor of a glyph.
+func scale(v float64) string {
+	v = math.Round(v*1000) / 1000
+	if v == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
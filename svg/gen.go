@@ -0,0 +1,402 @@
+//go:build ignore
+// +build ignore
+
+// This program generates glyphs.go, the outlines and metrics of the glyphs
+// that the svg package uses, from the DejaVu fonts:
+//
+//	go run gen.go -fonts /usr/share/fonts/truetype/dejavu
+//
+// Outlines are scaled to 1000 units per em, with the y axis pointing up.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/arnodel/asciimath/scanner"
+)
+
+func main() {
+	dir := flag.String("fonts", "/usr/share/fonts/truetype/dejavu", "directory of the DejaVu fonts")
+	out := flag.String("o", "glyphs.go", "output file")
+	flag.Parse()
+
+	load := func(name string) *font {
+		data, err := ioutil.ReadFile(filepath.Join(*dir, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		f, err := parse(data)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		return f
+	}
+	serif, serifBold := load("DejaVuSerif.ttf"), load("DejaVuSerif-Bold.ttf")
+	sans, mono := load("DejaVuSans.ttf"), load("DejaVuSansMono.ttf")
+
+	var ascii, letters []rune
+	for r := rune(' '); r <= '~'; r++ {
+		ascii = append(ascii, r)
+		if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			letters = append(letters, r)
+		}
+	}
+	for r := rune(0x391); r <= 0x3C9; r++ {
+		letters = append(letters, r)
+	}
+	letters = append(letters, 'ϑ', 'ϕ', 'ϵ', 'ɛ')
+
+	// The regular font has the characters of all the symbols and of the
+	// alphabets of bbb, cc and fr.
+	chars := append(append([]rune(nil), ascii...), letters...)
+	chars = append(chars, 'ˆ', '˜', '˙', '¨', '¯', '′', '−', '∣', '⟨', '⟩')
+	for i := range scanner.AMsymbols {
+		sym := &scanner.AMsymbols[i]
+		for _, r := range sym.Output() {
+			chars = append(chars, r)
+		}
+		for _, letter := range sym.Codes() {
+			for _, r := range letter {
+				chars = append(chars, r)
+			}
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen.go from the DejaVu fonts; DO NOT EDIT.\n\n// The outlines are those of the DejaVu fonts, see LICENSE.dejavu.\n\n")
+	fmt.Fprintf(&b, "package svg\n\n")
+	write(&b, "serifGlyphs", "DejaVu Serif, or DejaVu Sans for the characters it lacks", chars, serif, sans)
+	write(&b, "boldGlyphs", "DejaVu Serif Bold", letters, serifBold)
+	write(&b, "sansGlyphs", "DejaVu Sans", letters, sans)
+	write(&b, "monoGlyphs", "DejaVu Sans Mono", ascii, mono)
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// write writes the table of the glyphs of chars, taken from the first of
+// fonts which has them.
+func write(b *bytes.Buffer, name, doc string, chars []rune, fonts ...*font) {
+	seen := map[rune]bool{}
+	var sorted []rune
+	for _, r := range chars {
+		if !seen[r] {
+			seen[r] = true
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	fmt.Fprintf(b, "// %s are the glyphs of %s.\n", name, doc)
+	fmt.Fprintf(b, "var %s = map[rune]*glyph{\n", name)
+	for _, r := range sorted {
+		for _, f := range fonts {
+			if g, ok := f.glyph(r); ok {
+				fmt.Fprintf(b, "\t%q: {%d, %d, %d, %d, %d, %q},\n", r, g.advance, g.xmin, g.ymin, g.xmax, g.ymax, g.path)
+				break
+			}
+		}
+	}
+	fmt.Fprintf(b, "}\n\n")
+}
+
+// A font is a parsed TrueType font.
+type font struct {
+	data        []byte
+	tables      map[string][]byte
+	unitsPerEm  float64
+	longLoca    bool
+	numHMetrics int
+	cmap        map[rune]int
+}
+
+type glyph struct {
+	advance, xmin, ymin, xmax, ymax int
+	path                            string
+}
+
+func parse(data []byte) (*font, error) {
+	f := &font{data: data, tables: map[string][]byte{}, cmap: map[rune]int{}}
+	n := int(u16(data, 4))
+	for i := 0; i < n; i++ {
+		rec := data[12+16*i:]
+		off, length := u32(rec, 8), u32(rec, 12)
+		f.tables[string(rec[:4])] = data[off : off+length]
+	}
+	for _, tag := range []string{"head", "hhea", "hmtx", "loca", "glyf", "cmap"} {
+		if f.tables[tag] == nil {
+			return nil, fmt.Errorf("no %s table", tag)
+		}
+	}
+	f.unitsPerEm = float64(u16(f.tables["head"], 18))
+	f.longLoca = u16(f.tables["head"], 50) != 0
+	f.numHMetrics = int(u16(f.tables["hhea"], 34))
+	return f, f.parseCmap()
+}
+
+func (f *font) parseCmap() error {
+	cmap := f.tables["cmap"]
+	var format4, format12 []byte
+	for i := 0; i < int(u16(cmap, 2)); i++ {
+		rec := cmap[4+8*i:]
+		if u16(rec, 0) != 3 {
+			continue
+		}
+		sub := cmap[u32(rec, 4):]
+		switch u16(sub, 0) {
+		case 4:
+			format4 = sub
+		case 12:
+			format12 = sub
+		}
+	}
+	switch {
+	case format12 != nil:
+		for i := 0; i < int(u32(format12, 12)); i++ {
+			grp := format12[16+12*i:]
+			start, end, gid := u32(grp, 0), u32(grp, 4), u32(grp, 8)
+			for c := start; c <= end; c++ {
+				f.cmap[rune(c)] = int(gid + c - start)
+			}
+		}
+	case format4 != nil:
+		segs := int(u16(format4, 6)) / 2
+		ends, starts := format4[14:], format4[16+2*segs:]
+		deltas, offsets := format4[16+4*segs:], format4[16+6*segs:]
+		for s := 0; s < segs; s++ {
+			start, end := int(u16(starts, 2*s)), int(u16(ends, 2*s))
+			delta, offset := int(u16(deltas, 2*s)), int(u16(offsets, 2*s))
+			for c := start; c <= end && c != 0xFFFF; c++ {
+				gid := 0
+				if offset == 0 {
+					gid = (c + delta) & 0xFFFF
+				} else if g := int(u16(offsets, 2*s+offset+2*(c-start))); g != 0 {
+					gid = (g + delta) & 0xFFFF
+				}
+				if gid != 0 {
+					f.cmap[rune(c)] = gid
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("no Unicode cmap")
+	}
+	return nil
+}
+
+func (f *font) glyph(r rune) (*glyph, bool) {
+	gid, ok := f.cmap[r]
+	if !ok {
+		return nil, false
+	}
+	hmtx := f.tables["hmtx"]
+	i := gid
+	if i >= f.numHMetrics {
+		i = f.numHMetrics - 1
+	}
+	g := &glyph{advance: f.scale(float64(u16(hmtx, 4*i)))}
+	var contours [][]point
+	f.outline(gid, affine{1, 0, 0, 1, 0, 0}, &contours)
+	var path strings.Builder
+	xmin, ymin, xmax, ymax := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, c := range contours {
+		for _, p := range c {
+			xmin, xmax = math.Min(xmin, p.x), math.Max(xmax, p.x)
+			ymin, ymax = math.Min(ymin, p.y), math.Max(ymax, p.y)
+		}
+		f.writeContour(&path, c)
+	}
+	if len(contours) > 0 {
+		g.xmin, g.ymin, g.xmax, g.ymax = f.scale(xmin), f.scale(ymin), f.scale(xmax), f.scale(ymax)
+	}
+	g.path = path.String()
+	return g, true
+}
+
+func (f *font) scale(v float64) int {
+	return int(math.Round(v * 1000 / f.unitsPerEm))
+}
+
+type point struct {
+	x, y float64
+	on   bool
+}
+
+// An affine is the transform of a component of a composite glyph.
+type affine struct {
+	a, b, c, d, e, f float64
+}
+
+func (t affine) apply(p point) point {
+	return point{t.a*p.x + t.c*p.y + t.e, t.b*p.x + t.d*p.y + t.f, p.on}
+}
+
+// outline appends the contours of the glyph gid, transformed by t.
+func (f *font) outline(gid int, t affine, contours *[][]point) {
+	loca, glyf := f.tables["loca"], f.tables["glyf"]
+	var start, end uint32
+	if f.longLoca {
+		start, end = u32(loca, 4*gid), u32(loca, 4*gid+4)
+	} else {
+		start, end = 2*uint32(u16(loca, 2*gid)), 2*uint32(u16(loca, 2*gid+2))
+	}
+	if start == end {
+		return
+	}
+	g := glyf[start:end]
+	n := int(int16(u16(g, 0)))
+	if n < 0 {
+		f.composite(g[10:], t, contours)
+		return
+	}
+	ends := make([]int, n)
+	for i := range ends {
+		ends[i] = int(u16(g, 10+2*i))
+	}
+	numPoints := 0
+	if n > 0 {
+		numPoints = ends[n-1] + 1
+	}
+	pos := 10 + 2*n
+	pos += 2 + int(u16(g, pos))
+	flags := make([]byte, 0, numPoints)
+	for len(flags) < numPoints {
+		flag := g[pos]
+		pos++
+		flags = append(flags, flag)
+		if flag&8 != 0 {
+			for r := g[pos]; r > 0; r-- {
+				flags = append(flags, flag)
+			}
+			pos++
+		}
+	}
+	points := make([]point, numPoints)
+	coord := func(short, same byte, set func(i int, v float64)) {
+		v := 0.0
+		for i, flag := range flags {
+			switch {
+			case flag&short != 0:
+				d := float64(g[pos])
+				pos++
+				if flag&same == 0 {
+					d = -d
+				}
+				v += d
+			case flag&same == 0:
+				v += float64(int16(u16(g, pos)))
+				pos += 2
+			}
+			set(i, v)
+		}
+	}
+	coord(2, 16, func(i int, v float64) { points[i].x = v })
+	coord(4, 32, func(i int, v float64) { points[i].y = v })
+	first := 0
+	for _, last := range ends {
+		c := make([]point, 0, last-first+1)
+		for i := first; i <= last; i++ {
+			p := points[i]
+			p.on = flags[i]&1 != 0
+			c = append(c, t.apply(p))
+		}
+		*contours = append(*contours, c)
+		first = last + 1
+	}
+}
+
+func (f *font) composite(g []byte, t affine, contours *[][]point) {
+	for pos := 0; ; {
+		flags, gid := u16(g, pos), int(u16(g, pos+2))
+		pos += 4
+		var dx, dy float64
+		if flags&1 != 0 {
+			dx, dy = float64(int16(u16(g, pos))), float64(int16(u16(g, pos+2)))
+			pos += 4
+		} else {
+			dx, dy = float64(int8(g[pos])), float64(int8(g[pos+1]))
+			pos += 2
+		}
+		c := affine{1, 0, 0, 1, dx, dy}
+		f2dot14 := func(p int) float64 { return float64(int16(u16(g, p))) / 16384 }
+		switch {
+		case flags&8 != 0:
+			c.a = f2dot14(pos)
+			c.d = c.a
+			pos += 2
+		case flags&0x40 != 0:
+			c.a, c.d = f2dot14(pos), f2dot14(pos+2)
+			pos += 4
+		case flags&0x80 != 0:
+			c.a, c.b, c.c, c.d = f2dot14(pos), f2dot14(pos+2), f2dot14(pos+4), f2dot14(pos+6)
+			pos += 8
+		}
+		f.outline(gid, affine{
+			t.a*c.a + t.c*c.b, t.b*c.a + t.d*c.b,
+			t.a*c.c + t.c*c.d, t.b*c.c + t.d*c.d,
+			t.a*c.e + t.c*c.f + t.e, t.b*c.e + t.d*c.f + t.f,
+		}, contours)
+		if flags&0x20 == 0 {
+			return
+		}
+	}
+}
+
+// writeContour writes a contour as the commands of an SVG path, with a
+// quadratic curve for each off-curve point.
+func (f *font) writeContour(b *strings.Builder, c []point) {
+	if len(c) == 0 {
+		return
+	}
+	// Start at an on-curve point, which is the midpoint of the first two
+	// points if they are both off the curve.
+	start := -1
+	for i, p := range c {
+		if p.on {
+			start = i
+			break
+		}
+	}
+	var pts []point
+	if start < 0 {
+		mid := point{(c[0].x + c[1].x) / 2, (c[0].y + c[1].y) / 2, true}
+		pts = append(append([]point{mid}, c[1:]...), c[0])
+	} else {
+		pts = append(append([]point(nil), c[start:]...), c[:start]...)
+	}
+	pts = append(pts, pts[0])
+	s := func(p point) string { return fmt.Sprintf("%d %d", f.scale(p.x), f.scale(p.y)) }
+	b.WriteString("M" + s(pts[0]))
+	for i := 1; i < len(pts); i++ {
+		p := pts[i]
+		if p.on {
+			b.WriteString("L" + s(p))
+			continue
+		}
+		next := pts[i+1]
+		end := next
+		if !next.on {
+			end = point{(p.x + next.x) / 2, (p.y + next.y) / 2, true}
+		} else {
+			i++
+		}
+		b.WriteString("Q" + s(p) + " " + s(end))
+	}
+	b.WriteString("Z")
+}
+
+func u16(b []byte, i int) uint16 { return binary.BigEndian.Uint16(b[i:]) }
+func u32(b []byte, i int) uint32 { return binary.BigEndian.Uint32(b[i:]) }
@@ -0,0 +1,673 @@
+// Code generated by gen.go from the DejaVu fonts; DO NOT EDIT.
+
+// The outlines are those of the DejaVu fonts, see LICENSE.dejavu.
+
+package svg
+
+// serifGlyphs are the glyphs of DejaVu Serif, or DejaVu Sans for the characters it lacks.
+var serifGlyphs = map[rune]*glyph{
+	' ':      {318, 0, 0, 0, 0, ""},
+	'!':      {402, 136, -14, 266, 729, "M136 51Q136 78 155 97Q173 116 201 116Q228 116 247 97Q266 78 266 51Q266 24 247 5Q228 -14 201 -14Q173 -14 155 5Q136 23 136 51ZM138 729L264 729L231 328L231 205L171 205L171 328L138 729Z"},
+	'"':      {460, 98, 458, 362, 729, "M176 729L176 458L98 458L98 729L176 729ZM362 729L362 458L284 458L284 729L362 729Z"},
+	'#':      {838, 77, 0, 761, 718, "M509 440L371 440L330 277L469 277L509 440ZM440 718L390 515L528 515L578 718L662 718L611 515L761 515L761 440L592 440L552 277L705 277L705 202L533 202L483 0L399 0L450 202L312 202L261 0L178 0L228 202L77 202L77 277L247 277L287 440L133 440L133 515L306 515L357 718L440 718Z"},
+	'$':      {636, 85, -147, 553, 760, "M337 33Q392 38 421 65Q450 92 450 138Q450 180 421 209Q393 239 337 254L337 33ZM288 580Q238 576 210 551Q182 525 182 482Q182 443 208 417Q233 391 288 375L288 580ZM86 35L86 170L139 170Q141 103 178 69Q215 35 288 33L288 270Q176 301 130 342Q85 383 85 450Q85 528 139 576Q192 623 288 629L288 760L337 760L337 629Q386 625 432 613Q478 601 521 581L521 453L468 453Q462 510 428 542Q395 575 337 580L337 359Q457 326 505 284Q553 242 553 171Q553 90 497 41Q440 -8 337 -15L337 -147L288 -147L288 -15Q241 -14 191 -2Q141 11 86 35Z"},
+	'%':      {950, 55, -14, 895, 742, "M224 697Q181 697 157 656Q133 616 133 543Q133 471 157 430Q182 389 224 389Q266 389 290 430Q314 471 314 543Q314 615 290 656Q266 697 224 697ZM727 339Q685 339 660 298Q636 257 636 184Q636 112 661 72Q685 31 727 31Q769 31 793 72Q817 112 817 184Q817 256 793 298Q769 339 727 339ZM727 383Q804 383 850 329Q895 275 895 184Q895 93 849 39Q804 -14 727 -14Q649 -14 604 39Q558 93 558 184Q558 276 604 329Q649 383 727 383ZM666 742L740 742L284 -14L210 -14L666 742ZM223 742Q300 742 346 689Q392 635 392 544Q392 452 346 398Q301 345 223 345Q146 345 100 399Q55 452 55 544Q55 635 101 688Q146 742 223 742Z"},
+	'&':      {890, 72, -14, 843, 742, "M540 113L256 412Q213 374 192 330Q171 286 171 236Q171 155 227 100Q283 45 367 45Q417 45 461 62Q505 80 540 113ZM843 0L647 0L580 71Q529 28 471 7Q413 -14 345 -14Q219 -14 146 50Q72 115 72 225Q72 290 109 346Q146 403 222 452Q197 482 186 511Q174 541 174 573Q174 651 230 697Q286 742 382 742Q415 742 458 734Q502 726 558 709L558 583L503 583Q496 635 465 663Q434 690 380 690Q330 690 298 662Q267 635 267 591Q267 561 284 531Q300 502 353 447L605 181Q639 220 658 265Q677 311 682 362L594 362L594 414L825 414L825 362L741 362Q733 298 709 243Q685 188 644 141L728 52L843 52L843 0Z"},
+	'\'':     {275, 98, 458, 176, 729, "M176 729L176 458L98 458L98 729L176 729Z"},
+	'(':      {390, 79, -156, 319, 760, "M319 -156Q200 -103 140 13Q79 128 79 302Q79 476 140 591Q200 707 319 760L319 712Q244 660 211 566Q178 472 178 302Q178 132 211 38Q244 -56 319 -108L319 -156Z"},
+	')':      {390, 71, -156, 311, 760, "M71 -156L71 -108Q146 -56 179 38Q212 132 212 302Q212 472 179 566Q146 660 71 712L71 760Q190 707 250 591Q311 476 311 302Q311 128 250 13Q190 -103 71 -156Z"},
+	'*':      {500, 16, 287, 484, 742, "M484 604L293 515L484 425L447 367L277 473L283 287L217 287L223 473L53 367L16 425L207 514L16 604L53 662L223 556L217 742L283 742L277 556L447 662L484 604Z"},
+	'+':      {838, 106, 0, 732, 627, "M458 627L458 353L732 353L732 274L458 274L458 0L380 0L380 274L106 274L106 353L380 353L380 627L458 627Z"},
+	',':      {318, 36, -136, 221, 111, "M36 -97Q82 -63 104 -18Q125 28 125 93L125 111L221 111Q217 27 182 -32Q146 -92 75 -136L36 -97Z"},
+	'-':      {338, 44, 230, 294, 306, "M44 306L294 306L294 230L44 230L44 306Z"},
+	'.':      {318, 94, -14, 224, 116, "M94 51Q94 78 113 97Q131 116 159 116Q186 116 205 97Q224 78 224 51Q224 24 205 5Q186 -14 159 -14Q131 -14 113 5Q94 23 94 51Z"},
+	'/':      {337, 0, -93, 337, 729, "M259 729L337 729L78 -93L0 -93L259 729Z"},
+	'0':      {636, 66, -14, 570, 742, "M318 34Q393 34 430 116Q467 198 467 364Q467 530 430 612Q393 694 318 694Q243 694 206 612Q169 530 169 364Q169 198 206 116Q243 34 318 34ZM318 -14Q199 -14 133 85Q66 185 66 364Q66 543 133 643Q199 742 318 742Q437 742 503 643Q570 543 570 364Q570 185 503 85Q437 -14 318 -14Z"},
+	'1':      {636, 122, 0, 494, 742, "M142 0L142 52L269 52L269 658L122 563L122 627L300 742L367 742L367 52L494 52L494 0L142 0Z"},
+	'2':      {636, 68, 0, 538, 742, "M128 555L73 555L73 685Q125 713 178 728Q231 742 282 742Q396 742 462 687Q528 632 528 537Q528 430 378 281Q367 270 361 264L177 80L481 80L481 170L538 170L538 0L68 0L68 53L289 274Q362 347 394 408Q425 470 425 537Q425 611 386 652Q348 694 281 694Q211 694 173 659Q135 625 128 555Z"},
+	'3':      {636, 76, -14, 562, 742, "M97 698Q154 720 207 731Q259 742 305 742Q412 742 472 696Q532 650 532 568Q532 502 491 458Q449 414 373 398Q463 385 512 333Q562 280 562 197Q562 95 493 40Q425 -14 296 -14Q239 -14 184 -2Q130 10 76 35L76 177L131 177Q136 106 178 70Q221 34 298 34Q373 34 416 77Q459 121 459 196Q459 282 415 326Q370 370 284 370L238 370L238 420L262 420Q348 420 390 455Q433 491 433 562Q433 626 398 660Q363 694 297 694Q231 694 195 663Q158 631 152 570L97 570L97 698Z"},
+	'4':      {636, 31, 0, 586, 742, "M349 247L349 635L100 247L349 247ZM564 0L232 0L232 52L349 52L349 195L31 195L31 248L350 742L447 742L447 247L586 247L586 195L447 195L447 52L564 52L564 0Z"},
+	'5':      {636, 85, -14, 559, 729, "M503 729L503 649L169 649L169 440Q194 458 228 466Q262 475 304 475Q422 475 491 410Q559 344 559 231Q559 115 490 51Q421 -14 296 -14Q246 -14 193 -2Q140 10 85 35L85 177L140 177Q145 107 184 71Q224 34 296 34Q373 34 415 85Q456 136 456 231Q456 326 415 376Q374 427 296 427Q252 427 219 411Q185 396 159 363L117 363L117 729L503 729Z"},
+	'6':      {636, 67, -14, 573, 742, "M327 34Q396 34 433 85Q470 135 470 230Q470 325 433 375Q396 426 327 426Q257 426 221 377Q184 328 184 236Q184 139 221 86Q258 34 327 34ZM168 401Q201 438 243 456Q285 474 338 474Q447 474 510 409Q573 343 573 230Q573 119 505 52Q437 -14 323 -14Q200 -14 133 78Q67 170 67 341Q67 532 146 637Q224 742 367 742Q406 742 448 735Q491 728 535 713L535 593L480 593Q474 642 442 668Q411 694 357 694Q262 694 216 622Q169 550 168 401Z"},
+	'7':      {636, 84, 0, 564, 729, "M564 679L279 0L206 0L478 649L141 649L141 559L84 559L84 729L564 729L564 679Z"},
+	'8':      {636, 67, -14, 569, 742, "M466 199Q466 277 427 321Q388 364 318 364Q248 364 209 321Q170 277 170 199Q170 121 209 77Q248 34 318 34Q388 34 427 77Q466 121 466 199ZM446 553Q446 620 412 657Q378 694 318 694Q258 694 224 657Q190 620 190 553Q190 486 224 449Q258 412 318 412Q378 412 412 449Q446 486 446 553ZM393 388Q476 377 522 327Q569 277 569 199Q569 97 504 41Q439 -14 318 -14Q197 -14 132 41Q67 97 67 199Q67 277 113 327Q160 377 243 388Q169 401 130 444Q91 487 91 553Q91 641 151 692Q212 742 318 742Q424 742 484 692Q545 641 545 553Q545 487 506 444Q466 401 393 388Z"},
+	'9':      {636, 63, -14, 569, 742, "M468 327Q435 290 393 272Q350 254 297 254Q188 254 126 319Q63 385 63 498Q63 609 131 676Q199 742 313 742Q437 742 503 650Q569 558 569 387Q569 196 490 91Q412 -14 269 -14Q230 -14 188 -7Q146 0 101 15L101 136L156 136Q162 87 194 61Q226 34 279 34Q374 34 420 106Q466 177 468 327ZM309 694Q240 694 203 643Q166 593 166 498Q166 403 203 353Q240 302 309 302Q378 302 415 351Q452 400 452 492Q452 589 415 642Q378 694 309 694Z"},
+	':':      {337, 104, -14, 234, 434, "M104 51Q104 78 123 97Q142 116 169 116Q196 116 215 97Q234 78 234 51Q234 23 215 5Q197 -14 169 -14Q141 -14 123 5Q104 23 104 51ZM104 369Q104 396 123 415Q142 434 169 434Q197 434 215 416Q234 397 234 369Q234 341 215 323Q197 304 169 304Q142 304 123 323Q104 342 104 369Z"},
+	';':      {337, 36, -136, 236, 434, "M36 -97Q82 -63 104 -18Q125 28 125 93L125 111L221 111Q217 27 182 -32Q146 -92 75 -136L36 -97ZM106 369Q106 396 125 415Q144 434 171 434Q198 434 217 415Q236 396 236 369Q236 342 217 323Q198 304 171 304Q144 304 125 323Q106 342 106 369Z"},
+	'<':      {838, 106, 46, 732, 581, "M732 498L225 313L732 129L732 46L106 273L106 354L732 581L732 498Z"},
+	'=':      {838, 106, 175, 732, 452, "M106 452L732 452L732 374L106 374L106 452ZM106 253L732 253L732 175L106 175L106 253Z"},
+	'>':      {838, 106, 46, 732, 581, "M106 498L106 581L732 354L732 273L106 46L106 129L613 313L106 498Z"},
+	'?':      {536, 68, -14, 488, 742, "M176 51Q176 78 195 97Q213 116 241 116Q268 116 287 97Q306 78 306 51Q306 24 287 5Q268 -14 241 -14Q213 -14 195 5Q176 23 176 51ZM68 697Q120 720 166 731Q213 742 254 742Q362 742 425 690Q488 638 488 549Q488 458 434 400Q380 343 271 318L271 194L211 194L211 354Q297 375 341 424Q385 474 385 550Q385 617 349 655Q313 694 252 694Q196 694 161 662Q125 630 115 570L68 570L68 697Z"},
+	'@':      {1000, 66, -174, 929, 704, "M632 121Q603 81 567 62Q531 42 486 42Q400 42 347 103Q294 164 294 262Q294 360 347 421Q400 482 486 482Q531 482 567 462Q603 443 632 403L632 472L708 472L708 95Q791 113 837 174Q883 235 883 327Q883 381 866 430Q850 478 817 519Q765 584 691 618Q616 653 529 653Q485 653 442 644Q399 634 357 615Q258 570 204 478Q149 386 149 265Q149 184 173 115Q198 46 244 -4Q297 -62 370 -93Q443 -123 528 -123Q591 -123 646 -104Q700 -86 747 -49L773 -83Q720 -128 653 -151Q586 -174 507 -174Q417 -174 336 -141Q255 -109 194 -49Q131 13 98 93Q66 173 66 265Q66 355 99 435Q131 514 194 576Q256 638 340 671Q424 704 519 704Q624 704 710 664Q796 623 854 547Q891 499 910 443Q929 387 929 326Q929 195 850 119Q770 43 632 42L632 121ZM632 237L632 288Q632 349 597 387Q562 425 506 425Q447 425 414 382Q381 339 381 262Q381 185 414 142Q447 99 507 99Q563 99 597 137Q632 176 632 237Z"},
+	'A':      {722, -6, 0, 732, 729, "M200 264L468 264L334 611L200 264ZM-6 0L-6 52L58 52L318 729L400 729L660 52L732 52L732 0L467 0L467 52L548 52L487 212L180 212L119 52L199 52L199 0L-6 0Z"},
+	'B':      {735, 55, 0, 674, 729, "M247 52L393 52Q481 52 521 90Q562 128 562 211Q562 293 522 331Q481 369 393 369L247 369L247 52ZM247 421L371 421Q451 421 488 452Q525 482 525 549Q525 616 488 646Q451 677 371 677L247 677L247 421ZM55 0L55 52L148 52L148 677L55 677L55 729L415 729Q525 729 581 684Q637 639 637 549Q637 484 598 445Q560 407 485 398Q578 386 626 339Q674 291 674 211Q674 103 605 51Q537 0 392 0L55 0Z"},
+	'C':      {765, 56, -14, 705, 742, "M705 193Q673 91 597 38Q521 -14 405 -14Q333 -14 272 10Q211 35 164 82Q109 137 83 206Q56 276 56 364Q56 534 154 638Q252 742 413 742Q473 742 540 727Q607 711 685 679L685 511L630 511Q612 603 557 646Q503 690 405 690Q288 690 228 607Q168 524 168 364Q168 204 228 121Q288 38 405 38Q486 38 539 77Q592 116 615 193L705 193Z"},
+	'D':      {802, 55, 0, 744, 729, "M247 52L338 52Q480 52 556 133Q632 214 632 365Q632 517 556 597Q480 677 338 677L247 677L247 52ZM55 0L55 52L148 52L148 677L55 677L55 729L345 729Q534 729 639 633Q744 537 744 365Q744 193 639 97Q533 0 345 0L55 0Z"},
+	'E':      {730, 55, 0, 650, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L642 729L642 567L582 567L582 669L247 669L247 425L486 425L486 516L546 516L546 274L486 274L486 365L247 365L247 60L590 60L590 162L650 162L650 0L55 0Z"},
+	'F':      {694, 55, 0, 652, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L652 729L652 567L592 567L592 669L247 669L247 425L496 425L496 516L556 516L556 274L496 274L496 365L247 365L247 52L364 52L364 0L55 0Z"},
+	'G':      {799, 56, -14, 720, 742, "M640 511Q623 603 569 646Q515 690 418 690Q292 690 230 609Q168 528 168 364Q168 203 232 121Q296 38 420 38Q475 38 525 52Q576 65 621 93L621 281L484 281L484 333L720 333L720 61Q655 23 580 5Q505 -14 420 -14Q255 -14 156 89Q56 192 56 364Q56 537 156 639Q255 742 424 742Q486 742 553 728Q620 713 695 684L695 511L640 511Z"},
+	'H':      {872, 55, 0, 817, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 425L625 425L625 677L532 677L532 729L817 729L817 677L724 677L724 52L817 52L817 0L532 0L532 52L625 52L625 365L247 365L247 52L340 52L340 0L55 0Z"},
+	'I':      {395, 55, 0, 340, 729, "M247 52L340 52L340 0L55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 52Z"},
+	'J':      {401, -84, -208, 355, 729, "M-84 -175L-84 -60L-28 -60Q-27 -108 -5 -132Q16 -156 59 -156Q117 -156 140 -119Q163 -83 163 24L163 677L50 677L50 729L355 729L355 677L262 677L262 20Q262 -101 214 -154Q166 -208 58 -208Q23 -208 -12 -200Q-48 -191 -84 -175Z"},
+	'K':      {747, 55, 0, 762, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 401L561 677L482 677L482 729L724 729L724 677L642 677L329 402L679 52L762 52L762 0L593 0L247 346L247 52L340 52L340 0L55 0Z"},
+	'L':      {664, 55, 0, 640, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 60L580 60L580 182L640 182L640 0L55 0Z"},
+	'M':      {1024, 50, 0, 973, 729, "M55 0L55 52L148 52L148 677L50 677L50 729L262 729L518 210L774 729L973 729L973 677L876 677L876 52L969 52L969 0L684 0L684 52L777 52L777 615L527 107L458 107L208 615L208 52L301 52L301 0L55 0Z"},
+	'N':      {875, 49, -14, 831, 729, "M49 0L49 52L147 52L147 677L49 677L49 729L236 729L673 154L673 677L575 677L575 729L831 729L831 677L733 677L733 -14L674 -14L207 600L207 52L305 52L305 0L49 0Z"},
+	'O':      {820, 56, -14, 764, 742, "M410 38Q530 38 591 120Q652 203 652 364Q652 525 591 608Q530 690 410 690Q290 690 229 608Q168 525 168 364Q168 203 229 120Q290 38 410 38ZM410 -14Q336 -14 274 10Q211 35 164 82Q109 137 83 206Q56 275 56 364Q56 452 83 522Q109 591 164 646Q212 694 273 718Q335 742 410 742Q569 742 667 638Q764 534 764 364Q764 276 737 206Q710 136 656 82Q608 34 546 10Q485 -14 410 -14Z"},
+	'P':      {673, 55, 0, 637, 729, "M247 371L376 371Q449 371 487 410Q525 450 525 524Q525 599 487 638Q449 677 376 677L247 677L247 371ZM55 0L55 52L148 52L148 677L55 677L55 729L400 729Q509 729 573 674Q637 618 637 524Q637 430 573 375Q509 319 400 319L247 319L247 52L360 52L360 0L55 0Z"},
+	'Q':      {820, 56, -160, 764, 742, "M422 -14Q254 -14 155 89Q56 191 56 364Q56 452 83 522Q109 591 164 646Q212 694 273 718Q335 742 410 742Q569 742 667 638Q764 534 764 364Q764 219 691 121Q618 22 489 -6Q515 -39 553 -54Q591 -70 644 -70L659 -70L659 -160Q577 -156 518 -120Q459 -83 422 -14ZM410 38Q530 38 591 120Q652 203 652 364Q652 525 591 608Q530 690 410 690Q290 690 229 608Q168 525 168 364Q168 203 229 120Q290 38 410 38Z"},
+	'R':      {753, 55, 0, 777, 729, "M479 362Q513 353 538 330Q563 308 582 268L688 52L777 52L777 0L605 0L491 232Q458 299 431 319Q404 339 356 339L247 339L247 52L350 52L350 0L55 0L55 52L148 52L148 677L55 677L55 729L425 729Q531 729 589 678Q647 627 647 534Q647 459 605 416Q563 373 479 362ZM247 391L391 391Q465 391 500 426Q535 461 535 534Q535 607 500 642Q465 677 391 677L247 677L247 391Z"},
+	'S':      {685, 84, -14, 612, 742, "M93 35L93 201L149 201Q151 118 197 78Q243 38 336 38Q423 38 469 73Q514 107 514 173Q514 226 487 254Q459 282 370 309L274 338Q169 370 127 417Q84 464 84 547Q84 640 150 691Q216 742 335 742Q386 742 446 731Q507 720 575 699L575 544L520 544Q512 621 469 656Q425 690 337 690Q260 690 219 658Q179 627 179 567Q179 515 209 485Q240 455 338 426L428 399Q527 369 570 323Q612 277 612 199Q612 93 544 40Q476 -14 342 -14Q282 -14 219 -2Q157 10 93 35Z"},
+	'T':      {667, 10, 0, 657, 729, "M191 0L191 52L284 52L284 671L70 671L70 557L10 557L10 729L657 729L657 557L597 557L597 671L383 671L383 52L476 52L476 0L191 0Z"},
+	'U':      {843, 47, -14, 798, 729, "M140 677L47 677L47 729L332 729L332 677L239 677L239 300Q239 160 284 105Q330 50 442 50Q554 50 599 105Q645 160 645 300L645 677L552 677L552 729L798 729L798 677L705 677L705 290Q705 124 641 55Q577 -14 424 -14Q271 -14 205 55Q140 125 140 290L140 677Z"},
+	'V':      {722, -10, 0, 737, 729, "M175 677L390 117L605 677L523 677L523 729L737 729L737 677L666 677L406 0L322 0L63 677L-10 677L-10 729L256 729L256 677L175 677Z"},
+	'W':      {1028, 5, 0, 1025, 729, "M762 0L682 0L515 593L348 0L268 0L77 677L5 677L5 729L271 729L271 677L180 677L332 138L498 729L577 729L746 132L899 677L815 677L815 729L1025 729L1025 677L953 677L762 0Z"},
+	'X':      {712, 6, 0, 707, 729, "M331 312L153 52L247 52L247 0L6 0L6 52L90 52L300 358L87 677L9 677L9 729L298 729L298 677L212 677L368 442L529 677L435 677L435 729L674 729L674 677L592 677L399 396L629 52L707 52L707 0L418 0L418 52L505 52L331 312Z"},
+	'Y':      {660, -11, 0, 669, 729, "M189 0L189 52L282 52L282 314L57 677L-11 677L-11 729L260 729L260 677L175 677L357 382L539 677L457 677L457 729L669 729L669 677L600 677L381 323L381 52L474 52L474 0L189 0Z"},
+	'Z':      {695, 45, 0, 656, 729, "M45 0L45 35L499 669L122 669L122 560L62 560L62 729L638 729L638 694L184 60L596 60L596 162L656 162L656 0L45 0Z"},
+	'[':      {390, 86, -132, 313, 760, "M86 760L313 760L313 708L179 708L179 -80L313 -80L313 -132L86 -132L86 760Z"},
+	'\\':     {337, 0, -93, 337, 729, "M78 729L337 -93L259 -93L0 729L78 729Z"},
+	']':      {390, 77, -132, 304, 760, "M304 760L304 -132L77 -132L77 -80L211 -80L211 708L77 708L77 760L304 760Z"},
+	'^':      {838, 106, 457, 732, 729, "M467 729L732 457L657 457L419 643L181 457L106 457L371 729L467 729Z"},
+	'_':      {500, 0, -236, 500, -197, "M500 -197L500 -236L0 -236L0 -197L500 -197Z"},
+	'`':      {500, 83, 615, 306, 799, "M179 799L306 615L249 615L83 799L179 799Z"},
+	'a':      {596, 50, -14, 568, 533, "M398 163L398 273L282 273Q215 273 183 244Q150 215 150 156Q150 102 183 70Q216 38 273 38Q329 38 364 73Q398 107 398 163ZM488 324L488 52L568 52L568 0L398 0L398 56Q368 20 329 3Q290 -14 238 -14Q151 -14 101 32Q50 78 50 156Q50 236 108 281Q166 325 272 325L398 325L398 361Q398 420 362 452Q326 485 261 485Q208 485 176 460Q144 436 136 388L90 388L90 493Q137 513 181 523Q225 533 267 533Q375 533 431 480Q488 426 488 324Z"},
+	'b':      {640, 29, -14, 590, 760, "M115 52L115 708L29 708L29 760L205 760L205 438Q231 487 272 510Q313 533 373 533Q469 533 529 458Q590 382 590 260Q590 138 529 62Q469 -14 373 -14Q313 -14 272 9Q231 32 205 81L205 0L29 0L29 52L115 52ZM205 234Q205 140 241 91Q277 42 345 42Q414 42 449 97Q485 152 485 260Q485 368 449 422Q414 477 345 477Q277 477 241 428Q205 378 205 285L205 234Z"},
+	'c':      {560, 50, -14, 514, 533, "M514 156Q495 73 441 29Q387 -14 301 -14Q188 -14 119 61Q50 136 50 260Q50 384 119 459Q188 533 301 533Q350 533 399 522Q448 510 497 487L497 354L445 354Q435 422 400 454Q366 485 302 485Q229 485 192 428Q155 372 155 260Q155 147 192 91Q229 34 302 34Q360 34 395 64Q429 95 442 156L514 156Z"},
+	'd':      {640, 50, -14, 611, 760, "M525 52L611 52L611 0L435 0L435 81Q409 32 368 9Q327 -14 267 -14Q171 -14 111 62Q50 138 50 260Q50 382 110 458Q171 533 267 533Q327 533 368 510Q409 487 435 438L435 708L350 708L350 760L525 760L525 52ZM435 234L435 285Q435 378 399 428Q363 477 295 477Q226 477 190 422Q155 368 155 260Q155 152 190 97Q226 42 295 42Q363 42 399 91Q435 140 435 234Z"},
+	'e':      {592, 50, -14, 542, 533, "M542 250L155 250L155 246Q155 141 194 88Q234 34 311 34Q370 34 408 65Q446 96 461 157L533 157Q512 72 454 29Q396 -14 302 -14Q188 -14 119 61Q50 136 50 260Q50 383 118 458Q186 533 296 533Q414 533 477 461Q540 388 542 250ZM436 302Q433 393 398 439Q362 485 296 485Q234 485 198 438Q163 392 155 302L436 302Z"},
+	'f':      {370, 36, 0, 430, 760, "M430 637L383 637Q382 674 362 693Q342 712 303 712Q253 712 232 684Q212 657 212 586L212 519L357 519L357 467L212 467L212 52L327 52L327 0L36 0L36 52L122 52L122 467L36 467L36 519L122 519L122 584Q122 671 167 715Q212 760 300 760Q333 760 365 754Q398 748 430 736L430 637Z"},
+	'g':      {640, 50, -222, 611, 533, "M525 467L525 11Q525 -101 463 -161Q402 -222 288 -222Q237 -222 190 -213Q143 -204 100 -185L100 -76L147 -76Q156 -127 188 -150Q221 -174 282 -174Q361 -174 398 -129Q435 -84 435 11L435 81Q409 32 368 9Q327 -14 267 -14Q171 -14 111 62Q50 138 50 260Q50 382 110 458Q171 533 267 533Q327 533 368 510Q409 487 435 438L435 519L611 519L611 467L525 467ZM435 285Q435 378 399 428Q363 477 295 477Q226 477 190 422Q155 368 155 260Q155 152 190 97Q226 42 295 42Q363 42 399 91Q435 140 435 234L435 285Z"},
+	'h':      {644, 36, 0, 616, 760, "M41 0L41 52L122 52L122 708L36 708L36 760L212 760L212 427Q237 479 277 506Q316 533 369 533Q455 533 496 484Q536 435 536 330L536 52L616 52L616 0L368 0L368 52L446 52L446 302Q446 397 423 432Q399 467 340 467Q277 467 245 421Q212 376 212 289L212 52L290 52L290 0L41 0Z"},
+	'i':      {320, 36, 0, 297, 736, "M97 680Q97 703 114 719Q130 736 153 736Q175 736 192 719Q208 703 208 680Q208 657 192 641Q176 625 153 625Q130 625 114 641Q97 657 97 680ZM212 52L297 52L297 0L36 0L36 52L122 52L122 467L36 467L36 519L212 519L212 52Z"},
+	'j':      {310, -96, -222, 212, 736, "M100 680Q100 703 116 719Q133 736 156 736Q178 736 195 719Q211 703 211 680Q211 657 195 641Q179 625 156 625Q133 625 116 641Q100 657 100 680ZM122 467L37 467L37 519L212 519L212 -51Q212 -131 164 -177Q117 -222 33 -222Q-2 -222 -34 -214Q-66 -206 -96 -190L-96 -83L-50 -83Q-46 -130 -26 -152Q-5 -174 35 -174Q80 -174 101 -144Q122 -114 122 -51L122 467Z"},
+	'k':      {606, 29, 0, 613, 760, "M286 0L34 0L34 52L115 52L115 708L29 708L29 760L205 760L205 265L424 467L349 467L349 519L584 519L584 467L495 467L341 324L538 52L613 52L613 0L357 0L357 52L431 52L276 265L205 199L205 52L286 52L286 0Z"},
+	'l':      {320, 29, 0, 290, 760, "M205 52L290 52L290 0L29 0L29 52L115 52L115 708L29 708L29 760L205 760L205 52Z"},
+	'm':      {948, 36, 0, 921, 533, "M518 418Q544 475 584 504Q625 533 678 533Q760 533 800 483Q840 432 840 330L840 52L921 52L921 0L672 0L672 52L750 52L750 320Q750 399 727 433Q703 467 649 467Q589 467 557 421Q526 376 526 289L526 52L604 52L604 0L358 0L358 52L436 52L436 323Q436 401 413 434Q389 467 335 467Q275 467 243 421Q212 376 212 289L212 52L290 52L290 0L41 0L41 52L122 52L122 468L36 468L36 519L212 519L212 427Q237 479 275 506Q314 533 363 533Q423 533 464 503Q504 473 518 418Z"},
+	'n':      {644, 36, 0, 616, 533, "M41 0L41 52L122 52L122 467L36 467L36 519L212 519L212 427Q237 479 277 506Q316 533 369 533Q455 533 496 484Q536 435 536 330L536 52L616 52L616 0L368 0L368 52L446 52L446 302Q446 397 422 432Q399 468 340 468Q277 468 245 422Q212 376 212 289L212 52L290 52L290 0L41 0Z"},
+	'o':      {602, 50, -14, 552, 533, "M301 34Q373 34 410 91Q447 148 447 260Q447 371 410 428Q373 485 301 485Q229 485 192 428Q155 371 155 260Q155 148 192 91Q229 34 301 34ZM301 -14Q188 -14 119 61Q50 136 50 260Q50 384 118 458Q187 533 301 533Q415 533 483 458Q552 384 552 260Q552 136 483 61Q415 -14 301 -14Z"},
+	'p':      {640, 29, -208, 590, 533, "M205 285L205 234Q205 140 241 91Q277 42 345 42Q414 42 449 97Q485 152 485 260Q485 368 449 422Q414 477 345 477Q277 477 241 428Q205 378 205 285ZM115 467L29 467L29 519L205 519L205 438Q231 487 272 510Q313 533 373 533Q469 533 529 458Q590 382 590 260Q590 138 529 62Q469 -14 373 -14Q313 -14 272 9Q231 32 205 81L205 -156L290 -156L290 -208L29 -208L29 -156L115 -156L115 467Z"},
+	'q':      {640, 50, -208, 611, 533, "M525 467L525 -156L611 -156L611 -208L350 -208L350 -156L435 -156L435 81Q409 32 368 9Q327 -14 267 -14Q171 -14 111 62Q50 138 50 260Q50 382 110 458Q171 533 267 533Q327 533 368 510Q409 487 435 438L435 519L611 519L611 467L525 467ZM435 285Q435 378 399 428Q363 477 295 477Q226 477 190 422Q155 368 155 260Q155 152 190 97Q226 42 295 42Q363 42 399 91Q435 140 435 234L435 285Z"},
+	'r':      {478, 36, 0, 478, 533, "M478 520L478 390L426 390Q424 429 405 448Q386 467 349 467Q283 467 247 421Q212 375 212 289L212 52L316 52L316 0L41 0L41 52L122 52L122 468L36 468L36 519L212 519L212 427Q238 481 280 507Q321 533 381 533Q403 533 427 530Q451 526 478 520Z"},
+	's':      {513, 56, -14, 462, 533, "M56 29L56 150L108 150Q110 92 144 63Q179 34 246 34Q307 34 338 57Q370 80 370 123Q370 157 347 178Q324 199 249 223L184 245Q117 267 87 299Q57 331 57 381Q57 452 109 493Q162 533 254 533Q295 533 340 522Q386 512 434 491L434 378L382 378Q380 428 347 457Q314 485 257 485Q200 485 171 465Q142 445 142 405Q142 372 164 352Q186 333 252 312L323 290Q397 267 429 233Q462 198 462 144Q462 70 406 28Q349 -14 250 -14Q200 -14 152 -3Q104 7 56 29Z"},
+	't':      {402, 29, -14, 394, 680, "M108 467L29 467L29 519L108 519L108 680L198 680L198 519L367 519L367 467L198 467L198 137Q198 71 211 53Q224 34 258 34Q293 34 309 55Q325 76 326 122L394 122Q390 51 355 19Q321 -14 250 -14Q172 -14 140 20Q108 55 108 137L108 467Z"},
+	'u':      {644, 27, -14, 607, 519, "M354 519L522 519L522 52L607 52L607 0L432 0L432 92Q407 40 368 13Q328 -14 276 -14Q189 -14 149 35Q108 84 108 189L108 467L27 467L27 519L198 519L198 217Q198 122 221 87Q245 52 304 52Q367 52 399 98Q432 144 432 231L432 467L354 467L354 519Z"},
+	'v':      {565, -3, 0, 562, 519, "M247 0L56 467L-3 467L-3 519L236 519L236 467L153 467L299 110L445 467L367 467L367 519L562 519L562 467L504 467L313 0L247 0Z"},
+	'w':      {856, 16, 0, 843, 519, "M480 519L613 114L730 467L655 467L655 519L843 519L843 467L785 467L631 0L556 0L428 388L300 0L228 0L74 467L16 467L16 519L251 519L251 467L167 467L283 114L417 519L480 519Z"},
+	'x':      {564, 12, 0, 552, 519, "M291 317L400 467L330 467L330 519L530 519L530 467L461 467L322 275L484 52L552 52L552 0L312 0L312 52L378 52L265 207L152 52L219 52L219 0L22 0L22 52L91 52L234 249L76 467L12 467L12 519L244 519L244 467L182 467L291 317Z"},
+	'y':      {565, -3, -222, 562, 519, "M216 -95L250 -9L56 467L-3 467L-3 519L236 519L236 467L153 467L299 110L445 467L367 467L367 519L562 519L562 467L504 467L266 -117Q242 -178 212 -200Q182 -222 128 -222Q105 -222 81 -218Q57 -214 32 -207L32 -108L78 -108Q81 -141 95 -156Q109 -170 138 -170Q165 -170 181 -155Q198 -140 216 -95Z"},
+	'z':      {527, 40, 0, 490, 519, "M40 0L40 42L365 467L108 467L108 377L56 377L56 519L481 519L481 477L156 52L438 52L438 146L490 146L490 0L40 0Z"},
+	'{':      {636, 125, -163, 511, 760, "M511 -112L511 -163L481 -163Q359 -163 318 -127Q277 -91 277 17L277 143Q277 216 250 245Q224 273 155 273L125 273L125 324L155 324Q224 324 250 353Q277 381 277 454L277 580Q277 688 318 724Q359 760 481 760L511 760L511 709L478 709Q409 709 388 688Q367 666 367 597L367 457Q367 380 343 347Q318 313 255 299Q319 282 343 250Q367 217 367 140L367 0Q367 -69 388 -90Q409 -112 478 -112L511 -112Z"},
+	'|':      {337, 129, -236, 208, 764, "M208 764L208 -236L129 -236L129 764L208 764Z"},
+	'}':      {636, 125, -163, 511, 760, "M125 -112L159 -112Q228 -112 248 -91Q269 -69 269 0L269 140Q269 217 293 250Q317 282 381 299Q317 313 293 347Q269 380 269 457L269 597Q269 667 248 688Q228 709 159 709L125 709L125 760L156 760Q277 760 318 724Q359 688 359 580L359 454Q359 381 385 353Q412 324 481 324L511 324L511 273L481 273Q412 273 385 245Q359 216 359 143L359 17Q359 -91 318 -127Q277 -163 156 -163L125 -163L125 -112Z"},
+	'~':      {838, 106, 233, 732, 394, "M732 394L732 322Q683 279 639 258Q595 238 550 238Q503 238 418 270L413 272Q408 274 397 278Q325 306 279 306Q234 306 192 288Q149 271 106 233L106 307Q155 350 198 369Q242 389 288 389Q335 389 420 357L425 355Q430 353 440 349Q511 321 558 321Q603 321 646 339Q689 357 732 394Z"},
+	'\u00a0': {318, 0, 0, 0, 0, ""},
+	'¨':      {500, 98, 652, 402, 766, "M155 766Q178 766 195 749Q212 732 212 709Q212 684 196 668Q180 652 155 652Q130 652 114 668Q98 684 98 709Q98 732 115 749Q132 766 155 766ZM345 766Q368 766 385 749Q402 732 402 709Q402 684 386 668Q370 652 345 652Q320 652 304 668Q288 684 288 709Q288 732 305 749Q322 766 345 766Z"},
+	'¬':      {838, 106, 140, 732, 421, "M106 421L732 421L732 140L654 140L654 342L106 342L106 421Z"},
+	'¯':      {500, 104, 673, 396, 745, "M104 745L396 745L396 673L104 673L104 745Z"},
+	'±':      {838, 106, 0, 732, 627, "M458 627L458 436L732 436L732 357L458 357L458 166L380 166L380 357L106 357L106 436L380 436L380 627L458 627ZM106 78L732 78L732 0L106 0L106 78Z"},
+	'×':      {838, 138, 33, 700, 594, "M700 539L474 313L700 88L644 33L419 258L194 33L138 88L363 313L138 539L194 594L419 369L644 594L700 539Z"},
+	'÷':      {838, 106, 68, 732, 559, "M354 494Q354 521 373 540Q392 559 419 559Q446 559 465 540Q484 521 484 494Q484 467 465 448Q446 429 419 429Q391 429 373 448Q354 466 354 494ZM354 133Q354 160 373 179Q392 198 419 198Q446 198 465 179Q484 160 484 133Q484 106 465 87Q446 68 419 68Q391 68 373 87Q354 105 354 133ZM106 353L732 353L732 274L106 274L106 353Z"},
+	'ɛ':      {518, 49, -19, 472, 524, "M78 396Q78 458 125 492Q173 524 269 524Q295 524 346 517Q397 508 452 493L452 385L399 385Q393 429 358 451Q323 473 277 473Q163 473 163 391Q163 298 289 298L334 298L334 247L289 247Q212 247 174 216Q138 187 138 130Q138 92 164 67Q207 27 276 27Q333 27 374 53Q415 79 419 129L472 129L472 16Q420 -1 368 -10Q317 -19 278 -19Q168 -19 108 20Q49 58 49 131Q49 197 101 235Q135 262 194 274Q154 284 127 301Q78 333 78 396Z"},
+	'ˆ':      {500, 94, 616, 406, 800, "M209 800L291 800L406 616L349 616L250 743L151 616L94 616L209 800Z"},
+	'˙':      {500, 193, 652, 307, 766, "M250 766Q273 766 290 749Q307 732 307 709Q307 684 291 668Q275 652 250 652Q225 652 209 668Q193 684 193 709Q193 732 210 749Q227 766 250 766Z"},
+	'˜':      {500, 89, 639, 411, 777, "M248 669L220 696Q208 708 199 711Q191 715 182 715Q162 715 150 696Q138 676 136 639L89 639Q90 705 115 741Q140 777 184 777Q203 777 218 770Q234 763 252 747L280 720Q291 709 299 705Q308 701 318 701Q339 701 351 721Q362 740 364 777L411 777Q410 711 385 675Q360 639 316 639Q297 639 282 646Q266 653 248 669Z"},
+	'̲':      {0, -500, -236, 0, -197, "M0 -197L0 -236L-500 -236L-500 -197L0 -197Z"},
+	'Α':      {722, -6, 0, 732, 729, "M200 264L468 264L334 611L200 264ZM-6 0L-6 52L58 52L318 729L400 729L660 52L732 52L732 0L467 0L467 52L548 52L487 212L180 212L119 52L199 52L199 0L-6 0Z"},
+	'Β':      {735, 55, 0, 674, 729, "M247 52L393 52Q481 52 521 90Q562 128 562 211Q562 293 522 331Q481 369 393 369L247 369L247 52ZM247 421L371 421Q451 421 488 452Q525 482 525 549Q525 616 488 646Q451 677 371 677L247 677L247 421ZM55 0L55 52L148 52L148 677L55 677L55 729L415 729Q525 729 581 684Q637 639 637 549Q637 484 598 445Q560 407 485 398Q578 386 626 339Q674 291 674 211Q674 103 605 51Q537 0 392 0L55 0Z"},
+	'Γ':      {694, 55, 0, 652, 729, "M247 52L364 52L364 0L55 0L55 52L148 52L148 677L55 677L55 729L652 729L652 566L592 566L592 669L247 669L247 52Z"},
+	'Δ':      {722, 38, 0, 680, 729, "M680 0L38 0L318 729L400 729L680 0ZM548 52L334 611L119 52L548 52Z"},
+	'Ε':      {730, 55, 0, 650, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L642 729L642 567L582 567L582 669L247 669L247 425L486 425L486 516L546 516L546 274L486 274L486 365L247 365L247 60L590 60L590 162L650 162L650 0L55 0Z"},
+	'Ζ':      {695, 45, 0, 656, 729, "M45 0L45 35L499 669L122 669L122 560L62 560L62 729L638 729L638 694L184 60L596 60L596 162L656 162L656 0L45 0Z"},
+	'Η':      {872, 55, 0, 817, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 425L625 425L625 677L532 677L532 729L817 729L817 677L724 677L724 52L817 52L817 0L532 0L532 52L625 52L625 365L247 365L247 52L340 52L340 0L55 0Z"},
+	'Θ':      {820, 56, -14, 764, 742, "M410 38Q530 38 591 120Q652 203 652 364Q652 525 591 608Q530 690 410 690Q290 690 229 608Q168 525 168 364Q168 203 229 120Q290 38 410 38ZM410 -14Q336 -14 274 10Q211 35 164 82Q109 137 83 206Q56 275 56 364Q56 452 83 522Q109 591 164 646Q212 694 273 718Q335 742 410 742Q569 742 667 638Q764 534 764 364Q764 276 737 206Q710 136 656 82Q608 34 546 10Q485 -14 410 -14ZM289 365L289 274L229 274L229 516L289 516L289 425L531 425L531 516L591 516L591 274L531 274L531 365L289 365Z"},
+	'Ι':      {395, 55, 0, 340, 729, "M247 52L340 52L340 0L55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 52Z"},
+	'Κ':      {747, 55, 0, 762, 729, "M55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 401L561 677L482 677L482 729L724 729L724 677L642 677L329 402L679 52L762 52L762 0L593 0L247 346L247 52L340 52L340 0L55 0Z"},
+	'Λ':      {722, -6, 0, 732, 729, "M548 52L334 611L119 52L199 52L199 0L-6 0L-6 52L58 52L318 729L400 729L660 52L732 52L732 0L467 0L467 52L548 52Z"},
+	'Μ':      {1024, 50, 0, 973, 729, "M55 0L55 52L148 52L148 677L50 677L50 729L262 729L518 210L774 729L973 729L973 677L876 677L876 52L969 52L969 0L684 0L684 52L777 52L777 615L527 107L458 107L208 615L208 52L301 52L301 0L55 0Z"},
+	'Ν':      {875, 49, -14, 831, 729, "M49 0L49 52L147 52L147 677L49 677L49 729L236 729L673 154L673 677L575 677L575 729L831 729L831 677L733 677L733 -14L674 -14L207 600L207 52L305 52L305 0L49 0Z"},
+	'Ξ':      {704, 55, 0, 642, 729, "M582 92L582 162L642 162L642 0L55 0L55 162L115 162L115 92L582 92ZM582 637L115 637L115 567L55 567L55 729L642 729L642 567L582 567L582 637ZM234 365L234 274L174 274L174 516L234 516L234 425L463 425L463 516L523 516L523 274L463 274L463 365L234 365Z"},
+	'Ο':      {820, 56, -14, 764, 742, "M410 38Q530 38 591 120Q652 203 652 364Q652 525 591 608Q530 690 410 690Q290 690 229 608Q168 525 168 364Q168 203 229 120Q290 38 410 38ZM410 -14Q336 -14 274 10Q211 35 164 82Q109 137 83 206Q56 275 56 364Q56 452 83 522Q109 591 164 646Q212 694 273 718Q335 742 410 742Q569 742 667 638Q764 534 764 364Q764 276 737 206Q710 136 656 82Q608 34 546 10Q485 -14 410 -14Z"},
+	'Π':      {872, 55, 0, 817, 729, "M817 729L817 677L724 677L724 52L817 52L817 0L532 0L532 52L625 52L625 669L247 669L247 52L340 52L340 0L55 0L55 52L148 52L148 677L55 677L55 729L817 729Z"},
+	'Ρ':      {673, 55, 0, 637, 729, "M247 371L376 371Q449 371 487 410Q525 450 525 524Q525 599 487 638Q449 677 376 677L247 677L247 371ZM55 0L55 52L148 52L148 677L55 677L55 729L400 729Q509 729 573 674Q637 618 637 524Q637 430 573 375Q509 319 400 319L247 319L247 52L360 52L360 0L55 0Z"},
+	'Σ':      {707, 41, 0, 640, 729, "M469 397L199 92L580 92L580 162L640 162L640 0L41 0L365 365L41 729L640 729L640 567L580 567L580 669L240 669L469 397Z"},
+	'Τ':      {667, 10, 0, 657, 729, "M191 0L191 52L284 52L284 671L70 671L70 557L10 557L10 729L657 729L657 557L597 557L597 671L383 671L383 52L476 52L476 0L191 0Z"},
+	'Υ':      {660, -11, 0, 669, 729, "M189 0L189 52L282 52L282 314L57 677L-11 677L-11 729L260 729L260 677L175 677L357 382L539 677L457 677L457 729L669 729L669 677L600 677L381 323L381 52L474 52L474 0L189 0Z"},
+	'Φ':      {820, 56, 0, 765, 729, "M657 167Q608 132 547 116Q498 104 460 99L460 52L553 52L553 0L268 0L268 52L361 52L361 99Q323 104 273 116Q213 132 164 167Q109 205 83 253Q56 302 56 364Q56 426 83 474Q109 523 164 562Q214 597 273 612Q306 620 361 628L361 677L268 677L268 729L553 729L553 677L460 677L460 628Q515 620 547 612Q606 597 657 562Q711 523 738 474Q765 426 765 364Q765 302 738 253Q711 205 657 167ZM460 139Q545 149 592 193Q653 251 653 364Q653 481 592 535Q543 577 460 590L460 139ZM361 139L361 590Q277 577 229 535Q168 481 168 364Q168 251 229 193Q276 149 361 139Z"},
+	'Χ':      {712, 6, 0, 707, 729, "M331 312L153 52L247 52L247 0L6 0L6 52L90 52L300 358L87 677L9 677L9 729L298 729L298 677L212 677L368 442L529 677L435 677L435 729L674 729L674 677L592 677L399 396L629 52L707 52L707 0L418 0L418 52L505 52L331 312Z"},
+	'Ψ':      {877, 47, 0, 838, 729, "M646 445L646 587Q646 729 740 729L838 729L838 677L789 677Q744 677 745 587L745 437Q745 298 680 240Q623 188 492 181L492 52L585 52L585 0L300 0L300 52L393 52L393 181Q259 188 205 240Q140 301 140 437L140 587Q141 677 97 677L47 677L47 729L145 729Q239 729 239 587L239 445Q238 328 284 282Q316 250 393 237L393 677L300 677L300 729L585 729L585 677L492 677L492 237Q568 249 601 282Q646 328 646 445Z"},
+	'Ω':      {829, 61, 0, 769, 742, "M547 80L711 80L711 147L768 147L768 0L479 0L479 89Q567 123 612 199Q657 275 657 388Q657 524 590 607Q523 690 415 690Q307 690 240 607Q173 524 173 388Q173 274 218 199Q263 123 350 89L350 0L62 0L62 147L119 147L119 80L282 80Q180 123 121 208Q61 294 61 399Q61 473 89 537Q117 601 171 651Q219 695 282 719Q344 742 415 742Q486 742 548 719Q610 696 658 651Q712 601 741 536Q769 472 769 399Q769 295 709 209Q649 123 547 80Z"},
+	'Ϊ':      {395, 48, 0, 352, 920, "M247 52L340 52L340 0L55 0L55 52L148 52L148 677L55 677L55 729L340 729L340 677L247 677L247 52ZM104 920Q128 920 145 903Q162 886 162 863Q162 839 145 823Q129 806 104 806Q81 806 64 823Q48 839 48 863Q48 886 65 903Q82 920 104 920ZM295 920Q318 920 335 903Q352 886 352 863Q352 839 335 823Q319 806 295 806Q271 806 254 823Q238 839 238 863Q238 886 255 903Q271 920 295 920Z"},
+	'Ϋ':      {660, -11, 0, 669, 920, "M189 0L189 52L282 52L282 314L57 677L-11 677L-11 729L260 729L260 677L175 677L357 382L539 677L457 677L457 729L669 729L669 677L600 677L381 323L381 52L474 52L474 0L189 0ZM263 920Q286 920 303 903Q320 886 320 863Q320 839 303 823Q287 806 263 806Q239 806 222 823Q206 839 206 863Q206 886 223 903Q240 920 263 920ZM453 920Q476 920 493 903Q510 886 510 863Q510 839 493 823Q477 806 453 806Q429 806 412 823Q396 839 396 863Q396 886 413 903Q430 920 453 920Z"},
+	'ά':      {675, 50, -33, 648, 800, "M432 128L412 74Q397 32 351 5Q320 -13 267 -12Q170 -11 110 63Q50 140 50 262Q50 381 110 459Q167 533 288 533Q371 533 413 500Q450 471 464 408L468 390L487 444Q505 496 555 521L604 521L604 492Q558 478 541 432L490 293L524 144Q542 67 561 59Q587 46 648 83L648 24Q549 -33 479 26Q448 54 439 93L432 128ZM411 224L380 367Q355 481 289 482Q233 482 190 422Q155 373 155 260Q155 152 190 97Q229 37 294 42Q351 46 391 166L411 224ZM367 800L464 800L298 616L241 616L367 800Z"},
+	'έ':      {518, 49, -19, 472, 800, "M78 396Q78 458 125 492Q173 524 269 524Q295 524 346 517Q397 508 452 493L452 385L399 385Q393 429 358 451Q323 473 277 473Q163 473 163 391Q163 298 289 298L334 298L334 247L289 247Q212 247 174 216Q138 187 138 130Q138 92 164 67Q207 27 276 27Q333 27 374 53Q415 79 419 129L472 129L472 16Q420 -1 368 -10Q317 -19 278 -19Q168 -19 108 20Q49 58 49 131Q49 197 101 235Q135 262 194 274Q154 284 127 301Q78 333 78 396ZM318 800L415 800L249 616L192 616L318 800Z"},
+	'ή':      {599, 36, -208, 536, 800, "M122 0L122 467L36 467L36 519L212 519L212 427Q237 479 277 506Q316 533 369 533Q455 533 496 484Q536 435 536 330L536 -208L446 -208L446 302Q446 397 422 432Q399 468 340 468Q277 468 245 422Q212 376 212 289L212 0L122 0ZM355 800L452 800L286 616L229 616L355 800Z"},
+	'ί':      {392, 36, -35, 336, 800, "M122 142L122 467L36 467L36 519L212 519L212 142Q212 74 248 57Q275 45 336 81L336 22Q236 -35 167 24Q122 63 122 142ZM228 800L325 800L159 616L102 616L228 800Z"},
+	'ΰ':      {608, 36, -3, 559, 996, "M360 519L415 519Q497 480 526 424Q559 361 559 249Q559 195 538 148Q517 100 478 64Q442 31 396 14Q351 -3 298 -3Q218 0 167 46Q122 86 122 171L122 467L36 467L36 519L212 519L212 171Q212 107 232 78Q253 49 298 48Q359 47 414 112Q458 164 458 257Q458 389 440 417Q408 467 360 490L360 519ZM197 766Q221 766 238 749Q254 732 254 709Q254 684 238 668Q222 652 197 652Q173 652 157 668Q141 684 141 709Q141 732 157 749Q174 766 197 766ZM388 766Q411 766 427 749Q444 732 444 709Q444 684 428 668Q412 652 388 652Q363 652 347 668Q331 684 331 709Q331 732 347 749Q364 766 388 766ZM360 996L458 996L292 813L234 813L360 996Z"},
+	'α':      {675, 50, -33, 648, 533, "M432 128L412 74Q397 32 351 5Q320 -13 267 -12Q170 -11 110 63Q50 140 50 262Q50 381 110 459Q167 533 288 533Q371 533 413 500Q450 471 464 408L468 390L487 444Q505 496 555 521L604 521L604 492Q558 478 541 432L490 293L524 144Q542 67 561 59Q587 46 648 83L648 24Q549 -33 479 26Q448 54 439 93L432 128ZM411 224L380 367Q355 481 289 482Q233 482 190 422Q155 373 155 260Q155 152 190 97Q229 37 294 42Q351 46 391 166L411 224Z"},
+	'β':      {578, 63, -208, 528, 765, "M153 63Q192 34 264 34Q338 34 382 77Q425 121 425 196Q425 298 380 342Q335 387 250 387L204 387L204 439L228 439Q290 439 333 475Q368 504 368 582Q368 616 345 654Q308 718 263 717Q153 713 153 519L153 63ZM63 -208L63 519Q63 723 173 750Q231 765 271 765Q362 765 421 693Q467 637 467 588Q467 519 433 478Q399 437 339 417Q428 405 479 352Q528 299 528 197Q528 95 459 40Q391 -14 262 -14Q183 -14 153 -3L153 -208L63 -208Z"},
+	'γ':      {598, 36, -210, 548, 519, "M348 29Q378 -113 350 -166Q327 -208 293 -209Q241 -210 219 -160Q193 -104 261 8L122 467L36 467L36 519L202 519L329 97Q439 246 453 349Q459 397 447 430Q427 482 404 490L404 519L458 519Q515 485 529 456Q548 415 548 389Q548 342 498 248Q479 211 348 29Z"},
+	'δ':      {602, 50, -14, 552, 765, "M179 506Q146 519 130 542Q112 569 112 636Q112 699 160 732Q208 765 303 765Q330 765 380 757Q431 749 486 734L486 638L433 625Q427 669 392 691Q357 713 311 713Q265 713 231 689Q196 664 197 632Q199 585 215 570Q253 533 301 533Q415 533 483 458Q552 384 552 260Q552 136 483 61Q415 -14 301 -14Q188 -14 119 61Q50 136 50 260Q50 384 118 458Q149 491 179 506ZM301 34Q373 34 410 91Q447 148 447 260Q447 371 410 428Q373 485 301 485Q229 485 192 428Q155 371 155 260Q155 148 192 91Q229 34 301 34Z"},
+	'ε':      {518, 49, -19, 472, 524, "M78 396Q78 458 125 492Q173 524 269 524Q295 524 346 517Q397 508 452 493L452 385L399 385Q393 429 358 451Q323 473 277 473Q163 473 163 391Q163 298 289 298L334 298L334 247L289 247Q212 247 174 216Q138 187 138 130Q138 92 164 67Q207 27 276 27Q333 27 374 53Q415 79 419 129L472 129L472 16Q420 -1 368 -10Q317 -19 278 -19Q168 -19 108 20Q49 58 49 131Q49 197 101 235Q135 262 194 274Q154 284 127 301Q78 333 78 396Z"},
+	'ζ':      {542, 41, -208, 511, 760, "M497 760L497 700Q302 606 211 472Q155 389 155 275Q154 146 192 91Q229 35 326 35Q479 36 500 -72Q511 -127 458 -171Q415 -208 321 -208L321 -156Q419 -156 419 -79Q419 -14 324 -14Q187 -14 119 61Q50 136 50 275Q50 373 132 510Q199 622 384 700L92 700L92 629L41 629L41 760L497 760Z"},
+	'η':      {599, 36, -208, 536, 533, "M122 0L122 467L36 467L36 519L212 519L212 427Q237 479 277 506Q316 533 369 533Q455 533 496 484Q536 435 536 330L536 -208L446 -208L446 302Q446 397 422 432Q399 468 340 468Q277 468 245 422Q212 376 212 289L212 0L122 0Z"},
+	'θ':      {602, 50, -11, 551, 771, "M446 406Q442 563 409 643Q373 731 300 731Q229 731 191 643Q159 563 155 406L446 406ZM446 354L155 354Q159 198 192 119Q229 30 300 30Q372 30 409 119Q442 198 446 354ZM300 771Q414 771 483 664Q551 557 551 380Q551 203 482 96Q414 -11 300 -11Q188 -11 119 96Q50 203 50 380Q50 557 118 664Q187 771 300 771Z"},
+	'ι':      {392, 36, -35, 336, 519, "M122 142L122 467L36 467L36 519L212 519L212 142Q212 74 248 57Q275 45 336 81L336 22Q236 -35 167 24Q122 63 122 142Z"},
+	'κ':      {625, 34, 0, 613, 519, "M286 519L286 467L205 467L205 265L424 467L349 467L349 519L584 519L584 467L495 467L341 324L538 52L613 52L613 0L357 0L357 52L431 52L276 265L205 199L205 52L286 52L286 0L34 0L34 52L115 52L115 467L34 467L34 519L286 519Z"},
+	'λ':      {634, 41, 0, 606, 765, "M260 638Q242 683 225 698Q208 713 182 713Q153 713 139 699Q125 684 122 651L76 651L76 750Q101 757 125 761Q149 765 172 765Q226 765 256 743Q286 721 310 660L548 52L606 52L606 0L411 0L411 52L489 52L351 411L197 52L280 52L280 0L41 0L41 52L100 52L302 529L260 638Z"},
+	'μ':      {650, 34, -208, 614, 519, "M361 519L529 519L529 51L614 51L614 0L439 0L439 92Q414 40 378 13Q342 -14 298 -14Q270 -14 247 -5Q224 3 205 21L205 -208L115 -208L115 467L34 467L34 519L205 519L205 217Q205 122 229 87Q252 52 311 52Q374 52 406 98Q439 144 439 231L439 467L361 467L361 519Z"},
+	'ν':      {608, 36, 0, 555, 519, "M212 57Q271 80 336 137Q375 171 421 256Q451 309 455 357Q459 402 438 437Q411 484 375 490L375 519L430 519Q485 495 524 442Q555 401 555 351Q555 315 541 285Q531 264 496 213Q449 148 389 104Q279 21 212 0L122 0L122 467L36 467L36 519L212 519L212 57Z"},
+	'ξ':      {551, 41, -208, 511, 760, "M413 406L413 354Q283 354 236 324Q155 271 155 197Q155 125 191 70Q215 35 326 35Q479 36 500 -72Q511 -127 458 -171Q415 -208 321 -208L321 -156Q419 -156 419 -79Q419 -14 324 -14Q166 -14 110 44Q50 106 50 197Q50 275 152 354Q171 369 249 383Q118 425 118 530Q118 650 278 700L92 700L92 629L41 629L41 760L497 760L497 700Q223 682 223 524Q223 411 413 406Z"},
+	'ο':      {602, 50, -14, 552, 533, "M301 34Q373 34 410 91Q447 148 447 260Q447 371 410 428Q373 485 301 485Q229 485 192 428Q155 371 155 260Q155 148 192 91Q229 34 301 34ZM301 -14Q188 -14 119 61Q50 136 50 260Q50 384 118 458Q187 533 301 533Q415 533 483 458Q552 384 552 260Q552 136 483 61Q415 -14 301 -14Z"},
+	'π':      {657, 36, 0, 621, 519, "M41 0L41 52L122 52L122 467L36 467L36 519L621 519L621 467L536 467L536 52L616 52L616 0L368 0L368 52L446 52L446 467L212 467L212 52L290 52L290 0L41 0Z"},
+	'ρ':      {588, 63, -208, 538, 533, "M153 285L153 234Q153 140 189 91Q225 42 293 42Q362 42 397 97Q433 152 433 260Q433 372 397 422Q355 482 293 482Q228 482 188 428Q153 378 153 285ZM153 -208L63 -208L63 256Q63 378 105 438Q153 494 186 511Q229 533 292 533Q417 533 477 458Q538 382 538 260Q538 138 477 62Q417 -14 321 -14Q261 -14 220 9Q179 32 153 81L153 -208Z"},
+	'ς':      {560, 50, -208, 511, 533, "M326 35Q479 37 500 -72Q511 -127 458 -171Q415 -208 321 -208L321 -156Q419 -156 419 -79Q419 -14 324 -14Q187 -14 119 61Q50 136 50 260Q50 384 119 459Q188 533 301 533Q350 533 399 522Q448 510 497 487L497 354L445 354Q435 422 400 454Q366 485 302 485Q229 485 192 428Q155 372 155 260Q155 146 191 91Q229 35 326 35Z"},
+	'σ':      {683, 50, -14, 653, 519, "M301 34Q373 34 410 91Q447 148 447 260Q447 361 410 423Q377 476 301 476Q226 476 191 423Q155 366 155 260Q155 148 192 91Q229 34 301 34ZM302 519Q501 519 653 519L653 467L475 467Q552 392 552 260Q552 136 483 61Q415 -14 301 -14Q188 -14 119 61Q50 136 50 260Q50 384 118 458Q174 519 302 519Z"},
+	'τ':      {553, 41, -35, 515, 519, "M323 467L323 142Q323 73 359 57Q386 44 447 81L447 22Q347 -35 278 24Q232 63 233 142L233 467L92 467L92 389L41 389L41 519L515 519L515 467L323 467Z"},
+	'υ':      {608, 36, -3, 559, 519, "M360 519L415 519Q497 480 526 424Q559 361 559 249Q559 195 538 148Q517 100 478 64Q442 31 396 14Q351 -3 298 -3Q218 0 167 46Q122 86 122 171L122 467L36 467L36 519L212 519L212 171Q212 107 232 78Q253 49 298 48Q359 47 414 112Q458 164 458 257Q458 389 440 417Q408 467 360 490L360 519Z"},
+	'φ':      {700, 44, -208, 656, 523, "M395 -208L305 -208L305 -1Q208 0 179 14Q145 30 110 64Q78 95 56 148Q44 177 44 249Q44 361 76 424Q105 480 188 519L243 519L243 490Q195 467 162 417Q144 390 144 257Q144 166 171 112Q196 61 305 51L305 257Q305 367 327 424Q364 520 477 521Q573 523 624 424Q656 362 656 249Q656 177 644 148Q622 95 590 64Q553 27 521 14Q492 1 395 -1L395 -208ZM395 52Q505 63 529 112Q556 165 556 257Q556 365 538 417Q519 473 477 473Q439 473 413 417Q396 381 395 257Q395 119 395 52Z"},
+	'χ':      {606, 12, -222, 584, 533, "M252 -156L252 -208L12 -208L12 -156L80 -156L261 183L196 406Q182 455 167 466Q146 481 138 481Q109 481 95 467Q81 452 78 419L32 419L32 518Q57 525 81 529Q105 533 128 533Q157 533 192 511Q229 487 246 428L298 251L414 466L352 466L352 519L584 519L584 466L520 466L335 123L400 -95Q415 -144 430 -155Q450 -170 458 -170Q487 -170 501 -156Q515 -141 518 -108L564 -108L564 -207Q540 -214 516 -218Q491 -222 468 -222Q439 -222 404 -200Q367 -176 350 -117L299 55L186 -156L252 -156Z"},
+	'ψ':      {784, 63, -208, 721, 519, "M438 -208L348 -208L348 -3Q302 -3 260 14Q228 27 190 64Q156 98 137 148Q125 177 125 257Q125 365 107 417Q89 472 63 490L63 519L113 519Q171 469 193 424Q225 361 225 257Q225 164 251 112Q285 47 348 48L348 519L438 519L438 48Q500 47 533 112Q560 164 560 257Q560 368 591 424Q619 473 672 519L721 519L721 490Q697 473 677 417Q659 366 659 257Q659 177 648 148Q629 98 594 64Q557 27 525 14Q482 -3 438 -3L438 -208Z"},
+	'ω':      {815, 44, -3, 767, 519, "M405 95Q344 0 267 -3Q222 -3 179 14Q147 27 110 64Q78 95 56 148Q44 177 44 249Q44 361 76 424Q105 480 188 519L243 519L243 490Q195 467 162 417Q144 390 144 257Q144 164 171 112Q204 47 267 48Q300 49 323 78Q360 128 360 183L360 359L450 359L450 183Q450 128 488 78Q510 49 543 48Q606 47 640 112Q667 164 667 257Q667 390 648 417Q616 467 568 490L568 519L623 519Q705 480 734 424Q767 361 767 249Q767 177 754 148Q732 95 701 64Q663 27 631 14Q589 -3 543 -3Q467 0 405 95Z"},
+	'ϑ':      {715, 34, -11, 678, 775, "M678 406L678 364L617 364Q614 198 548 96Q479 -11 366 -11Q255 -11 185 96Q105 217 115 467L34 467L34 519L205 519Q211 218 258 119Q300 30 366 30Q438 30 475 119Q508 198 512 354Q398 354 331 390Q237 440 237 586Q237 775 409 771Q499 769 558 664Q614 563 617 406L678 406ZM512 406Q507 586 484 643Q447 731 411 731Q328 731 328 583Q328 488 372 451Q425 406 512 406Z"},
+	'ϕ':      {682, 38, -208, 643, 760, "M385 37Q472 46 500 91Q538 148 538 260Q538 371 501 428Q472 474 385 483L385 37ZM385 -208L295 -208L295 -13Q165 -2 106 61Q38 136 38 260Q38 384 106 458Q164 521 295 532L295 760L385 760L385 532Q516 521 574 458Q643 384 643 260Q643 136 574 61Q516 -2 385 -13L385 -208ZM295 37L295 483Q208 474 180 428Q143 371 143 260Q143 148 180 91Q208 46 295 37Z"},
+	'ϵ':      {560, 50, -14, 514, 533, "M302 34Q417 36 442 156L514 156Q498 86 450 36Q402 -14 301 -14Q182 -14 116 61Q50 136 50 260Q51 388 120 461Q189 533 301 533Q356 533 404 521Q452 510 497 487L497 354L445 354Q438 421 402 453Q366 485 302 485Q158 485 156 295L387 295L387 244L155 244Q160 34 302 34Z"},
+	'′':      {227, 20, 547, 203, 729, "M20 547L104 729L203 729L62 547L20 547Z"},
+	'ℂ':      {796, 42, -14, 745, 742, "M745 208Q716 95 642 41Q567 -14 441 -14Q256 -14 149 88Q42 189 42 364Q42 539 149 640Q256 742 441 742Q506 742 576 727Q646 711 725 679L725 500L663 500Q644 592 594 637Q543 683 461 683Q354 683 303 605Q251 526 251 364Q251 201 303 123Q354 45 462 45Q535 45 581 85Q627 126 648 208L745 208ZM259 646Q221 626 190 598Q101 513 101 364Q101 215 190 130Q220 102 259 83L253 90Q192 184 192 364Q192 544 253 637L259 646Z"},
+	'ℋ':      {988, 36, -14, 943, 748, "M468 739L467 724L415 700L415 417L661 417Q680 519 708 587Q740 662 777 697Q825 748 885 748Q915 748 925 738Q943 718 943 704Q943 683 930 670Q912 654 902 654Q892 654 877 668Q859 687 836 687Q811 687 795 670Q775 651 763 618Q752 589 739 531Q720 426 720 269Q720 188 732 125Q749 63 770 42Q781 31 808 31Q822 31 847 58Q875 84 890 113L908 97Q881 50 867 31Q820 -14 767 -14Q732 -14 704 13Q677 41 663 100Q649 161 649 244Q649 288 655 376L415 376Q415 275 390 198Q364 118 319 73Q234 -12 144 -12Q93 -12 61 20Q36 45 36 75Q36 92 46 103Q57 114 76 114Q102 114 109 106Q118 98 124 82Q134 60 139 55Q158 36 190 36Q234 36 274 75Q308 109 326 192Q344 274 344 398L344 700Q277 700 232 689Q184 677 165 657Q130 621 130 586Q130 571 134 546Q135 538 140 521L144 503Q144 481 135 474Q117 453 101 453Q89 453 75 467Q65 476 61 496Q57 519 57 547Q57 611 118 670Q155 707 210 724Q272 742 344 742Q365 742 406 741Q447 739 468 739Z"},
+	'ℌ':      {754, 1, -128, 693, 731, "M396 -100L447 -32Q466 -71 509 -71Q527 -71 542 -56Q560 -41 573 -14Q585 14 594 75Q601 133 601 220Q601 293 587 339Q574 392 550 414Q516 449 452 449Q412 449 369 415Q326 382 292 323Q315 267 320 222Q327 178 315 152Q216 24 177 -14Q155 14 129 32Q103 49 86 49Q69 49 12 -2L1 15Q93 123 132 123Q174 123 218 77Q258 115 258 158Q258 187 224 249Q179 330 156 389Q132 446 132 478Q132 526 188 595Q241 659 344 731Q405 670 481 667Q495 666 511 679Q514 680 584 729L584 707Q548 671 530 654Q512 638 482 615Q451 593 435 593Q406 593 371 605Q336 616 280 643Q251 607 236 579Q221 548 221 524Q221 482 275 369Q354 451 469 518Q587 518 664 460Q693 357 693 271Q693 101 646 -2Q510 -128 469 -128Q423 -128 396 -100Z"},
+	'ℍ':      {945, 47, 0, 900, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 425L618 425L618 670L525 670L525 729L900 729L900 670L806 670L806 59L900 59L900 0L525 0L525 59L618 59L618 358L328 358L328 59L422 59L422 0L47 0ZM199 670L199 59L269 59L269 670L199 670ZM677 670L677 59L747 59L747 670L677 670Z"},
+	'ℐ':      {470, 29, -15, 432, 742, "M432 736L417 714L366 704L366 328Q366 143 272 48Q208 -15 139 -15Q85 -15 56 14Q29 41 29 74Q29 102 37 109Q49 123 66 123Q80 123 95 105Q99 102 104 75Q109 53 123 40Q132 30 161 30Q198 30 237 68Q268 98 284 163Q299 228 299 320L299 704Q238 704 194 691Q147 677 125 654Q100 630 100 600Q100 586 103 576Q103 574 108 558Q113 542 113 538Q113 519 98 504Q87 495 75 495Q63 495 52 506Q32 525 32 563Q32 625 75 668Q113 704 181 723Q253 742 342 742Q358 742 388 739Q419 736 432 736Z"},
+	'ℑ':      {697, 52, -14, 659, 742, "M52 44L120 125Q174 144 231 144Q259 144 324 104Q394 63 410 63Q469 63 518 129Q553 177 553 213Q553 275 522 330Q483 401 483 454Q483 503 522 581L626 649Q587 582 587 522Q587 470 626 398Q652 350 652 258Q652 212 554 94Q463 -14 345 -14Q318 -14 255 24Q190 63 163 63Q117 63 52 44ZM171 715Q224 742 293 742Q353 742 415 718Q475 695 537 695Q598 695 659 724L562 641Q494 612 439 612Q370 612 317 636Q265 660 195 660Q136 660 73 632L171 715Z"},
+	'ℒ':      {720, 33, -14, 679, 743, "M232 171L358 118Q412 94 456 83Q496 70 525 70Q576 70 618 112Q650 145 650 220Q650 260 646 274L667 281Q679 217 679 210Q679 99 625 45Q566 -14 507 -14Q481 -14 432 3Q389 18 319 53Q191 120 151 120L151 124Q118 124 91 92Q65 61 59 -3L33 8Q33 103 69 146Q82 162 105 179Q124 191 147 219Q174 251 179 438Q182 521 199 585Q215 647 246 685Q294 743 396 743Q452 743 487 713Q519 686 519 633Q519 621 512 613Q492 590 477 590Q456 590 441 604Q435 609 430 638Q426 665 406 683Q381 704 354 704Q317 704 294 675Q277 656 269 604Q260 553 258 471Q256 379 246 322Q235 263 217 241Q190 208 158 185L159 183L167 183Q197 183 232 171Z"},
+	'ℕ':      {914, 44, 0, 874, 729, "M44 0L44 59L137 59L137 670L44 670L44 729L272 729L712 213L712 670L619 670L619 729L874 729L874 670L781 670L781 0L653 0L206 527L206 59L299 59L299 0L44 0ZM196 670L196 630L680 59L722 59L722 110L245 670L196 670Z"},
+	'ℙ':      {752, 47, 0, 727, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L462 729Q583 729 655 671Q727 613 727 516Q727 418 655 360Q583 302 462 302L328 302L328 59L446 59L446 0L47 0ZM328 361L383 361Q446 361 484 403Q522 445 522 516Q522 586 484 628Q447 670 383 670L328 670L328 361ZM269 670L199 670L199 59L269 59L269 670ZM532 664Q581 606 581 516Q581 426 531 367Q583 378 618 406Q668 447 668 516Q668 585 618 625Q583 653 532 664Z"},
+	'ℚ':      {871, 42, -180, 828, 742, "M432 -14Q248 -11 145 88Q42 188 42 364Q42 541 147 641Q251 742 435 742Q619 742 724 642Q828 541 828 364Q828 208 748 112Q667 15 519 -8Q545 -36 582 -48Q619 -60 678 -60L703 -60L703 -180L689 -180Q596 -180 534 -140Q472 -100 432 -14ZM435 45Q528 45 574 124Q619 202 619 364Q619 526 574 604Q528 683 435 683Q342 683 297 604Q251 524 251 364Q251 204 297 124Q342 45 435 45ZM618 645Q678 552 678 364Q678 177 619 83Q642 95 662 110Q682 125 702 150Q723 174 737 203Q751 232 760 274Q769 315 769 364Q769 381 768 396Q767 412 763 436Q760 459 754 480Q748 500 737 525Q725 549 710 569Q694 590 671 610Q647 630 618 645ZM252 84Q192 177 192 364Q192 551 252 645Q224 630 200 610Q176 590 161 570Q146 549 134 525Q123 500 116 480Q110 459 107 436Q103 413 102 397Q101 381 101 364Q101 346 102 330Q103 314 107 291Q110 268 116 247Q123 227 134 203Q146 179 161 158Q176 138 200 118Q223 99 252 84Z"},
+	'ℛ':      {798, 32, -9, 764, 774, "M407 704L407 364Q422 361 452 361Q522 361 567 408Q619 458 619 553Q619 633 578 673Q565 687 520 696Q476 704 407 704ZM550 89L449 327L407 331Q392 237 367 171Q344 104 310 71Q232 -9 142 -9Q88 -9 61 17Q32 48 32 75Q32 96 45 108Q57 120 74 120Q87 120 98 109Q111 97 119 82Q130 63 138 55Q150 42 185 42Q234 42 275 83Q303 111 319 170Q335 231 335 308L335 704Q222 704 159 640Q130 613 130 578Q130 566 134 542Q135 538 139 525Q143 512 143 505Q143 488 129 472Q112 457 99 457Q86 457 72 468Q50 490 50 525Q50 596 101 649Q193 740 335 740Q349 747 378 761L407 774L407 743Q496 743 563 729Q627 714 652 689Q695 646 695 565Q695 472 641 418Q591 367 516 344L560 235Q600 135 660 75Q672 60 694 60Q708 60 723 75Q740 95 749 119L764 107Q747 52 719 23Q688 -6 660 -6Q623 -6 607 9Q567 47 550 89Z"},
+	'ℜ':      {814, 41, -14, 803, 743, "M626 -14Q586 75 586 153Q586 221 575 266Q556 347 517 347Q497 347 452 326L393 298L393 201Q393 101 343 43Q292 -14 224 -14Q155 -14 105 43Q55 101 55 187Q77 181 100 175Q100 115 131 73Q162 31 197 31Q232 31 263 73Q294 116 294 189L294 515Q294 587 263 630Q232 673 188 673Q148 673 116 630Q85 588 85 539Q85 490 116 448Q147 405 188 405L214 360Q141 360 91 417Q41 475 41 551Q41 628 91 685Q141 742 214 742Q293 742 343 685Q357 668 365 652L468 700Q560 743 614 743Q637 743 655 736Q716 712 716 625Q716 583 685 523Q652 462 591 417Q620 417 644 393Q661 375 674 324Q690 264 690 200Q690 138 712 80L782 113L803 68L626 -14ZM383 609Q393 572 393 527L393 350L468 385Q539 417 576 468Q612 518 612 577Q612 651 576 667Q564 671 549 671Q518 671 468 648L383 609Z"},
+	'ℝ':      {831, 47, 0, 837, 729, "M531 356Q574 350 604 327Q634 305 656 262L762 59L837 59L837 0L597 0L481 220Q447 289 424 307Q400 326 358 326L328 326L328 59L422 59L422 0L47 0L47 59L140 59L140 670L47 670L47 729L463 729Q590 729 659 678Q728 627 728 533Q728 457 679 413Q630 369 531 356ZM328 385L392 385Q460 385 497 422Q534 459 534 528Q534 597 497 633Q460 670 392 670L328 670L328 385ZM269 59L269 670L199 670L199 59L269 59ZM695 59L604 235Q587 266 568 280Q551 293 522 297L504 300Q515 283 534 247L633 59L695 59ZM552 661Q563 649 572 628Q595 583 593 528Q593 508 592 494Q590 481 585 461Q580 441 571 423Q616 436 640 457Q669 483 669 533Q669 566 658 590Q647 613 624 630Q596 651 552 661Z"},
+	'ℤ':      {730, 37, 0, 694, 729, "M37 0L37 69L472 662L123 662L123 553L56 553L56 729L689 729L689 660L259 67L627 67L627 169L694 169L694 0L37 0ZM551 670L103 59L180 59L623 670L551 670Z"},
+	'ℨ':      {616, 12, -149, 573, 783, "M194 332L241 399Q302 404 361 463Q413 515 413 583Q413 606 396 623Q372 646 332 646Q320 646 258 640Q185 635 172 635Q127 635 109 652Q98 664 98 680L172 783L189 783Q172 754 189 737Q206 721 270 721Q291 721 332 723Q340 723 358 725Q375 726 383 726Q418 726 440 721Q463 715 475 704Q504 675 504 640Q504 561 440 498Q376 433 323 401L324 399Q359 406 383 406Q506 406 555 332Q573 274 573 211Q573 76 487 -52Q369 -149 224 -149Q167 -149 120 -138Q120 -72 119 -58Q117 -37 109 -29Q98 -17 81 -17Q57 -17 23 -46L12 -29Q48 1 91 22Q131 40 160 40Q198 40 206 22Q211 12 211 -46Q211 -88 224 -100Q238 -115 270 -115Q344 -115 401 -58Q437 -21 456 38Q475 97 475 171Q475 213 466 244Q457 275 440 291Q390 343 304 343Q251 343 206 320L194 332Z"},
+	'ℬ':      {786, 45, 0, 734, 772, "M555 416L559 408Q641 408 688 361Q734 315 734 252Q734 167 675 106Q567 0 405 0L86 0L115 57Q188 57 253 123Q281 151 295 219Q311 284 311 387L311 701Q220 701 157 637Q119 602 119 564Q119 559 125 529Q125 526 128 516Q131 505 131 499Q131 474 122 464Q105 448 84 448Q72 448 63 459Q45 476 45 518Q45 583 100 640Q178 718 307 737L381 772L386 741Q482 741 553 728Q623 712 646 688Q682 652 682 600Q682 524 632 474Q595 438 555 416ZM413 413Q512 413 567 467Q608 507 608 570Q608 627 574 662Q553 682 504 695Q451 707 386 707L386 427Q386 413 413 413ZM249 57L419 56Q485 56 538 69Q589 83 612 106Q655 149 655 240Q655 295 613 337Q591 359 550 371Q512 382 455 382Q418 382 382 379Q367 264 345 210Q302 110 249 57Z"},
+	'ℭ':      {703, 63, -31, 652, 742, "M287 651L321 634Q396 687 543 742Q549 729 559 699Q568 670 574 655Q582 636 590 628Q601 618 652 634L652 611Q583 578 533 571Q504 589 447 680Q427 674 413 657Q401 644 401 616Q401 583 406 549Q408 538 415 513Q422 489 426 470Q430 452 430 434Q430 365 396 331Q339 277 243 262L230 279Q274 290 310 326Q327 343 327 399Q327 424 304 515Q288 571 297 611Q236 611 201 566Q149 500 149 394Q149 234 235 148Q344 39 521 57L646 119L646 91Q542 25 492 -12Q398 -31 308 -10Q218 11 155 74Q109 119 87 189Q63 260 63 348Q63 450 109 542Q203 614 287 651Z"},
+	'ℯ':      {592, 42, -14, 547, 533, "M146 199Q145 181 145 164Q145 118 161 88Q190 34 267 34Q324 34 352 51Q401 82 413 122L479 122Q459 60 387 16Q336 -14 235 -14Q135 -14 81 61Q42 113 42 188Q42 222 50 259Q74 383 156 458Q239 533 356 533Q547 533 547 398Q547 301 447 250Q345 199 146 199ZM397 309Q452 346 452 401Q452 485 341 485Q275 485 230 435Q185 385 157 251Q313 251 397 309Z"},
+	'ℰ':      {605, 79, -14, 565, 742, "M519 161L565 110Q550 78 542 70Q456 -14 333 -14Q263 -14 212 2Q159 21 125 52Q79 98 79 181Q79 266 145 333Q198 386 285 397L286 408Q233 425 203 455Q165 494 165 566Q165 634 213 683Q273 742 391 742Q457 742 498 702Q523 677 523 635Q523 597 501 575Q477 551 460 551Q440 551 429 562Q422 568 422 584Q422 598 436 616Q449 637 449 652Q449 670 431 688Q410 708 360 708Q313 708 281 677Q234 628 234 554Q234 493 273 455Q296 432 340 420Q386 408 447 408L447 381Q363 381 303 367Q245 353 217 325Q164 271 164 196Q164 115 211 68Q255 23 350 23Q413 23 470 81Q500 111 506 157L519 161Z"},
+	'ℱ':      {786, 41, -16, 758, 755, "M741 743L758 734L715 654Q696 618 668 618Q649 618 622 627Q512 657 481 669L481 402L636 402L606 369L481 369L481 320Q481 246 461 182Q440 119 403 83Q306 -16 175 -16Q113 -16 66 30Q41 56 41 88Q41 107 56 123Q69 135 91 135Q106 135 113 130Q122 120 132 97Q142 72 155 59Q187 27 233 27Q286 27 346 87Q376 117 390 166Q406 215 406 278L406 369L230 369L249 402L406 402L406 688Q359 699 329 699Q234 699 181 646Q146 611 146 570Q146 541 149 525L159 490Q159 468 147 456Q130 440 117 440Q99 440 82 456Q66 471 66 505Q66 593 125 651Q206 732 356 732Q374 732 406 729L481 755L481 719Q573 696 643 686Q666 681 677 681Q700 681 716 705L741 743Z"},
+	'ℳ':      {1069, 28, -28, 1032, 751, "M855 639L855 290Q855 183 866 120Q876 57 898 34Q911 23 925 23Q951 23 970 45Q993 66 1012 98L1032 82Q1013 53 979 20Q933 -28 894 -28Q855 -28 827 0Q800 27 792 104Q789 145 789 396Q789 410 787 508Q785 606 788 621Q703 534 644 379Q583 228 557 20L509 -18L500 -11Q471 227 421 380Q394 463 365 517Q335 575 301 609L301 366Q301 250 276 159Q253 72 208 26Q168 -13 119 -13Q82 -13 48 18Q28 41 28 63Q28 81 42 97Q54 108 71 108Q98 108 112 80Q125 49 157 49Q178 49 204 75Q230 101 246 160Q260 217 260 294L260 649Q225 692 174 692Q139 692 109 663Q80 634 70 573L47 571Q55 621 66 652Q80 686 98 705Q144 751 203 751Q273 751 324 697L328 697Q408 617 463 489Q518 358 542 194L548 189Q589 349 650 468Q712 594 789 668Q863 742 950 742Q982 742 1001 723Q1015 709 1015 695Q1015 676 1006 668Q992 651 970 651Q953 651 949 655Q938 666 935 672Q929 687 927 688Q915 700 902 700Q888 700 875 689Q855 667 855 639Z"},
+	'ℴ':      {462, 51, -12, 411, 395, "M333 246Q333 302 311 333Q290 363 251 363Q198 363 164 300Q129 237 129 136Q129 80 150 50Q171 20 210 20Q264 20 298 83Q333 146 333 246ZM238 395Q312 395 362 342Q411 290 411 210Q411 113 357 51Q303 -12 224 -12Q150 -12 100 41Q51 93 51 173Q51 270 105 332Q159 395 238 395Z"},
+	'ℵ':      {745, 50, -14, 712, 742, "M471 352L664 159Q712 110 712 86Q712 23 642 -14Q642 26 617 51L240 428L164 352Q134 321 166 289L250 205Q299 156 299 104Q299 52 269 30Q227 0 193 0L74 0L74 27Q169 58 169 104Q169 118 146 141L99 189Q73 215 73 261Q73 307 141 376L216 452L99 569Q50 618 50 642Q50 705 121 742Q121 702 145 677L448 375L585 513L528 569Q480 618 480 642Q480 705 550 742Q550 702 575 677L664 588Q712 540 712 516Q712 453 642 416Q642 456 617 480L608 489L471 352Z"},
+	'←':      {838, 37, 119, 781, 527, "M781 363L781 284L152 284L246 119L241 119L37 321L37 326L241 527L246 527L152 363L781 363Z"},
+	'↑':      {838, 215, 0, 623, 744, "M379 0L379 629L215 535L215 540L417 744L421 744L623 540L623 535L458 629L458 0L379 0Z"},
+	'→':      {838, 57, 119, 801, 527, "M57 363L686 363L592 527L597 527L801 326L801 321L597 119L592 119L686 284L57 284L57 363Z"},
+	'↓':      {838, 215, -20, 623, 724, "M458 724L458 95L623 189L623 184L421 -20L417 -20L215 184L215 189L379 95L379 724L458 724Z"},
+	'↔':      {838, 37, 119, 801, 527, "M686 363L592 527L597 527L801 326L801 321L597 119L592 119L686 284L152 284L246 119L241 119L37 321L37 326L241 527L246 527L152 363L686 363Z"},
+	'↠':      {838, 57, 119, 801, 527, "M57 284L57 363L564 363L470 527L475 527L641 363L686 363L592 527L597 527L801 326L801 321L597 119L592 119L686 284L641 284L475 119L470 119L564 284L57 284Z"},
+	'↣':      {838, 54, 119, 801, 527, "M275 284L109 119L54 175L201 321L201 326L54 471L109 527L275 363L686 363L592 527L597 527L801 326L801 321L597 119L592 119L686 284L275 284Z"},
+	'↦':      {838, 57, 119, 801, 527, "M57 131L57 515L136 515L136 363L686 363L592 527L597 527L801 326L801 321L597 119L592 119L686 284L136 284L136 131L57 131Z"},
+	'⇐':      {838, 37, 119, 781, 527, "M246 119L241 119L37 321L37 326L241 527L246 527L203 448L781 448L781 369L159 369L134 323L159 277L781 277L781 198L203 198L246 119Z"},
+	'⇒':      {838, 57, 119, 801, 527, "M592 527L597 527L801 326L801 321L597 119L592 119L635 198L57 198L57 277L679 277L704 323L679 369L57 369L57 448L635 448L592 527Z"},
+	'⇔':      {838, 37, 119, 801, 527, "M592 527L597 527L801 326L801 321L597 119L592 119L635 198L203 198L246 119L241 119L37 321L37 326L241 527L246 527L203 448L635 448L592 527ZM159 277L679 277L704 323L679 369L159 369L134 323L159 277Z"},
+	'∀':      {604, 4, 0, 599, 729, "M396 399L208 399L302 142L396 399ZM270 0L4 729L88 729L179 478L424 478L516 729L599 729L334 0L270 0Z"},
+	'∂':      {517, 51, -12, 469, 647, "M369 303Q376 346 380 385Q384 425 384 462Q384 525 366 557Q348 589 313 589Q286 589 251 561Q216 532 198 532Q181 532 169 543Q157 554 157 569Q157 599 192 623Q228 647 277 647Q365 647 417 571Q469 496 469 367Q469 204 399 96Q328 -12 224 -12Q150 -12 100 41Q51 93 51 173Q51 270 105 332Q159 395 238 395Q287 395 319 373Q351 351 369 303ZM345 241Q345 298 324 328Q303 359 264 359Q211 359 177 296Q142 233 142 132Q142 75 163 45Q184 15 223 15Q276 15 311 79Q345 142 345 241Z"},
+	'∃':      {542, 61, 0, 464, 729, "M464 729L464 0L61 0L61 79L385 79L385 325L61 325L61 404L385 404L385 650L61 650L61 729L464 729Z"},
+	'∅':      {871, 76, -10, 795, 710, "M629 654L672 708L724 708L662 631Q713 590 747 531Q795 444 795 350Q795 256 747 170Q698 83 612 37Q526 -10 436 -10Q344 -10 258 37Q231 51 208 70L146 -6L95 -6L178 97Q147 129 124 170Q76 256 76 350Q76 444 124 531Q172 617 258 664Q344 710 436 710Q526 710 612 664Q621 659 629 654ZM219 147L588 603Q588 603 581 607Q510 645 436 645Q360 645 289 607Q218 568 178 497Q139 426 138 350Q139 274 178 204Q196 172 219 147ZM621 581L249 121Q268 105 290 94Q360 55 436 55Q510 55 581 94Q652 132 692 204Q732 274 733 350Q732 426 692 497Q664 547 621 581Z"},
+	'∇':      {698, 25, 0, 667, 729, "M525 649L116 649L321 117L525 649ZM25 729L667 729L388 0L304 0L25 729Z"},
+	'∈':      {740, 106, 0, 634, 627, "M419 78L634 78L634 0L419 0Q334 0 262 42Q190 84 148 156Q106 229 106 313Q106 398 148 471Q190 543 262 585Q334 627 419 627L634 627L634 549L419 549Q356 549 302 517Q248 485 216 431Q194 394 188 353L634 353L634 274L188 274Q194 233 216 196Q248 142 302 110Q356 78 419 78Z"},
+	'∉':      {740, 106, -132, 634, 759, "M419 78L634 78L634 0L419 0Q371 0 327 14L273 -132L199 -105L254 47Q188 88 148 156Q106 229 106 313Q106 398 148 471Q190 543 262 585Q334 627 419 627L465 627L514 759L588 732L550 627L634 627L634 549L521 549L450 353L634 353L634 274L421 274L354 87Q385 78 419 78ZM282 123L337 274L188 274Q194 233 216 196Q242 152 282 123ZM366 353L437 549L419 549Q356 549 302 517Q248 485 216 431Q194 394 188 353L366 353Z"},
+	'∏':      {796, 26, -192, 769, 719, "M26 719L769 719L769 683L676 683L676 -156L769 -156L769 -192L478 -192L478 -156L572 -156L572 683L223 683L223 -156L317 -156L317 -192L26 -192L26 -156L119 -156L119 683L26 683L26 719Z"},
+	'∑':      {714, 8, -192, 701, 719, "M8 719L575 719L629 541L591 541L555 652Q550 668 535 676Q520 683 487 683L150 683L545 277L545 259L142 -100L574 -100Q605 -100 614 -94Q623 -87 628 -69L663 43L701 43L629 -192L8 -192L8 -172L457 226L8 693L8 719Z"},
+	'−':      {838, 106, 274, 732, 353, "M106 353L732 353L732 274L106 274L106 353Z"},
+	'∗':      {680, 106, 85, 574, 541, "M574 402L383 313L574 223L537 166L367 271L373 85L307 85L313 271L143 166L106 223L297 313L106 402L143 460L313 354L307 541L373 541L367 354L537 460L574 402Z"},
+	'∘':      {490, 106, 175, 384, 454, "M316 313Q316 343 295 365Q274 386 245 386Q215 386 194 365Q174 344 174 314Q174 284 194 263Q215 243 245 243Q275 243 296 264Q316 284 316 313ZM344 413Q384 373 384 314Q384 255 344 215Q303 175 244 175Q185 175 146 215Q106 254 106 313Q106 373 146 413Q187 454 246 454Q305 454 344 413Z"},
+	'∝':      {677, 108, 108, 569, 491, "M387 325Q363 368 331 390Q298 411 257 411Q208 411 176 379Q145 346 145 295Q145 246 174 213Q203 180 246 180Q286 180 318 212Q350 245 387 325ZM569 110Q533 110 497 138Q461 167 422 236Q387 169 349 139Q312 108 265 108Q199 108 154 163Q108 217 108 298Q108 383 149 436Q189 489 255 489Q301 489 338 459Q375 429 411 360Q444 427 483 459Q521 491 569 491L569 418Q548 418 516 385Q484 353 447 273Q471 229 504 208Q536 186 569 186L569 110Z"},
+	'∞':      {833, 108, 108, 726, 491, "M447 273Q471 229 504 208Q536 186 577 186Q626 186 658 219Q689 252 689 303Q689 353 660 385Q631 418 588 418Q548 418 516 385Q484 353 447 273ZM387 325Q363 368 331 390Q298 411 257 411Q208 411 176 379Q145 346 145 295Q145 246 174 213Q203 180 246 180Q286 180 318 212Q350 245 387 325ZM422 236Q387 169 349 139Q312 108 265 108Q199 108 154 163Q108 217 108 298Q108 383 149 436Q189 489 255 489Q301 489 338 459Q375 429 411 360Q444 427 483 459Q521 491 569 491Q634 491 680 436Q726 381 726 300Q726 215 685 162Q645 110 579 110Q533 110 497 138Q461 167 422 236Z"},
+	'∠':      {838, 138, 99, 700, 661, "M700 99L138 99L611 661L690 661L284 178L700 178L700 99Z"},
+	'∣':      {291, 106, -98, 185, 827, "M185 827L185 -98L106 -98L106 827L185 827Z"},
+	'∧':      {732, 129, 0, 603, 584, "M129 0L305 584L427 584L603 0L508 0L366 464L224 0L129 0Z"},
+	'∨':      {732, 129, 0, 603, 584, "M129 584L224 584L366 120L508 584L603 584L427 0L305 0L129 584Z"},
+	'∩':      {838, 105, 0, 732, 627, "M184 313L184 0L105 0L105 313Q105 398 147 471Q189 543 262 585Q334 627 419 627Q504 627 576 585Q648 543 690 471Q732 398 732 313L732 0L654 0L654 313Q654 377 623 431Q591 485 537 517Q482 549 419 549Q355 549 301 517Q247 485 215 431Q184 377 184 313Z"},
+	'∪':      {838, 105, -12, 732, 615, "M654 301L654 615L732 615L732 301Q732 216 690 144Q648 72 576 30Q504 -12 419 -12Q334 -12 262 30Q189 72 147 144Q105 216 105 301L105 615L184 615L184 301Q184 238 215 184Q247 129 301 98Q355 66 419 66Q482 66 537 98Q591 129 623 184Q654 238 654 301Z"},
+	'∫':      {521, 23, -181, 497, 757, "M152 -128Q204 -128 210 208Q211 261 212 289Q218 540 264 648Q311 757 409 757Q448 757 473 736Q497 716 497 684Q497 658 481 642Q466 627 439 627Q418 627 405 636Q391 646 385 663Q382 670 380 688Q378 705 370 705Q318 705 311 403Q309 309 307 255Q299 21 253 -80Q207 -181 111 -181Q72 -181 48 -161Q23 -141 23 -109Q23 -82 40 -66Q56 -51 86 -51Q102 -51 114 -58Q125 -65 133 -79Q138 -87 140 -108Q143 -128 152 -128Z"},
+	'∮':      {521, 57, -212, 464, 757, "M236 610Q240 692 280 725Q319 757 355 757Q393 757 427 723Q454 696 464 627L392 620Q383 684 355 684Q321 684 317 598L310 453Q358 441 391 404Q444 344 444 272Q444 196 391 142Q349 101 292 91L285 -66Q281 -147 241 -181Q202 -212 166 -212Q128 -212 94 -178Q67 -151 57 -83L129 -76Q138 -139 166 -139Q200 -139 204 -54L211 95Q166 107 130 143Q77 197 77 272Q77 349 131 405Q171 447 229 456L236 610ZM296 157Q326 165 347 188Q381 223 381 273Q381 325 346 359Q328 377 307 386L296 157ZM214 161L225 391Q195 382 174 360Q139 324 139 273Q139 223 175 188Q193 170 214 161Z"},
+	'∴':      {636, 59, 100, 577, 604, "M59 224L162 224L162 100L59 100L59 224ZM474 224L577 224L577 100L474 100L474 224ZM267 604L370 604L370 480L267 480L267 604Z"},
+	'∵':      {636, 59, 100, 577, 604, "M267 224L370 224L370 100L267 100L267 224ZM474 604L577 604L577 480L474 480L474 604ZM59 604L162 604L162 480L59 480L59 604Z"},
+	'≅':      {838, 106, 90, 732, 594, "M732 594Q732 594 732 507Q681 469 637 452Q593 435 546 435Q492 435 421 464L413 467Q410 468 402 471Q327 501 281 501Q238 501 196 482Q154 464 106 423L106 510Q157 548 201 565Q245 582 292 582Q346 582 417 553L425 550L436 547Q511 516 557 516Q599 516 640 534Q682 553 732 594ZM106 355Q106 355 732 355L732 272L106 272L106 355ZM106 173Q106 173 732 173L732 90L106 90L106 173Z"},
+	'≈':      {838, 106, 138, 732, 489, "M732 299L732 227Q684 183 639 163Q595 142 550 142Q505 142 424 173Q417 176 413 177Q408 179 397 183Q325 211 279 211Q234 211 192 193Q149 175 106 138L106 210Q155 254 199 274Q243 294 288 294Q335 294 420 262L425 260Q430 258 440 254Q511 226 558 226Q603 226 646 244Q689 262 732 299ZM732 489L732 417Q684 374 640 353Q596 333 550 333Q503 333 418 365L413 367Q408 369 397 373Q325 401 279 401Q234 401 192 383Q149 365 106 328L106 400Q155 444 199 464Q243 484 288 484Q335 484 420 452L425 450Q430 448 440 444Q511 416 558 416Q603 416 646 434Q689 452 732 489Z"},
+	'≠':      {838, 106, 19, 732, 608, "M106 452L483 452L608 608L669 558L584 452L732 452L732 374L517 374L422 253L732 253L732 175L354 175L228 19L167 69L252 175L106 175L106 253L320 253L415 374L106 374L106 452Z"},
+	'≡':      {838, 106, 93, 732, 534, "M106 353L732 353L732 274L106 274L106 353ZM106 534L732 534L732 456L106 456L106 534ZM106 171L732 171L732 93L106 93L106 171Z"},
+	'≤':      {838, 106, 0, 732, 582, "M732 501L217 361L732 221L732 140L106 320L106 403L732 582L732 501ZM106 76L732 76L732 0L106 0L106 76Z"},
+	'≥':      {838, 106, 0, 732, 582, "M732 76L732 0L106 0L106 76L732 76ZM106 501L106 582L732 403L732 320L106 140L106 221L621 361L106 501Z"},
+	'≺':      {838, 106, -38, 732, 664, "M732 547Q538 360 281 313Q536 267 732 79L732 -38Q536 212 106 272L106 354Q537 414 732 664L732 547Z"},
+	'≻':      {838, 106, -38, 732, 664, "M106 547L106 664Q301 414 732 354L732 272Q302 212 106 -38L106 79Q302 267 557 313Q300 360 106 547Z"},
+	'⊂':      {838, 106, 0, 732, 627, "M419 78L732 78L732 0L419 0Q334 0 262 42Q190 84 148 156Q106 229 106 313Q106 398 148 471Q190 543 262 585Q334 627 419 627L732 627L732 549L419 549Q356 549 302 517Q248 485 216 431Q184 377 184 313Q184 250 216 196Q248 142 302 110Q356 78 419 78Z"},
+	'⊃':      {838, 106, 0, 732, 627, "M418 549L106 549L106 627L418 627Q503 627 576 585Q648 543 690 471Q732 398 732 313Q732 229 690 156Q648 84 576 42Q503 0 418 0L106 0L106 78L418 78Q482 78 536 110Q590 142 622 196Q654 250 654 313Q654 377 622 431Q590 485 536 517Q482 549 418 549Z"},
+	'⊆':      {838, 106, -73, 732, 700, "M106 6L732 6L732 -73L106 -73L106 6ZM419 151L732 151L732 73L419 73Q334 73 262 115Q190 157 148 229Q106 302 106 387Q106 472 148 544Q190 616 262 658Q334 700 419 700L732 700L732 622L419 622Q356 622 302 590Q248 559 216 504Q184 450 184 387Q184 323 216 269Q248 215 302 183Q356 151 419 151Z"},
+	'⊇':      {838, 106, -73, 732, 700, "M106 6L732 6L732 -73L106 -73L106 6ZM418 622L106 622L106 700L418 700Q503 700 576 658Q648 616 690 544Q732 472 732 387Q732 302 690 229Q648 157 576 115Q503 73 418 73L106 73L106 151L418 151Q482 151 536 183Q590 215 622 269Q654 323 654 387Q654 450 622 504Q590 559 536 590Q482 622 418 622Z"},
+	'⊕':      {838, 105, 0, 732, 627, "M105 313Q105 398 147 471Q189 543 262 585Q334 627 419 627Q504 627 576 585Q648 543 690 471Q732 398 732 313Q732 229 690 156Q648 84 576 42Q504 0 419 0Q334 0 262 42Q189 84 147 156Q105 229 105 313ZM187 353L380 353L380 546Q338 539 301 517Q247 485 215 431Q194 394 187 353ZM458 546L458 353L651 353Q644 394 623 431Q591 485 537 517Q500 539 458 546ZM651 274L458 274L458 81Q500 88 537 110Q591 142 623 196Q644 233 651 274ZM380 81L380 274L187 274Q194 233 215 196Q247 142 301 110Q338 88 380 81Z"},
+	'⊗':      {838, 105, 0, 732, 627, "M105 313Q105 398 147 471Q189 543 262 585Q334 627 419 627Q504 627 576 585Q648 543 690 471Q732 398 732 313Q732 229 690 156Q648 84 576 42Q504 0 419 0Q334 0 262 42Q189 84 147 156Q105 229 105 313ZM215 196Q221 186 228 177L363 313L227 450Q221 440 215 431Q184 377 184 313Q184 250 215 196ZM283 505L419 369L555 505Q546 512 537 517Q482 549 419 549Q355 549 301 517Q292 512 283 505ZM611 450L474 313L610 177Q617 186 623 196Q654 250 654 313Q654 377 623 431Q617 441 611 450ZM555 122L419 258L283 122Q292 116 301 110Q355 78 419 78Q482 78 537 110Q546 116 555 122Z"},
+	'⊙':      {838, 105, 0, 732, 627, "M354 313Q354 341 372 360Q391 378 419 378Q446 378 465 359Q484 340 484 313Q484 286 465 267Q446 249 419 249Q391 249 372 267Q354 286 354 313ZM184 313Q184 250 215 196Q247 142 301 110Q355 78 419 78Q482 78 537 110Q591 142 623 196Q654 250 654 313Q654 377 623 431Q591 485 537 517Q482 549 419 549Q355 549 301 517Q247 485 215 431Q184 377 184 313ZM105 313Q105 398 147 471Q189 543 262 585Q334 627 419 627Q504 627 576 585Q648 543 690 471Q732 398 732 313Q732 229 690 156Q648 84 576 42Q504 0 419 0Q334 0 262 42Q189 84 147 156Q105 229 105 313Z"},
+	'⊢':      {860, 106, 0, 754, 729, "M106 729L185 729L185 404L754 404L754 325L185 325L185 0L106 0L106 729Z"},
+	'⊤':      {940, 106, 0, 834, 688, "M106 688L834 688L834 609L510 609L510 0L431 0L431 609L106 609L106 688Z"},
+	'⊥':      {940, 106, 0, 834, 688, "M106 0L106 79L431 79L431 688L510 688L510 79L834 79L834 0L106 0Z"},
+	'⊨':      {860, 106, 0, 754, 729, "M106 729L185 729L185 490L754 490L754 411L185 411L185 319L754 319L754 240L185 240L185 0L106 0L106 729Z"},
+	'⋀':      {820, -3, -192, 823, 719, "M464 719L823 -192L724 -192L410 616L96 -192L-3 -192L355 719L464 719Z"},
+	'⋁':      {820, -3, -192, 823, 719, "M356 -192L-3 719L96 719L410 -89L724 719L823 719L465 -192L356 -192Z"},
+	'⋂':      {820, 68, -192, 752, 719, "M410 639Q303 639 228 584Q153 528 153 405L153 -192L68 -192L68 417Q68 575 177 647Q286 719 410 719Q535 719 643 647Q752 575 752 417L752 -192L667 -192L667 405Q667 527 593 583Q519 639 410 639Z"},
+	'⋃':      {820, 68, -192, 752, 719, "M410 -112Q517 -112 592 -56Q667 -1 667 122L667 719L752 719L752 110Q752 -47 643 -120Q535 -192 410 -192Q286 -192 177 -120Q68 -47 68 110L68 719L153 719L153 122Q153 0 227 -56Q302 -112 410 -112Z"},
+	'⋄':      {626, 63, 63, 563, 563, "M181 313L313 181L445 313L313 445L181 313ZM63 313L313 563L563 313L313 63L63 313Z"},
+	'⋅':      {342, 106, 282, 236, 412, "M106 347Q106 375 125 393Q144 412 171 412Q198 412 217 393Q236 374 236 347Q236 320 217 301Q198 282 171 282Q144 282 125 301Q106 319 106 347Z"},
+	'⋆':      {626, 122, 149, 504, 512, "M122 374L268 374L313 512L359 374L504 374L386 288L432 149L313 235L194 149L240 288L122 374Z"},
+	'⋈':      {1000, 106, -30, 894, 657, "M106 -30L106 657L500 368L894 657L894 -30L500 258L106 -30ZM424 313L189 484L189 142L424 313ZM576 313L811 142L811 489L576 313Z"},
+	'⋉':      {1000, 106, -30, 894, 657, "M894 79L894 -30L500 258L106 -30L106 657L500 368L894 657L894 549L576 313L894 79ZM424 313L189 484L189 142L424 313Z"},
+	'⋊':      {1000, 106, -30, 894, 657, "M106 548L106 657L500 369L894 657L894 -30L500 259L106 -30L106 78L424 314L106 548ZM576 314L811 143L811 485L576 314Z"},
+	'⋮':      {1000, 448, -93, 551, 715, "M448 31L551 31L551 -93L448 -93L448 31ZM448 715L551 715L551 591L448 591L448 715ZM448 373L551 373L551 249L448 249L448 373Z"},
+	'⋯':      {1000, 115, 249, 884, 373, "M115 373L218 373L218 249L115 249L115 373ZM448 373L551 373L551 249L448 249L448 373ZM781 373L884 373L884 249L781 249L781 373Z"},
+	'⋱':      {1000, 115, -93, 884, 715, "M781 31L884 31L884 -93L781 -93L781 31ZM115 715L218 715L218 591L115 591L115 715ZM448 373L551 373L551 249L448 249L448 373Z"},
+	'⌈':      {390, 86, -132, 313, 760, "M86 760L313 760L313 708L179 708L179 -132L86 -132L86 760Z"},
+	'⌉':      {390, 77, -132, 304, 760, "M304 760L304 -132L211 -132L211 708L77 708L77 760L304 760Z"},
+	'⌊':      {390, 86, -132, 313, 760, "M86 -132L86 760L179 760L179 -80L313 -80L313 -132L86 -132Z"},
+	'⌋':      {390, 77, -132, 304, 760, "M304 -132L77 -132L77 -80L211 -80L211 760L304 760L304 -132Z"},
+	'□':      {945, 91, -123, 854, 643, "M146 -67L798 -67L798 587L146 587L146 -67ZM91 -123L91 643L854 643L854 -123L91 -123Z"},
+	'△':      {769, 3, -123, 766, 643, "M86 -67L683 -67L384 531L86 -67ZM3 -123L384 643L766 -123L3 -123Z"},
+	'⟨':      {390, 89, -132, 310, 759, "M89 313L227 759L310 759L172 313L310 -132L227 -132L89 313Z"},
+	'⟩':      {390, 80, -132, 301, 759, "M301 313L163 -132L80 -132L218 313L80 759L163 759L301 313Z"},
+	'⤖':      {838, 54, 119, 801, 527, "M564 363L470 527L475 527L649 363L686 363L592 527L597 527L801 326L801 321L597 119L592 119L686 284L649 284L475 119L470 119L564 284L275 284L109 119L54 175L201 321L201 326L54 471L109 527L275 363L564 363Z"},
+	'⪯':      {838, 106, -24, 732, 667, "M106 59L732 59L732 -24L106 -24L106 59ZM732 563Q545 424 313 379Q545 333 732 195L732 90Q545 274 106 338L106 419Q545 480 732 667L732 563Z"},
+	'⪰':      {838, 106, -24, 732, 667, "M732 59L732 -24L106 -24L106 59L732 59ZM106 563L106 667Q293 480 732 419Q732 419 732 338Q293 274 106 90Q106 90 106 195Q293 333 525 379Q293 424 106 563Z"},
+	'𝔸':      {776, -8, 0, 787, 729, "M-8 0L-8 59L52 59L326 729L443 729L718 59L787 59L787 0L446 0L446 59L517 59L457 208L183 208L122 59L209 59L209 0L-8 0ZM207 267L433 267L321 546L207 267ZM350 631L581 59L654 59L403 670L366 670L350 631Z"},
+	'𝔹':      {845, 47, 0, 790, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L479 729Q612 729 679 684Q746 639 746 549Q746 484 700 446Q654 408 563 398Q673 388 731 339Q790 291 790 211Q790 103 708 51Q626 0 452 0L47 0ZM328 425L392 425Q476 425 517 455Q557 486 557 549Q557 612 518 641Q479 670 392 670L328 670L328 425ZM328 59L398 59Q491 59 536 96Q581 133 581 211Q581 289 536 328Q490 366 398 366L328 366L328 59ZM269 59L269 670L199 670L199 59L269 59ZM609 331Q642 280 640 211Q639 178 635 156Q631 134 619 108Q609 88 595 71Q646 82 677 102Q688 108 697 116Q705 125 713 137Q722 150 726 169Q731 188 731 211Q731 263 694 294Q663 319 609 331ZM598 463Q640 473 662 491Q687 512 687 549Q687 608 646 635Q622 651 582 660Q617 616 616 549Q616 531 614 517Q610 488 598 463Z"},
+	'𝔻':      {867, 47, 0, 825, 729, "M328 59L396 59Q510 59 563 132Q616 206 616 365Q616 524 563 597Q511 670 396 670L328 670L328 59ZM47 0L47 59L140 59L140 670L47 670L47 729L421 729Q617 729 721 635Q825 542 825 365Q825 188 721 94Q617 0 421 0L47 0ZM269 59L269 670L199 670L199 59L269 59ZM606 638L611 631Q675 543 675 365Q675 187 611 98L606 91Q649 109 681 138Q766 214 766 365Q766 515 682 591Q649 620 606 638Z"},
+	'𝔼':      {762, 47, 0, 711, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L702 729L702 557L635 557L635 662L328 662L328 428L519 428L519 521L586 521L586 269L519 269L519 362L328 362L328 67L644 67L644 172L711 172L711 0L47 0ZM199 670L199 59L269 59L269 670L199 670Z"},
+	'𝔽':      {710, 47, 0, 697, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L697 729L697 557L630 557L630 662L329 662L329 428L514 428L514 521L581 521L581 269L514 269L514 362L329 362L329 59L444 59L444 0L47 0ZM199 670L199 59L270 59L270 670L199 670Z"},
+	'𝔾':      {854, 42, -14, 775, 742, "M688 500Q666 597 615 640Q563 683 470 683Q357 683 304 606Q251 529 251 364Q251 200 302 122Q353 45 460 45Q501 45 536 54Q571 63 600 82L600 282L512 282L512 341L775 341L775 61Q693 23 611 4Q529 -14 444 -14Q256 -14 149 87Q42 188 42 364Q42 540 149 641Q256 742 444 742Q522 742 598 727Q673 711 750 679L750 500L688 500ZM659 282L659 82L659 79Q688 88 716 100L716 282L659 282ZM257 83L252 90Q192 182 192 364Q192 548 255 640L260 646Q221 627 190 598Q101 515 101 364Q101 213 190 130Q220 102 257 83Z"},
+	'𝕀':      {468, 47, 0, 422, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 59L422 59L422 0L47 0ZM199 670L199 59L269 59L269 670L199 670Z"},
+	'𝕁':      {473, -73, -208, 435, 729, "M-73 -175L-73 -60L-10 -60Q-7 -106 12 -127Q31 -149 68 -149Q115 -149 134 -113Q153 -76 153 24L153 670L47 670L47 729L435 729L435 670L342 670L342 20Q342 -99 282 -153Q222 -208 92 -208Q51 -208 10 -200Q-31 -191 -73 -175ZM212 670L212 24Q212 -88 188 -138Q222 -128 242 -110Q283 -73 283 20L283 670L212 670Z"},
+	'𝕂':      {869, 47, 0, 900, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 404L637 670L559 670L559 729L831 729L831 670L734 670L472 445L828 59L900 59L900 0L653 0L328 354L328 59L422 59L422 0L47 0ZM199 670L199 59L269 59L269 670L199 670ZM425 409L387 377L679 59L748 59L425 409Z"},
+	'𝕃':      {703, 47, 0, 681, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 67L615 67L615 182L681 182L681 0L47 0ZM199 670L199 59L269 59L269 670L199 670Z"},
+	'𝕄':      {1107, 42, 0, 1060, 729, "M42 0L42 59L135 59L135 670L42 670L42 729L343 729L551 261L760 729L1060 729L1060 670L967 670L967 59L1060 59L1060 0L685 0L685 59L778 59L778 615L552 107L428 107L203 615L203 59L296 59L296 0L42 0ZM908 670L837 670L837 59L908 59L908 670ZM521 183L304 670L244 670L467 166L513 166L521 183Z"},
+	'𝕆':      {871, 42, -14, 828, 742, "M435 45Q528 45 574 124Q619 202 619 364Q619 526 574 604Q528 683 435 683Q342 683 297 604Q251 524 251 364Q251 204 297 124Q342 45 435 45ZM435 -14Q251 -14 146 86Q42 187 42 364Q42 541 147 641Q251 742 435 742Q619 742 724 642Q828 541 828 364Q828 187 723 86Q619 -14 435 -14ZM618 645L618 645ZM252 645L252 645ZM253 83Q192 176 192 364Q192 551 252 645Q224 630 200 610Q176 590 161 570Q146 549 134 525Q123 500 116 480Q110 459 107 436Q103 413 102 397Q101 381 101 364Q101 347 102 331Q103 314 107 291Q110 268 116 247Q123 227 134 202Q146 178 161 158Q177 137 200 117Q224 98 253 83ZM619 83Q647 99 671 119Q694 139 710 159Q725 179 737 203Q748 228 754 248Q760 269 763 292Q767 315 768 331Q769 347 769 364Q769 381 768 396Q767 412 763 436Q760 459 754 480Q748 500 737 525Q725 549 710 569Q694 590 671 610Q647 630 618 645Q619 645 626 633Q633 621 641 601Q649 582 658 551Q667 521 673 472Q678 422 678 364Q678 176 619 83ZM253 83L253 83ZM619 83L619 83Z"},
+	'𝕊':      {722, 66, -14, 662, 742, "M77 35L77 208L139 208Q153 126 206 85Q259 45 352 45Q428 45 467 74Q507 103 507 158Q507 202 480 227Q453 251 369 272L260 299Q153 326 109 375Q66 423 66 513Q66 622 138 682Q210 742 340 742Q404 742 473 731Q542 721 616 699L616 538L554 538Q540 613 492 648Q444 683 356 683Q284 683 247 658Q210 633 210 583Q210 538 235 514Q261 490 360 465L469 438Q571 413 616 359Q662 305 662 211Q662 101 586 43Q510 -14 362 -14Q291 -14 220 -2Q150 10 77 35ZM153 613Q125 574 125 513Q125 446 153 414Q185 379 274 356L383 329Q480 305 520 271Q566 229 566 158Q566 123 555 94Q603 133 603 211Q603 284 571 321Q538 360 455 381L346 408Q317 415 293 422Q270 430 255 436Q241 442 229 448Q217 454 211 458Q206 461 201 465Q196 469 195 471Q151 509 151 583Q151 599 153 613Z"},
+	'𝕋':      {744, 11, 0, 734, 729, "M181 0L181 59L278 59L278 662L78 662L78 547L11 547L11 729L734 729L734 547L668 547L668 662L467 662L467 59L564 59L564 0L181 0ZM337 670L337 59L408 59L408 670L337 670Z"},
+	'𝕌':      {872, 34, -14, 850, 729, "M127 670L34 670L34 729L409 729L409 670L316 670L316 305Q316 169 358 115Q401 60 504 60Q604 60 647 115Q689 170 689 305L689 670L596 670L596 729L850 729L850 670L757 670L757 295Q757 128 685 57Q612 -14 442 -14Q272 -14 200 58Q127 129 127 296L127 670ZM257 670L186 670L186 296Q186 154 241 100Q272 69 333 55Q321 66 312 77Q303 89 292 110Q281 131 274 156Q267 182 262 220Q257 259 257 305L257 670Z"},
+	'𝕍':      {776, -6, 0, 790, 729, "M790 729L790 670L729 670L454 0L338 0L63 670L-6 670L-6 729L336 729L336 670L265 670L462 187L660 670L573 670L573 729L790 729ZM127 670L377 59L415 59L433 103L201 670L127 670Z"},
+	'𝕎':      {1123, -9, 0, 1135, 729, "M852 0L726 0L561 531L396 0L270 0L62 670L-9 670L-9 729L337 729L337 670L259 670L398 222L555 729L694 729L854 214L996 670L909 670L909 729L1135 729L1135 670L1060 670L852 0ZM124 670L313 59L353 59L370 114L197 670L124 670ZM589 639L770 59L809 59L824 110L650 670L599 670L589 639Z"},
+	'𝕏':      {776, 5, 0, 775, 729, "M339 281L187 59L277 59L277 0L18 0L18 59L108 59L300 338L72 670L5 670L5 729L367 729L367 670L287 670L428 465L568 670L483 670L483 729L737 729L737 670L647 670L467 408L706 59L775 59L775 0L411 0L411 59L492 59L339 281ZM144 670L564 59L634 59L215 670L144 670Z"},
+	'𝕐':      {714, -9, 0, 723, 729, "M172 0L172 59L270 59L270 302L53 670L-9 670L-9 729L341 729L341 670L260 670L424 392L587 670L514 670L514 729L723 729L723 670L661 670L459 327L459 59L557 59L557 0L172 0ZM122 670L329 318L329 59L400 59L400 316L191 670L122 670Z"},
+	'𝕒':      {648, 41, -14, 625, 533, "M551 319L551 59L625 59L625 0L378 0L378 66Q344 25 302 5Q260 -14 206 -14Q126 -14 84 29Q41 71 41 151Q41 238 102 282Q164 325 287 325L378 325L378 356Q378 419 348 449Q318 479 256 479Q204 479 176 458Q148 437 136 388L81 388L81 500Q127 517 177 525Q227 533 282 533Q421 533 486 481Q551 430 551 319ZM378 163L378 267L313 267Q265 267 239 241Q213 214 213 165Q213 116 232 91Q250 67 289 67Q329 67 354 93Q378 120 378 163ZM437 356L437 59L492 59L492 319Q492 359 482 387Q472 415 457 429Q441 444 417 454Q429 429 433 406Q437 383 437 356ZM193 45Q154 87 154 165Q154 215 174 251Q136 239 118 216Q100 192 100 151Q100 97 124 73Q147 48 193 45Z"},
+	'𝕓':      {699, 23, -14, 659, 760, "M97 59L97 701L23 701L23 760L270 760L270 453Q292 494 329 513Q366 533 421 533Q532 533 595 460Q659 387 659 260Q659 132 595 59Q532 -14 421 -14Q366 -14 329 6Q292 25 270 66L270 0L23 0L23 59L97 59ZM270 234Q270 143 293 103Q316 62 368 62Q421 62 443 105Q465 148 465 260Q465 372 443 414Q421 457 368 457Q316 457 293 417Q270 376 270 285L270 234ZM211 59L211 701L156 701L156 59L211 59ZM479 467Q500 441 512 395Q524 348 524 260Q524 172 512 125Q500 79 479 52Q495 57 509 64Q523 72 541 88Q558 104 571 125Q583 147 592 182Q600 217 600 260Q600 293 595 322Q590 350 582 370Q574 390 563 406Q551 422 541 432Q530 442 518 450Q505 458 496 461Q488 464 479 467Z"},
+	'𝕔':      {609, 41, -14, 564, 533, "M564 162Q545 73 488 30Q431 -14 332 -14Q193 -14 117 58Q41 129 41 260Q41 389 116 461Q191 533 325 533Q379 533 435 523Q490 513 547 492L547 348L492 348Q484 414 454 446Q423 478 367 478Q295 478 264 429Q234 380 234 260Q234 142 264 92Q293 41 361 41Q413 41 445 72Q476 104 483 162L564 162ZM210 453Q180 440 157 418Q100 363 100 260Q100 155 158 101Q180 80 210 66Q175 131 175 260Q175 390 210 453Z"},
+	'𝕕':      {699, 41, -14, 676, 760, "M429 234L429 285Q429 376 406 417Q382 457 331 457Q277 457 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q382 62 406 103Q429 143 429 234ZM602 59L676 59L676 0L429 0L429 66Q407 25 370 6Q333 -14 278 -14Q167 -14 104 59Q41 132 41 260Q41 388 104 460Q167 533 278 533Q333 533 370 513Q407 494 429 453L429 701L355 701L355 760L602 760L602 59ZM488 701L488 59L543 59L543 701L488 701ZM220 52Q210 64 203 78Q175 134 175 260Q175 386 203 441Q210 455 220 467Q177 455 149 422Q100 366 100 260Q100 154 149 98Q178 64 220 52Z"},
+	'𝕖':      {636, 41, -14, 591, 533, "M401 293Q401 396 382 437Q363 478 317 478Q272 478 253 438Q234 397 234 302L234 293L401 293ZM591 235L234 235L234 231Q234 130 264 86Q294 41 362 41Q418 41 453 71Q487 101 497 157L578 157Q557 69 495 27Q432 -14 320 -14Q185 -14 113 57Q41 128 41 260Q41 389 115 461Q188 533 320 533Q449 533 518 457Q586 381 591 235ZM208 64Q175 120 175 231L175 302Q175 396 193 446Q100 395 100 260Q100 109 208 64ZM442 444Q460 393 460 294L526 294Q509 401 442 444Z"},
+	'𝕗':      {430, 31, 0, 484, 760, "M484 630L429 630Q425 668 407 687Q389 705 355 705Q316 705 298 677Q281 649 281 586L281 519L411 519L411 460L281 460L281 59L381 59L381 0L34 0L34 59L108 59L108 460L31 460L31 519L108 519L108 584Q108 670 163 715Q218 760 325 760Q367 760 406 755Q446 750 484 741L484 630ZM238 689Q198 676 183 649Q167 623 167 584L167 59L222 59L222 586Q222 649 238 689Z"},
+	'𝕘':      {699, 41, -222, 676, 533, "M602 460L602 7Q602 -103 524 -162Q446 -222 304 -222Q252 -222 199 -214Q146 -206 90 -190L90 -66L145 -66Q152 -117 186 -142Q220 -167 283 -167Q363 -167 396 -129Q429 -90 429 7L429 66Q407 25 370 6Q333 -14 278 -14Q167 -14 104 59Q41 132 41 260Q41 388 104 460Q167 533 278 533Q333 533 370 513Q407 494 429 453L429 519L676 519L676 460L602 460ZM429 285Q429 376 406 417Q382 457 331 457Q277 457 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q382 62 406 103Q429 143 429 234L429 285ZM464 -131Q543 -89 543 7L543 460L488 460L488 7Q488 -79 464 -131ZM220 467Q177 455 149 422Q100 366 100 260Q100 154 149 98Q178 64 220 52Q210 64 203 78Q175 134 175 260Q175 386 203 441Q210 455 220 467Z"},
+	'𝕙':      {727, 31, 0, 704, 760, "M34 0L34 59L108 59L108 701L31 701L31 760L281 760L281 446Q312 492 352 513Q392 533 453 533Q541 533 585 482Q630 430 630 330L630 59L704 59L704 0L394 0L394 59L457 59L457 335Q457 401 440 427Q423 452 382 452Q330 452 305 414Q281 375 281 292L281 59L344 59L344 0L34 0ZM167 701L167 59L222 59L222 701L167 701ZM479 473Q487 462 494 451Q500 439 504 428Q508 417 510 405Q513 392 514 381Q515 369 515 357Q516 345 516 335L516 59L571 59L571 330Q571 363 565 388Q560 414 551 428Q542 443 530 453Q517 463 505 467Q494 471 479 473Z"},
+	'𝕚':      {380, 34, 0, 355, 760, "M92 666Q92 706 119 733Q146 760 186 760Q225 760 252 733Q279 706 279 666Q279 627 252 600Q225 573 186 573Q146 573 119 600Q92 627 92 666ZM281 59L355 59L355 0L34 0L34 59L108 59L108 460L34 460L34 519L281 519L281 59ZM151 666Q151 652 161 642Q170 632 186 632Q200 632 210 642Q220 652 220 666Q220 681 210 691Q200 701 186 701Q171 701 161 691Q151 681 151 666ZM167 59L222 59L222 460L167 460L167 59Z"},
+	'𝕛':      {362, -74, -222, 280, 760, "M87 666Q87 706 114 733Q141 760 181 760Q219 760 247 732Q274 705 274 666Q274 627 247 600Q220 573 181 573Q142 573 114 600Q87 627 87 666ZM107 460L33 460L33 519L280 519L280 -61Q280 -141 223 -181Q167 -222 57 -222Q28 -222 -5 -217Q-39 -212 -74 -203L-74 -93L-19 -93Q-18 -132 -4 -150Q11 -167 43 -167Q79 -167 93 -144Q107 -121 107 -61L107 460ZM146 666Q146 652 156 642Q166 632 181 632Q195 632 205 642Q215 652 215 666Q215 681 205 691Q195 701 181 701Q166 701 156 691Q146 681 146 666ZM155 -150Q188 -139 205 -118Q221 -98 221 -61L221 460L166 460L166 -61Q166 -116 155 -150Z"},
+	'𝕜':      {693, 34, 0, 710, 760, "M346 0L34 0L34 59L108 59L108 701L34 701L34 760L281 760L281 272L490 460L428 460L428 519L665 519L665 460L569 460L435 340L654 59L710 59L710 0L412 0L412 59L473 59L329 244L281 201L281 59L346 59L346 0ZM167 59L222 59L222 701L167 701L167 59ZM395 295L377 279L548 59L579 59L395 295Z"},
+	'𝕝':      {380, 34, 0, 355, 760, "M281 59L355 59L355 0L34 0L34 59L108 59L108 701L34 701L34 760L281 760L281 59ZM167 59L222 59L222 701L167 701L167 59Z"},
+	'𝕞':      {1058, 34, 0, 1035, 533, "M607 434Q643 486 685 510Q727 533 783 533Q873 533 917 483Q961 432 961 330L961 59L1035 59L1035 0L726 0L726 59L788 59L788 304Q788 401 773 427Q758 452 718 452Q672 452 646 417Q621 381 621 316L621 59L683 59L683 0L386 0L386 59L448 59L448 304Q448 401 433 427Q418 452 378 452Q332 452 306 417Q281 381 281 316L281 59L343 59L343 0L34 0L34 59L108 59L108 460L34 460L34 519L281 519L281 446Q311 491 350 512Q389 533 443 533Q506 533 546 509Q585 485 607 434ZM812 472Q825 456 832 442Q838 428 842 396Q846 363 847 304L847 59L902 59L902 330Q902 364 896 389Q891 414 883 429Q875 443 862 453Q849 462 838 466Q827 470 812 472ZM472 472Q485 456 492 441Q498 426 502 394Q506 362 507 304L507 59L562 59L562 316Q562 336 562 348Q561 361 559 378Q558 396 554 406Q550 417 544 430Q537 442 528 450Q519 458 504 464Q490 470 472 472ZM222 59L222 460L167 460L167 59L222 59Z"},
+	'𝕟':      {727, 34, 0, 704, 533, "M34 0L34 59L108 59L108 460L34 460L34 519L281 519L281 446Q312 492 352 513Q392 533 453 533Q541 533 585 482Q630 430 630 330L630 59L704 59L704 0L394 0L394 59L457 59L457 335Q457 401 440 427Q423 452 382 452Q330 452 305 414Q281 375 281 292L281 59L344 59L344 0L34 0ZM167 460L167 59L222 59L222 460L167 460ZM479 473Q487 462 494 451Q500 439 504 428Q508 417 510 405Q513 392 514 381Q515 369 515 357Q516 345 516 335L516 59L571 59L571 330Q571 363 565 388Q560 414 551 428Q542 443 530 453Q517 463 505 467Q494 471 479 473Z"},
+	'𝕠':      {667, 41, -14, 627, 533, "M334 41Q389 41 411 88Q433 135 433 260Q433 385 411 431Q389 478 334 478Q279 478 256 431Q234 384 234 260Q234 136 256 88Q279 41 334 41ZM41 260Q41 388 119 461Q197 533 334 533Q472 533 549 461Q627 388 627 260Q627 132 549 59Q471 -14 334 -14Q197 -14 119 59Q41 132 41 260ZM469 74Q491 85 509 102Q568 157 568 260Q568 362 509 417Q491 434 469 446Q492 387 492 260Q492 133 469 74ZM198 445Q177 434 159 417Q100 362 100 260Q100 157 159 102Q177 85 198 74Q175 134 175 260Q175 386 198 445Z"},
+	'𝕡':      {699, 23, -208, 659, 533, "M270 285L270 234Q270 143 293 103Q316 62 368 62Q421 62 443 105Q465 148 465 260Q465 372 443 414Q421 457 368 457Q316 457 293 417Q270 376 270 285ZM97 460L23 460L23 519L270 519L270 453Q292 494 329 513Q366 533 421 533Q532 533 595 460Q659 387 659 260Q659 132 595 59Q532 -14 421 -14Q366 -14 329 6Q292 25 270 66L270 -149L350 -149L350 -208L23 -208L23 -149L97 -149L97 460ZM211 -149L211 460L156 460L156 -149L211 -149ZM479 467Q489 455 496 441Q524 386 524 260Q524 134 496 78Q489 64 479 52Q522 64 551 98Q600 154 600 260Q600 365 551 421Q522 455 479 467Z"},
+	'𝕢':      {699, 41, -208, 676, 533, "M602 460L602 -149L676 -149L676 -208L349 -208L349 -149L429 -149L429 66Q407 25 370 6Q333 -14 278 -14Q167 -14 104 59Q41 132 41 260Q41 388 104 460Q167 533 278 533Q333 533 370 513Q407 494 429 453L429 519L676 519L676 460L602 460ZM429 285Q429 376 406 417Q382 457 331 457Q277 457 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q382 62 406 103Q429 143 429 234L429 285ZM488 460L488 -149L543 -149L543 460L488 460ZM220 467Q177 455 149 422Q100 366 100 260Q100 154 149 98Q178 64 220 52Q210 64 203 78Q175 134 175 260Q175 386 203 441Q210 455 220 467Z"},
+	'𝕣':      {527, 29, 0, 537, 533, "M537 525L537 370L482 370Q479 412 459 432Q440 452 403 452Q346 452 313 402Q281 353 281 264L281 59L375 59L375 0L34 0L34 59L108 59L108 460L29 460L29 519L281 519L281 427Q306 481 348 507Q390 533 450 533Q465 533 487 531Q509 529 537 525ZM222 59L222 460L167 460L167 59L222 59Z"},
+	'𝕤':      {563, 43, -14, 522, 533, "M47 15L47 160L102 160Q109 102 146 72Q182 41 245 41Q298 41 326 59Q354 78 354 112Q354 143 336 160Q318 177 267 190L196 208Q115 228 79 265Q43 303 43 366Q43 451 102 492Q160 533 282 533Q328 533 380 526Q432 518 495 502L495 371L440 371Q436 424 402 451Q369 478 306 478Q253 478 226 461Q199 444 199 412Q199 386 215 371Q230 355 271 345L342 327Q444 301 483 263Q522 225 522 157Q522 69 459 28Q396 -14 264 -14Q216 -14 162 -7Q107 0 47 15ZM146 450Q140 447 136 444Q102 420 102 366Q102 327 122 307Q146 282 210 265L281 247Q321 237 345 225Q355 220 358 218Q361 216 377 203Q391 192 401 169Q408 154 410 144Q412 134 413 112Q414 87 406 66Q417 71 426 77Q445 89 454 109Q463 128 463 157Q463 200 442 221Q414 248 327 270L256 288Q235 293 218 300Q208 305 200 309Q192 313 188 316Q184 319 180 323Q176 326 174 328Q140 359 140 412Q140 432 146 450Z"},
+	'𝕥':      {462, 24, -14, 457, 680, "M99 460L24 460L24 519L99 519L99 680L272 680L272 519L416 519L416 460L272 460L272 142Q272 74 283 58Q293 41 322 41Q353 41 368 62Q383 83 384 127L457 127Q453 49 414 17Q376 -14 282 -14Q175 -14 137 19Q99 53 99 142L99 460ZM223 49Q218 63 216 83Q213 103 213 117Q213 130 213 142L213 621L158 621L158 142Q158 122 160 108Q161 93 165 83Q169 73 174 67Q178 62 187 58Q195 54 202 52Q209 50 223 49Z"},
+	'𝕦':      {727, 23, -14, 693, 519, "M619 519L619 59L693 59L693 0L446 0L446 73Q415 27 375 6Q335 -14 274 -14Q187 -14 142 37Q97 89 97 189L97 460L23 460L23 519L270 519L270 216Q270 119 286 93Q302 67 345 67Q397 67 422 105Q446 144 446 228L446 460L383 460L383 519L619 519ZM560 59L560 460L505 460L505 59L560 59ZM248 46Q227 70 220 104Q212 138 211 216L211 460L156 460L156 189Q156 156 162 131Q167 106 176 91Q184 77 197 67Q209 57 221 53Q233 49 248 46Z"},
+	'𝕧':      {581, -18, 0, 606, 519, "M223 0L39 460L-18 460L-18 519L280 519L280 460L217 460L348 132L479 460L411 460L411 519L606 519L606 460L546 460L362 0L223 0ZM103 460L263 59L313 59L153 460L103 460Z"},
+	'𝕨':      {861, -9, 0, 881, 519, "M539 519L657 173L755 460L687 460L687 519L881 519L881 460L820 460L663 0L544 0L434 325L323 0L205 0L48 460L-9 460L-9 519L285 519L285 460L224 460L320 179L436 519L539 519ZM497 460L479 460L464 420L586 59L621 59L627 78L497 460ZM110 460L248 59L281 59L290 84L162 460L110 460Z"},
+	'𝕩':      {596, 0, 0, 603, 519, "M351 331L443 460L379 460L379 519L581 519L581 460L512 460L380 275L534 59L603 59L603 0L273 0L273 59L340 59L242 196L144 59L214 59L214 0L0 0L0 59L74 59L212 253L63 460L5 460L5 519L322 519L322 460L259 460L351 331ZM467 59L180 460L130 460L418 59L467 59Z"},
+	'𝕪':      {581, -13, -222, 605, 519, "M312 -117Q289 -174 255 -198Q222 -222 166 -222Q142 -222 113 -217Q83 -211 49 -201L49 -93L104 -93Q105 -131 122 -149Q138 -167 171 -167Q203 -167 222 -150Q242 -132 261 -84L269 -66L43 460L-13 460L-13 519L286 519L286 460L224 460L356 153L479 460L410 460L410 519L605 519L605 460L542 460L312 -117ZM107 460L300 12L325 75L160 460L107 460Z"},
+	'𝕫':      {568, 35, 0, 534, 519, "M35 0L35 59L333 462L109 462L109 373L51 373L51 519L534 519L534 461L236 58L476 58L476 152L534 152L534 0L35 0ZM405 460L108 59L163 59L188 93L460 460L405 460Z"},
+}
+
+// boldGlyphs are the glyphs of DejaVu Serif Bold.
+var boldGlyphs = map[rune]*glyph{
+	'0': {696, 47, -14, 649, 742, "M348 43Q407 43 428 96Q450 149 450 364Q450 577 428 631Q407 685 348 685Q290 685 268 632Q246 579 246 364Q246 149 268 96Q290 43 348 43ZM348 -14Q202 -14 125 83Q47 181 47 364Q47 547 125 645Q202 742 348 742Q494 742 571 645Q649 547 649 364Q649 181 571 83Q494 -14 348 -14Z"},
+	'1': {696, 122, 0, 596, 742, "M137 0L137 59L274 59L274 649L122 559L122 632L305 742L459 742L459 59L596 59L596 0L137 0Z"},
+	'2': {696, 67, 0, 606, 742, "M132 549L73 549L73 706Q133 724 191 733Q250 742 309 742Q448 742 526 685Q604 628 604 527Q604 455 561 397Q518 340 397 261L194 128L539 128L539 214L606 214L606 0L67 0L67 119L175 194Q313 289 359 356Q405 423 405 512Q405 597 370 641Q335 685 268 685Q210 685 176 650Q141 615 132 549Z"},
+	'3': {696, 70, -14, 632, 742, "M86 708Q150 725 211 734Q271 742 329 742Q461 742 531 695Q602 648 602 560Q602 494 562 453Q521 412 443 398Q538 383 585 333Q632 283 632 197Q632 95 551 40Q470 -14 317 -14Q259 -14 198 -4Q137 5 70 25L70 185L129 185Q134 116 172 79Q210 43 278 43Q352 43 393 84Q433 125 433 201Q433 280 391 323Q349 366 271 366L239 366L239 425L264 425Q336 425 372 458Q408 491 408 557Q408 619 374 652Q340 685 277 685Q219 685 186 654Q152 622 145 562L86 562L86 708Z"},
+	'4': {696, 41, 0, 655, 742, "M638 0L237 0L237 59L345 59L345 192L41 192L41 248L346 742L530 742L530 255L655 255L655 192L530 192L530 59L638 59L638 0ZM345 255L345 609L128 255L345 255Z"},
+	'5': {696, 82, -14, 620, 729, "M564 729L564 601L174 601L174 441Q206 466 246 479Q285 491 329 491Q464 491 542 423Q620 355 620 238Q620 119 542 52Q464 -14 323 -14Q264 -14 205 -4Q146 5 82 26L82 185L141 185Q144 113 178 78Q212 43 280 43Q352 43 386 91Q421 139 421 238Q421 335 387 383Q353 431 284 431Q247 431 218 416Q189 400 164 368L115 368L115 729L564 729Z"},
+	'6': {696, 63, -14, 642, 742, "M246 432Q278 458 317 470Q356 483 403 483Q515 483 578 417Q642 352 642 238Q642 117 570 52Q499 -14 366 -14Q217 -14 140 76Q63 166 63 341Q63 540 148 641Q234 742 402 742Q446 742 496 735Q546 728 604 713L604 584L545 584Q533 634 499 659Q465 685 411 685Q324 685 285 625Q247 566 246 432ZM355 43Q405 43 427 86Q448 128 448 234Q448 339 427 382Q405 425 355 425Q305 425 283 382Q262 339 262 234Q262 128 283 86Q305 43 355 43Z"},
+	'7': {696, 79, 0, 613, 729, "M613 605L312 0L213 0L506 591L146 591L146 492L79 492L79 729L613 729L613 605Z"},
+	'8': {696, 52, -14, 642, 742, "M465 388Q552 374 597 324Q642 274 642 194Q642 93 566 40Q490 -14 347 -14Q204 -14 128 40Q52 93 52 194Q52 274 97 324Q143 374 229 388Q152 406 114 447Q76 488 76 552Q76 645 146 693Q215 742 347 742Q479 742 549 693Q618 645 618 552Q618 488 580 447Q542 406 465 388ZM429 550Q429 627 411 656Q392 685 347 685Q302 685 283 656Q265 627 265 550Q265 473 283 445Q302 416 347 416Q393 416 411 445Q429 473 429 550ZM448 202Q448 292 426 325Q403 359 347 359Q291 359 269 325Q246 292 246 202Q246 112 269 77Q292 43 347 43Q402 43 425 77Q448 112 448 202Z"},
+	'9': {696, 54, -14, 633, 742, "M341 685Q291 685 269 642Q248 600 248 494Q248 389 269 346Q291 303 341 303Q391 303 413 346Q434 389 434 494Q434 600 413 642Q391 685 341 685ZM450 297Q417 271 378 258Q339 245 293 245Q182 245 118 310Q54 375 54 490Q54 611 125 677Q197 742 329 742Q479 742 556 652Q633 562 633 387Q633 188 547 87Q462 -14 294 -14Q250 -14 200 -7Q149 0 92 15L92 144L151 144Q163 94 197 69Q231 43 285 43Q371 43 410 103Q449 163 450 297Z"},
+	'A': {776, -8, 0, 787, 729, "M-8 0L-8 59L52 59L326 729L443 729L718 59L787 59L787 0L446 0L446 59L517 59L457 208L183 208L122 59L209 59L209 0L-8 0ZM207 267L433 267L321 546L207 267Z"},
+	'B': {845, 47, 0, 790, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L479 729Q612 729 679 684Q746 639 746 549Q746 484 700 446Q654 408 563 398Q673 388 731 339Q790 291 790 211Q790 103 708 51Q626 0 452 0L47 0ZM328 425L392 425Q476 425 517 455Q557 486 557 549Q557 612 518 641Q479 670 392 670L328 670L328 425ZM328 59L398 59Q491 59 536 96Q581 133 581 211Q581 289 536 328Q490 366 398 366L328 366L328 59Z"},
+	'C': {796, 42, -14, 745, 742, "M745 208Q716 95 642 41Q567 -14 441 -14Q256 -14 149 88Q42 189 42 364Q42 539 149 640Q256 742 441 742Q506 742 576 727Q646 711 725 679L725 500L663 500Q644 592 594 637Q543 683 461 683Q354 683 303 605Q251 526 251 364Q251 201 303 123Q354 45 462 45Q535 45 581 85Q627 126 648 208L745 208Z"},
+	'D': {867, 47, 0, 825, 729, "M328 59L396 59Q510 59 563 132Q616 206 616 365Q616 524 563 597Q511 670 396 670L328 670L328 59ZM47 0L47 59L140 59L140 670L47 670L47 729L421 729Q617 729 721 635Q825 542 825 365Q825 188 721 94Q617 0 421 0L47 0Z"},
+	'E': {762, 47, 0, 711, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L702 729L702 557L635 557L635 662L328 662L328 428L519 428L519 521L586 521L586 269L519 269L519 362L328 362L328 67L644 67L644 172L711 172L711 0L47 0Z"},
+	'F': {710, 47, 0, 697, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L697 729L697 557L630 557L630 662L329 662L329 428L514 428L514 521L581 521L581 269L514 269L514 362L329 362L329 59L444 59L444 0L47 0Z"},
+	'G': {854, 42, -14, 775, 742, "M688 500Q666 597 615 640Q563 683 470 683Q357 683 304 606Q251 529 251 364Q251 200 302 122Q353 45 460 45Q501 45 536 54Q571 63 600 82L600 282L512 282L512 341L775 341L775 61Q693 23 611 4Q529 -14 444 -14Q256 -14 149 87Q42 188 42 364Q42 540 149 641Q256 742 444 742Q522 742 598 727Q673 711 750 679L750 500L688 500Z"},
+	'H': {945, 47, 0, 900, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 425L618 425L618 670L525 670L525 729L900 729L900 670L806 670L806 59L900 59L900 0L525 0L525 59L618 59L618 358L328 358L328 59L422 59L422 0L47 0Z"},
+	'I': {468, 47, 0, 422, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 59L422 59L422 0L47 0Z"},
+	'J': {473, -73, -208, 435, 729, "M-73 -175L-73 -60L-10 -60Q-7 -106 12 -127Q31 -149 68 -149Q115 -149 134 -113Q153 -76 153 24L153 670L47 670L47 729L435 729L435 670L342 670L342 20Q342 -99 282 -153Q222 -208 92 -208Q51 -208 10 -200Q-31 -191 -73 -175Z"},
+	'K': {869, 47, 0, 900, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 404L637 670L559 670L559 729L831 729L831 670L734 670L472 445L828 59L900 59L900 0L653 0L328 354L328 59L422 59L422 0L47 0Z"},
+	'L': {703, 47, 0, 681, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 67L615 67L615 182L681 182L681 0L47 0Z"},
+	'M': {1107, 42, 0, 1060, 729, "M42 0L42 59L135 59L135 670L42 670L42 729L343 729L551 261L760 729L1060 729L1060 670L967 670L967 59L1060 59L1060 0L685 0L685 59L778 59L778 615L552 107L428 107L203 615L203 59L296 59L296 0L42 0Z"},
+	'N': {914, 44, 0, 874, 729, "M44 0L44 59L137 59L137 670L44 670L44 729L272 729L712 213L712 670L619 670L619 729L874 729L874 670L781 670L781 0L653 0L206 527L206 59L299 59L299 0L44 0Z"},
+	'O': {871, 42, -14, 828, 742, "M435 45Q528 45 574 124Q619 202 619 364Q619 526 574 604Q528 683 435 683Q342 683 297 604Q251 524 251 364Q251 204 297 124Q342 45 435 45ZM435 -14Q251 -14 146 86Q42 187 42 364Q42 541 147 641Q251 742 435 742Q619 742 724 642Q828 541 828 364Q828 187 723 86Q619 -14 435 -14Z"},
+	'P': {752, 47, 0, 727, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L462 729Q583 729 655 671Q727 613 727 516Q727 418 655 360Q583 302 462 302L328 302L328 59L446 59L446 0L47 0ZM328 361L383 361Q446 361 484 403Q522 445 522 516Q522 586 484 628Q447 670 383 670L328 670L328 361Z"},
+	'Q': {871, 42, -180, 828, 742, "M432 -14Q248 -11 145 88Q42 188 42 364Q42 541 147 641Q251 742 435 742Q619 742 724 642Q828 541 828 364Q828 208 748 112Q667 15 519 -8Q545 -36 582 -48Q619 -60 678 -60L703 -60L703 -180L689 -180Q596 -180 534 -140Q472 -100 432 -14ZM435 45Q528 45 574 124Q619 202 619 364Q619 526 574 604Q528 683 435 683Q342 683 297 604Q251 524 251 364Q251 204 297 124Q342 45 435 45Z"},
+	'R': {831, 47, 0, 837, 729, "M531 356Q574 350 604 327Q634 305 656 262L762 59L837 59L837 0L597 0L481 220Q447 289 424 307Q400 326 358 326L328 326L328 59L422 59L422 0L47 0L47 59L140 59L140 670L47 670L47 729L463 729Q590 729 659 678Q728 627 728 533Q728 457 679 413Q630 369 531 356ZM328 385L392 385Q460 385 497 422Q534 459 534 528Q534 597 497 633Q460 670 392 670L328 670L328 385Z"},
+	'S': {722, 66, -14, 662, 742, "M77 35L77 208L139 208Q153 126 206 85Q259 45 352 45Q428 45 467 74Q507 103 507 158Q507 202 480 227Q453 251 369 272L260 299Q153 326 109 375Q66 423 66 513Q66 622 138 682Q210 742 340 742Q404 742 473 731Q542 721 616 699L616 538L554 538Q540 613 492 648Q444 683 356 683Q284 683 247 658Q210 633 210 583Q210 538 235 514Q261 490 360 465L469 438Q571 413 616 359Q662 305 662 211Q662 101 586 43Q510 -14 362 -14Q291 -14 220 -2Q150 10 77 35Z"},
+	'T': {744, 11, 0, 734, 729, "M181 0L181 59L278 59L278 662L78 662L78 547L11 547L11 729L734 729L734 547L668 547L668 662L467 662L467 59L564 59L564 0L181 0Z"},
+	'U': {872, 34, -14, 850, 729, "M127 670L34 670L34 729L409 729L409 670L316 670L316 305Q316 169 358 115Q401 60 504 60Q604 60 647 115Q689 170 689 305L689 670L596 670L596 729L850 729L850 670L757 670L757 295Q757 128 685 57Q612 -14 442 -14Q272 -14 200 58Q127 129 127 296L127 670Z"},
+	'V': {776, -6, 0, 790, 729, "M790 729L790 670L729 670L454 0L338 0L63 670L-6 670L-6 729L336 729L336 670L265 670L462 187L660 670L573 670L573 729L790 729Z"},
+	'W': {1123, -9, 0, 1135, 729, "M852 0L726 0L561 531L396 0L270 0L62 670L-9 670L-9 729L337 729L337 670L259 670L398 222L555 729L694 729L854 214L996 670L909 670L909 729L1135 729L1135 670L1060 670L852 0Z"},
+	'X': {776, 5, 0, 775, 729, "M339 281L187 59L277 59L277 0L18 0L18 59L108 59L300 338L72 670L5 670L5 729L367 729L367 670L287 670L428 465L568 670L483 670L483 729L737 729L737 670L647 670L467 408L706 59L775 59L775 0L411 0L411 59L492 59L339 281Z"},
+	'Y': {714, -9, 0, 723, 729, "M172 0L172 59L270 59L270 302L53 670L-9 670L-9 729L341 729L341 670L260 670L424 392L587 670L514 670L514 729L723 729L723 670L661 670L459 327L459 59L557 59L557 0L172 0Z"},
+	'Z': {730, 37, 0, 694, 729, "M37 0L37 69L472 662L123 662L123 553L56 553L56 729L689 729L689 660L259 67L627 67L627 169L694 169L694 0L37 0Z"},
+	'a': {648, 41, -14, 625, 533, "M551 319L551 59L625 59L625 0L378 0L378 66Q344 25 302 5Q260 -14 206 -14Q126 -14 84 29Q41 71 41 151Q41 238 102 282Q164 325 287 325L378 325L378 356Q378 419 348 449Q318 479 256 479Q204 479 176 458Q148 437 136 388L81 388L81 500Q127 517 177 525Q227 533 282 533Q421 533 486 481Q551 430 551 319ZM378 163L378 267L313 267Q265 267 239 241Q213 214 213 165Q213 116 232 91Q250 67 289 67Q329 67 354 93Q378 120 378 163Z"},
+	'b': {699, 23, -14, 659, 760, "M97 59L97 701L23 701L23 760L270 760L270 453Q292 494 329 513Q366 533 421 533Q532 533 595 460Q659 387 659 260Q659 132 595 59Q532 -14 421 -14Q366 -14 329 6Q292 25 270 66L270 0L23 0L23 59L97 59ZM270 234Q270 143 293 103Q316 62 368 62Q421 62 443 105Q465 148 465 260Q465 372 443 414Q421 457 368 457Q316 457 293 417Q270 376 270 285L270 234Z"},
+	'c': {609, 41, -14, 564, 533, "M564 162Q545 73 488 30Q431 -14 332 -14Q193 -14 117 58Q41 129 41 260Q41 389 116 461Q191 533 325 533Q379 533 435 523Q490 513 547 492L547 348L492 348Q484 414 454 446Q423 478 367 478Q295 478 264 429Q234 380 234 260Q234 142 264 92Q293 41 361 41Q413 41 445 72Q476 104 483 162L564 162Z"},
+	'd': {699, 41, -14, 676, 760, "M429 234L429 285Q429 376 406 417Q382 457 331 457Q277 457 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q382 62 406 103Q429 143 429 234ZM602 59L676 59L676 0L429 0L429 66Q407 25 370 6Q333 -14 278 -14Q167 -14 104 59Q41 132 41 260Q41 388 104 460Q167 533 278 533Q333 533 370 513Q407 494 429 453L429 701L355 701L355 760L602 760L602 59Z"},
+	'e': {636, 41, -14, 591, 533, "M401 293Q401 396 382 437Q363 478 317 478Q272 478 253 438Q234 397 234 302L234 293L401 293ZM591 235L234 235L234 231Q234 130 264 86Q294 41 362 41Q418 41 453 71Q487 101 497 157L578 157Q557 69 495 27Q432 -14 320 -14Q185 -14 113 57Q41 128 41 260Q41 389 115 461Q188 533 320 533Q449 533 518 457Q586 381 591 235Z"},
+	'f': {430, 31, 0, 484, 760, "M484 630L429 630Q425 668 407 687Q389 705 355 705Q316 705 298 677Q281 649 281 586L281 519L411 519L411 460L281 460L281 59L381 59L381 0L34 0L34 59L108 59L108 460L31 460L31 519L108 519L108 584Q108 670 163 715Q218 760 325 760Q367 760 406 755Q446 750 484 741L484 630Z"},
+	'g': {699, 41, -222, 676, 533, "M602 460L602 7Q602 -103 524 -162Q446 -222 304 -222Q252 -222 199 -214Q146 -206 90 -190L90 -66L145 -66Q152 -117 186 -142Q220 -167 283 -167Q363 -167 396 -129Q429 -90 429 7L429 66Q407 25 370 6Q333 -14 278 -14Q167 -14 104 59Q41 132 41 260Q41 388 104 460Q167 533 278 533Q333 533 370 513Q407 494 429 453L429 519L676 519L676 460L602 460ZM429 285Q429 376 406 417Q382 457 331 457Q277 457 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q382 62 406 103Q429 143 429 234L429 285Z"},
+	'h': {727, 31, 0, 704, 760, "M34 0L34 59L108 59L108 701L31 701L31 760L281 760L281 446Q312 492 352 513Q392 533 453 533Q541 533 585 482Q630 430 630 330L630 59L704 59L704 0L394 0L394 59L457 59L457 335Q457 401 440 427Q423 452 382 452Q330 452 305 414Q281 375 281 292L281 59L344 59L344 0L34 0Z"},
+	'i': {380, 34, 0, 355, 760, "M92 666Q92 706 119 733Q146 760 186 760Q225 760 252 733Q279 706 279 666Q279 627 252 600Q225 573 186 573Q146 573 119 600Q92 627 92 666ZM281 59L355 59L355 0L34 0L34 59L108 59L108 460L34 460L34 519L281 519L281 59Z"},
+	'j': {362, -74, -222, 280, 760, "M87 666Q87 706 114 733Q141 760 181 760Q219 760 247 732Q274 705 274 666Q274 627 247 600Q220 573 181 573Q142 573 114 600Q87 627 87 666ZM107 460L33 460L33 519L280 519L280 -61Q280 -141 223 -181Q167 -222 57 -222Q28 -222 -5 -217Q-39 -212 -74 -203L-74 -93L-19 -93Q-18 -132 -4 -150Q11 -167 43 -167Q79 -167 93 -144Q107 -121 107 -61L107 460Z"},
+	'k': {693, 34, 0, 710, 760, "M346 0L34 0L34 59L108 59L108 701L34 701L34 760L281 760L281 272L490 460L428 460L428 519L665 519L665 460L569 460L435 340L654 59L710 59L710 0L412 0L412 59L473 59L329 244L281 201L281 59L346 59L346 0Z"},
+	'l': {380, 34, 0, 355, 760, "M281 59L355 59L355 0L34 0L34 59L108 59L108 701L34 701L34 760L281 760L281 59Z"},
+	'm': {1058, 34, 0, 1035, 533, "M607 434Q643 486 685 510Q727 533 783 533Q873 533 917 483Q961 432 961 330L961 59L1035 59L1035 0L726 0L726 59L788 59L788 304Q788 401 773 427Q758 452 718 452Q672 452 646 417Q621 381 621 316L621 59L683 59L683 0L386 0L386 59L448 59L448 304Q448 401 433 427Q418 452 378 452Q332 452 306 417Q281 381 281 316L281 59L343 59L343 0L34 0L34 59L108 59L108 460L34 460L34 519L281 519L281 446Q311 491 350 512Q389 533 443 533Q506 533 546 509Q585 485 607 434Z"},
+	'n': {727, 34, 0, 704, 533, "M34 0L34 59L108 59L108 460L34 460L34 519L281 519L281 446Q312 492 352 513Q392 533 453 533Q541 533 585 482Q630 430 630 330L630 59L704 59L704 0L394 0L394 59L457 59L457 335Q457 401 440 427Q423 452 382 452Q330 452 305 414Q281 375 281 292L281 59L344 59L344 0L34 0Z"},
+	'o': {667, 41, -14, 627, 533, "M334 41Q389 41 411 88Q433 135 433 260Q433 385 411 431Q389 478 334 478Q279 478 256 431Q234 384 234 260Q234 136 256 88Q279 41 334 41ZM334 -14Q197 -14 119 59Q41 132 41 260Q41 388 119 461Q197 533 334 533Q472 533 549 461Q627 388 627 260Q627 132 549 59Q471 -14 334 -14Z"},
+	'p': {699, 23, -208, 659, 533, "M270 285L270 234Q270 143 293 103Q316 62 368 62Q421 62 443 105Q465 148 465 260Q465 372 443 414Q421 457 368 457Q316 457 293 417Q270 376 270 285ZM97 460L23 460L23 519L270 519L270 453Q292 494 329 513Q366 533 421 533Q532 533 595 460Q659 387 659 260Q659 132 595 59Q532 -14 421 -14Q366 -14 329 6Q292 25 270 66L270 -149L350 -149L350 -208L23 -208L23 -149L97 -149L97 460Z"},
+	'q': {699, 41, -208, 676, 533, "M602 460L602 -149L676 -149L676 -208L349 -208L349 -149L429 -149L429 66Q407 25 370 6Q333 -14 278 -14Q167 -14 104 59Q41 132 41 260Q41 388 104 460Q167 533 278 533Q333 533 370 513Q407 494 429 453L429 519L676 519L676 460L602 460ZM429 285Q429 376 406 417Q382 457 331 457Q277 457 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q382 62 406 103Q429 143 429 234L429 285Z"},
+	'r': {527, 29, 0, 537, 533, "M537 525L537 370L482 370Q479 412 459 432Q440 452 403 452Q346 452 313 402Q281 353 281 264L281 59L375 59L375 0L34 0L34 59L108 59L108 460L29 460L29 519L281 519L281 427Q306 481 348 507Q390 533 450 533Q465 533 487 531Q509 529 537 525Z"},
+	's': {563, 43, -14, 522, 533, "M47 15L47 160L102 160Q109 102 146 72Q182 41 245 41Q298 41 326 59Q354 78 354 112Q354 143 336 160Q318 177 267 190L196 208Q115 228 79 265Q43 303 43 366Q43 451 102 492Q160 533 282 533Q328 533 380 526Q432 518 495 502L495 371L440 371Q436 424 402 451Q369 478 306 478Q253 478 226 461Q199 444 199 412Q199 386 215 371Q230 355 271 345L342 327Q444 301 483 263Q522 225 522 157Q522 69 459 28Q396 -14 264 -14Q216 -14 162 -7Q107 0 47 15Z"},
+	't': {462, 24, -14, 457, 680, "M99 460L24 460L24 519L99 519L99 680L272 680L272 519L416 519L416 460L272 460L272 142Q272 74 283 58Q293 41 322 41Q353 41 368 62Q383 83 384 127L457 127Q453 49 414 17Q376 -14 282 -14Q175 -14 137 19Q99 53 99 142L99 460Z"},
+	'u': {727, 23, -14, 693, 519, "M619 519L619 59L693 59L693 0L446 0L446 73Q415 27 375 6Q335 -14 274 -14Q187 -14 142 37Q97 89 97 189L97 460L23 460L23 519L270 519L270 216Q270 119 286 93Q302 67 345 67Q397 67 422 105Q446 144 446 228L446 460L383 460L383 519L619 519Z"},
+	'v': {581, -18, 0, 606, 519, "M223 0L39 460L-18 460L-18 519L280 519L280 460L217 460L348 132L479 460L411 460L411 519L606 519L606 460L546 460L362 0L223 0Z"},
+	'w': {861, -9, 0, 881, 519, "M539 519L657 173L755 460L687 460L687 519L881 519L881 460L820 460L663 0L544 0L434 325L323 0L205 0L48 460L-9 460L-9 519L285 519L285 460L224 460L320 179L436 519L539 519Z"},
+	'x': {596, 0, 0, 603, 519, "M351 331L443 460L379 460L379 519L581 519L581 460L512 460L380 275L534 59L603 59L603 0L273 0L273 59L340 59L242 196L144 59L214 59L214 0L0 0L0 59L74 59L212 253L63 460L5 460L5 519L322 519L322 460L259 460L351 331Z"},
+	'y': {581, -13, -222, 605, 519, "M312 -117Q289 -174 255 -198Q222 -222 166 -222Q142 -222 113 -217Q83 -211 49 -201L49 -93L104 -93Q105 -131 122 -149Q138 -167 171 -167Q203 -167 222 -150Q242 -132 261 -84L269 -66L43 460L-13 460L-13 519L286 519L286 460L224 460L356 153L479 460L410 460L410 519L605 519L605 460L542 460L312 -117Z"},
+	'z': {568, 35, 0, 534, 519, "M35 0L35 59L333 462L109 462L109 373L51 373L51 519L534 519L534 461L236 58L476 58L476 152L534 152L534 0L35 0Z"},
+	'ɛ': {608, 48, -12, 554, 534, "M76 403Q76 459 129 493Q190 532 315 533Q439 534 540 509L540 405L485 405Q479 438 443 458Q409 478 363 478Q303 478 271 454Q242 432 242 401Q242 368 270 345Q304 318 366 318L398 318L398 244L369 244Q296 244 252 210Q219 184 219 141Q220 103 250 76Q288 43 362 43Q436 42 477 82Q497 101 500 130L554 130L554 16Q424 -12 327 -12Q217 -12 142 18Q49 54 48 138Q48 202 91 235Q142 274 210 281Q143 294 109 327Q76 359 76 403Z"},
+	'Α': {776, -8, 0, 787, 729, "M-8 0L-8 59L52 59L326 729L443 729L718 59L787 59L787 0L446 0L446 59L517 59L457 208L183 208L122 59L209 59L209 0L-8 0ZM207 267L433 267L321 546L207 267Z"},
+	'Β': {845, 47, 0, 790, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L479 729Q612 729 679 684Q746 639 746 549Q746 484 700 446Q654 408 563 398Q673 388 731 339Q790 291 790 211Q790 103 708 51Q626 0 452 0L47 0ZM328 425L392 425Q476 425 517 455Q557 486 557 549Q557 612 518 641Q479 670 392 670L328 670L328 425ZM328 59L398 59Q491 59 536 96Q581 133 581 211Q581 289 536 328Q490 366 398 366L328 366L328 59Z"},
+	'Γ': {710, 47, 0, 697, 729, "M329 59L444 59L444 0L47 0L47 59L140 59L140 670L47 670L47 729L697 729L697 557L630 557L630 662L329 662L329 59Z"},
+	'Δ': {776, 29, 0, 741, 729, "M741 0L29 0L326 729L443 729L741 0ZM122 59L517 59L321 546L122 59Z"},
+	'Ε': {762, 47, 0, 711, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L702 729L702 557L635 557L635 662L328 662L328 428L519 428L519 521L586 521L586 269L519 269L519 362L328 362L328 67L644 67L644 172L711 172L711 0L47 0Z"},
+	'Ζ': {730, 37, 0, 694, 729, "M37 0L37 69L472 662L123 662L123 553L56 553L56 729L689 729L689 660L259 67L627 67L627 169L694 169L694 0L37 0Z"},
+	'Η': {945, 47, 0, 900, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 425L618 425L618 670L525 670L525 729L900 729L900 670L806 670L806 59L900 59L900 0L525 0L525 59L618 59L618 358L328 358L328 59L422 59L422 0L47 0Z"},
+	'Θ': {871, 42, -14, 828, 742, "M505 362L365 362L365 269L298 269L298 521L365 521L365 428L505 428L505 521L572 521L572 269L505 269L505 362ZM435 45Q528 45 574 124Q619 202 619 364Q619 526 574 604Q528 683 435 683Q342 683 297 604Q251 524 251 364Q251 204 297 124Q342 45 435 45ZM435 -14Q251 -14 146 86Q42 187 42 364Q42 541 147 641Q251 742 435 742Q619 742 724 642Q828 541 828 364Q828 187 723 86Q619 -14 435 -14Z"},
+	'Ι': {468, 47, 0, 422, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 59L422 59L422 0L47 0Z"},
+	'Κ': {869, 47, 0, 900, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 404L637 670L559 670L559 729L831 729L831 670L734 670L472 445L828 59L900 59L900 0L653 0L328 354L328 59L422 59L422 0L47 0Z"},
+	'Λ': {776, -8, 0, 787, 729, "M122 59L209 59L209 0L-8 0L-8 59L52 59L326 729L443 729L718 59L787 59L787 0L446 0L446 59L517 59L321 546L122 59Z"},
+	'Μ': {1107, 42, 0, 1060, 729, "M42 0L42 59L135 59L135 670L42 670L42 729L343 729L551 261L760 729L1060 729L1060 670L967 670L967 59L1060 59L1060 0L685 0L685 59L778 59L778 615L552 107L428 107L203 615L203 59L296 59L296 0L42 0Z"},
+	'Ν': {914, 44, 0, 874, 729, "M44 0L44 59L137 59L137 670L44 670L44 729L272 729L712 213L712 670L619 670L619 729L874 729L874 670L781 670L781 0L653 0L206 527L206 59L299 59L299 0L44 0Z"},
+	'Ξ': {704, 55, 0, 642, 729, "M582 136L582 206L642 206L642 0L55 0L55 206L115 206L115 136L582 136ZM582 593L115 593L115 523L55 523L55 729L642 729L642 523L582 523L582 593ZM234 362L234 269L174 269L174 521L234 521L234 428L463 428L463 521L523 521L523 269L463 269L463 362L234 362Z"},
+	'Ο': {871, 42, -14, 828, 742, "M435 45Q528 45 574 124Q619 202 619 364Q619 526 574 604Q528 683 435 683Q342 683 297 604Q251 524 251 364Q251 204 297 124Q342 45 435 45ZM435 -14Q251 -14 146 86Q42 187 42 364Q42 541 147 641Q251 742 435 742Q619 742 724 642Q828 541 828 364Q828 187 723 86Q619 -14 435 -14Z"},
+	'Π': {944, 47, 0, 899, 729, "M899 729L899 670L806 670L806 59L899 59L899 0L525 0L525 59L618 59L618 662L328 662L328 59L421 59L421 0L47 0L47 59L140 59L140 670L47 670L47 729L899 729Z"},
+	'Ρ': {752, 47, 0, 727, 729, "M47 0L47 59L140 59L140 670L47 670L47 729L462 729Q583 729 655 671Q727 613 727 516Q727 418 655 360Q583 302 462 302L328 302L328 59L446 59L446 0L47 0ZM328 361L383 361Q446 361 484 403Q522 445 522 516Q522 586 484 628Q447 670 383 670L328 670L328 361Z"},
+	'Σ': {707, 41, 0, 640, 729, "M41 0L365 365L41 729L640 729L640 567L580 567L580 662L330 662L524 433L257 136L580 136L580 206L640 206L640 0L41 0Z"},
+	'Τ': {744, 11, 0, 734, 729, "M181 0L181 59L278 59L278 662L78 662L78 547L11 547L11 729L734 729L734 547L668 547L668 662L467 662L467 59L564 59L564 0L181 0Z"},
+	'Υ': {714, -9, 0, 723, 729, "M172 0L172 59L270 59L270 302L53 670L-9 670L-9 729L341 729L341 670L260 670L424 392L587 670L514 670L514 729L723 729L723 670L661 670L459 327L459 59L557 59L557 0L172 0Z"},
+	'Φ': {871, 42, 0, 828, 729, "M529 163Q553 172 573 196Q619 252 619 364Q619 476 573 532Q553 556 529 564L529 163ZM529 59L623 59L623 0L248 0L248 59L341 59L341 103Q223 116 146 170Q42 243 42 364Q42 490 146 558Q223 607 341 625L341 670L248 670L248 729L623 729L623 670L529 670L529 625Q647 607 723 558Q828 490 828 364Q828 243 723 170Q646 116 529 103L529 59ZM341 163L341 564Q316 556 297 532Q251 476 251 364Q251 252 297 196Q316 172 341 163Z"},
+	'Χ': {776, 5, 0, 775, 729, "M339 281L187 59L277 59L277 0L18 0L18 59L108 59L300 338L72 670L5 670L5 729L367 729L367 670L287 670L428 465L568 670L483 670L483 729L737 729L737 670L647 670L467 408L706 59L775 59L775 0L411 0L411 59L492 59L339 281Z"},
+	'Ψ': {913, 7, 0, 907, 729, "M670 445L670 580Q669 729 764 729L907 729L907 670L857 670Q813 670 813 580L813 437Q813 298 748 240Q690 188 551 181L551 59L645 59L645 0L270 0L270 59L363 59L363 181Q219 188 165 240Q100 301 100 437L100 580Q101 670 57 670L7 670L7 729L149 729Q244 729 243 580L243 445Q243 328 289 282Q311 260 363 246L363 670L270 670L270 729L645 729L645 670L551 670L551 246Q606 255 625 282Q671 349 670 445Z"},
+	'Ω': {890, 45, 0, 845, 742, "M610 90L773 90L773 151L840 151L840 0L482 0L482 94Q558 110 597 184Q636 258 636 388Q636 532 587 608Q539 683 445 683Q351 683 302 608Q254 532 254 387Q254 260 293 185Q333 110 408 94L408 0L50 0L50 151L116 151L116 90L280 90Q164 135 104 213Q45 292 45 399Q45 553 155 648Q264 742 445 742Q625 742 735 648Q845 553 845 399Q845 292 786 213Q726 135 610 90Z"},
+	'Ϊ': {468, 47, 0, 422, 939, "M47 0L47 59L140 59L140 670L47 670L47 729L422 729L422 670L328 670L328 59L422 59L422 0L47 0ZM56 867Q56 896 78 918Q99 939 128 939Q157 939 178 918Q199 896 199 867Q199 838 178 817Q158 796 128 796Q99 796 78 817Q56 838 56 867ZM274 867Q274 896 296 918Q317 939 346 939Q375 939 397 918Q418 896 418 867Q418 838 397 817Q375 796 346 796Q317 796 296 817Q274 838 274 867Z"},
+	'Ϋ': {714, -9, 0, 723, 939, "M172 0L172 59L270 59L270 302L53 670L-9 670L-9 729L341 729L341 670L260 670L424 392L587 670L514 670L514 729L723 729L723 670L661 670L459 327L459 59L557 59L557 0L172 0ZM197 867Q197 896 219 918Q240 939 270 939Q298 939 319 918Q340 896 340 867Q340 838 320 817Q299 796 270 796Q240 796 219 817Q197 838 197 867ZM416 867Q416 896 437 918Q458 939 487 939Q517 939 538 918Q560 896 560 867Q560 838 538 817Q517 796 487 796Q458 796 437 817Q416 838 416 867Z"},
+	'ά': {770, 41, -37, 746, 800, "M396 800L532 800L350 616L270 616L396 800ZM545 455Q586 494 633 519L696 519L696 498Q638 451 608 403Q599 386 577 328L604 169Q622 63 664 62Q706 60 746 92L746 16Q611 -37 519 22Q501 33 483 51Q466 22 427 6Q382 -14 310 -14Q167 -14 104 59Q41 132 41 260Q41 387 104 460Q167 533 322 531Q493 530 545 455ZM430 176L406 381Q397 452 331 457Q279 461 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q383 63 406 103Q412 113 430 176Z"},
+	'έ': {608, 48, -12, 554, 800, "M375 800L512 800L330 616L250 616L375 800ZM76 403Q76 459 129 493Q190 532 315 533Q439 534 540 509L540 405L485 405Q479 438 443 458Q409 478 363 478Q303 478 271 454Q242 432 242 401Q242 368 270 345Q304 318 366 318L398 318L398 244L369 244Q296 244 252 210Q219 184 219 141Q220 103 250 76Q288 43 362 43Q436 42 477 82Q497 101 500 130L554 130L554 16Q424 -12 327 -12Q217 -12 142 18Q49 54 48 138Q48 202 91 235Q142 274 210 281Q143 294 109 327Q76 359 76 403Z"},
+	'ή': {727, 34, -208, 630, 800, "M414 800L550 800L368 616L288 616L414 800ZM108 0L108 460L34 460L34 519L281 519L281 446Q312 492 352 513Q392 533 453 533Q541 533 585 481Q630 431 630 330L630 -208L457 -208L457 335Q457 401 440 427Q423 452 382 452Q330 452 305 414Q281 375 281 292L281 0L108 0Z"},
+	'ί': {484, 34, -39, 444, 800, "M247 800L383 800L201 616L121 616L247 800ZM444 16Q292 -39 195 22Q108 77 108 170L108 460L34 460L34 519L281 519L281 169Q281 61 341 59Q400 57 444 92L444 16Z"},
+	'ΰ': {694, 34, -1, 654, 996, "M399 996L535 996L353 812L273 812L399 996ZM164 717Q164 746 186 767Q207 788 236 788Q266 788 286 767Q307 746 307 717Q307 688 286 666Q265 645 236 645Q207 645 186 667Q164 688 164 717ZM382 717Q382 746 404 767Q425 788 454 788Q483 788 505 767Q526 746 526 717Q526 688 505 667Q483 645 454 645Q425 645 404 667Q382 688 382 717ZM355 -1Q108 0 108 170L108 460L34 460L34 519L281 519L281 169Q281 59 355 59Q403 59 435 116Q465 171 465 282Q465 375 445 424Q419 485 404 498L404 519L467 519Q553 487 604 423Q654 360 654 274Q654 198 630 155Q594 92 561 66Q521 33 468 16Q415 -1 355 -1Z"},
+	'α': {770, 41, -37, 746, 533, "M545 455Q586 494 633 519L696 519L696 498Q638 451 608 403Q599 386 577 328L604 169Q622 63 664 62Q706 60 746 92L746 16Q611 -37 519 22Q501 33 483 51Q466 22 427 6Q382 -14 310 -14Q167 -14 104 59Q41 132 41 260Q41 387 104 460Q167 533 322 531Q493 530 545 455ZM430 176L406 381Q397 452 331 457Q279 461 256 414Q234 372 234 260Q234 148 256 105Q278 62 331 62Q383 63 406 103Q412 113 430 176Z"},
+	'β': {664, 63, -208, 609, 770, "M236 47Q260 43 290 43Q329 43 370 84Q416 131 410 201Q404 276 381 322Q352 381 316 381L284 381L284 439L310 439Q329 439 350 473Q377 516 377 591Q377 654 349 690Q331 714 305 713Q236 712 236 545L236 47ZM236 -11L236 -208L63 -208L63 562Q63 770 306 769Q442 769 500 724Q571 669 571 594Q571 509 530 468Q490 427 420 413Q490 396 562 319Q609 270 609 197Q609 95 528 40Q447 -14 317 -14Q269 -14 236 -11Z"},
+	'γ': {660, 24, -210, 666, 519, "M548 207Q453 107 377 18Q409 -115 383 -150Q338 -208 286 -209Q214 -210 189 -160Q163 -106 223 -8L82 467L24 467L24 519L232 519L354 107Q445 249 466 349Q472 374 457 424Q446 461 416 498L416 519L479 519Q578 491 615 423Q666 330 548 207Z"},
+	'δ': {667, 41, -14, 627, 765, "M174 499Q94 545 94 615Q94 698 159 731Q162 733 165 734Q228 765 334 765Q383 765 443 759Q501 752 558 741L558 637L503 637Q501 654 493 665Q488 671 466 688Q434 710 382 710Q323 710 292 687L276 673Q257 656 261 613Q268 533 334 533Q472 533 549 461Q627 388 627 260Q627 132 549 59Q471 -14 334 -14Q197 -14 119 59Q41 132 41 260Q41 388 119 461Q166 504 174 499ZM334 41Q389 41 411 88Q433 135 433 260Q433 385 411 431Q389 478 334 478Q279 478 256 431Q234 384 234 260Q234 136 256 88Q279 41 334 41Z"},
+	'ε': {608, 48, -12, 554, 534, "M76 403Q76 459 129 493Q190 532 315 533Q439 534 540 509L540 405L485 405Q479 438 443 458Q409 478 363 478Q303 478 271 454Q242 432 242 401Q242 368 270 345Q304 318 366 318L398 318L398 244L369 244Q296 244 252 210Q219 184 219 141Q220 103 250 76Q288 43 362 43Q436 42 477 82Q497 101 500 130L554 130L554 16Q424 -12 327 -12Q217 -12 142 18Q49 54 48 138Q48 202 91 235Q142 274 210 281Q143 294 109 327Q76 359 76 403Z"},
+	'ζ': {592, 34, -208, 553, 760, "M408 701L93 701L93 619L34 619L34 760L542 760L542 701Q323 571 265 435Q234 363 234 260Q234 142 264 92Q293 41 361 41Q521 41 542 -72Q553 -126 490 -171Q438 -208 330 -208L330 -156Q426 -156 427 -79Q428 -14 332 -14Q193 -14 117 58Q41 129 41 260Q41 360 116 466Q210 601 408 701Z"},
+	'η': {727, 34, -208, 630, 533, "M108 0L108 460L34 460L34 519L281 519L281 446Q312 492 352 513Q392 533 453 533Q541 533 585 481Q630 431 630 330L630 -208L457 -208L457 335Q457 401 440 427Q423 452 382 452Q330 452 305 414Q281 375 281 292L281 0L108 0Z"},
+	'θ': {667, 41, -17, 627, 771, "M234 407L434 407Q432 571 411 636Q387 713 334 713Q281 713 257 636Q236 571 234 407ZM434 348L234 348Q236 177 256 113Q279 41 334 41Q389 41 412 113Q432 177 434 348ZM41 377Q41 563 119 667Q197 771 334 771Q472 771 549 667Q627 563 627 377Q627 193 549 88Q471 -17 334 -17Q197 -17 119 88Q41 193 41 377Z"},
+	'ι': {484, 34, -39, 444, 519, "M444 16Q292 -39 195 22Q108 77 108 170L108 460L34 460L34 519L281 519L281 169Q281 61 341 59Q400 57 444 92L444 16Z"},
+	'κ': {750, 34, 0, 710, 519, "M346 0L34 0L34 59L108 59L108 460L34 460L34 519L346 519L346 460L281 460L281 272L490 460L428 460L428 519L665 519L665 460L569 460L435 340L654 59L710 59L710 0L412 0L412 59L473 59L329 244L281 201L281 59L346 59L346 0Z"},
+	'λ': {701, 36, 0, 660, 739, "M600 59L660 59L660 0L465 0L465 59L533 59L420 342L271 59L333 59L333 0L36 0L36 59L93 59L338 542Q290 651 245 672Q223 683 181 684Q149 685 132 666Q115 647 114 610L59 609L59 718Q95 729 123 733Q156 739 176 739Q310 734 382 566L600 59Z"},
+	'μ': {732, 28, -208, 698, 519, "M275 -208L102 -208L102 460L28 460L28 519L275 519L275 228Q275 121 292 94Q308 67 351 67Q403 67 427 106Q451 145 451 228L451 460L387 460L387 519L624 519L624 59L698 59L698 0L451 0L451 61Q424 21 398 3Q371 -14 335 -14Q320 -14 305 -9Q291 -4 275 6L275 -208Z"},
+	'ν': {694, 34, 0, 654, 519, "M281 0L108 0L108 460L34 460L34 519L281 519L281 59Q316 71 360 114Q415 167 435 205Q465 264 465 354Q465 381 445 449Q434 486 404 498L404 519L467 519Q571 490 604 449Q654 385 654 346Q654 288 630 240Q601 186 561 149Q501 94 468 72Q392 25 281 0Z"},
+	'ξ': {592, 34, -208, 553, 760, "M276 410Q234 419 183 449Q140 473 140 561Q140 659 347 701L93 701L93 619L34 619L34 760L542 760L542 701Q307 668 307 559Q307 439 440 439L464 439L464 381L434 381Q234 374 234 206Q234 126 264 89Q303 41 361 41Q520 41 542 -72Q553 -126 490 -171Q438 -208 330 -208L330 -156Q426 -156 427 -79Q428 -14 332 -14Q212 -14 117 55Q41 111 41 206Q41 358 276 410Z"},
+	'ο': {667, 41, -14, 627, 533, "M334 41Q389 41 411 88Q433 135 433 260Q433 385 411 431Q389 478 334 478Q279 478 256 431Q234 384 234 260Q234 136 256 88Q279 41 334 41ZM334 -14Q197 -14 119 59Q41 132 41 260Q41 388 119 461Q197 533 334 533Q472 533 549 461Q627 388 627 260Q627 132 549 59Q471 -14 334 -14Z"},
+	'π': {732, 34, 0, 698, 519, "M34 0L34 59L108 59L108 460L34 460L34 519L698 519L698 460L624 460L624 59L698 59L698 0L387 0L387 59L451 59L451 460L281 460L281 59L344 59L344 0L34 0Z"},
+	'ρ': {665, 63, -208, 625, 534, "M236 285L236 234Q236 143 259 103Q282 62 334 62Q387 62 409 105Q431 148 431 260Q431 383 409 426Q387 469 334 469Q282 469 259 428Q236 388 236 285ZM63 205Q63 490 259 526Q301 534 374 533Q500 532 562 460Q625 385 625 260Q625 132 561 59Q498 -14 387 -14Q332 -14 295 6Q258 25 236 66L236 -208L63 -208L63 205Z"},
+	'ς': {609, 41, -208, 553, 533, "M361 41Q521 41 542 -72Q553 -126 490 -171Q438 -208 330 -208L330 -156Q426 -156 427 -79Q428 -14 332 -14Q193 -14 117 58Q41 129 41 260Q41 389 116 461Q191 533 325 533Q379 533 435 523Q490 513 547 492L547 348L492 348Q484 414 454 446Q423 478 367 478Q295 478 264 429Q234 380 234 260Q234 142 264 92Q293 41 361 41Z"},
+	'σ': {737, 41, -14, 686, 519, "M334 41Q389 41 411 88Q433 135 433 260Q433 376 411 423Q389 469 334 469Q279 469 256 422Q234 375 234 260Q234 136 256 88Q279 41 334 41ZM538 460Q627 388 627 260Q627 132 549 59Q471 -14 334 -14Q197 -14 119 59Q41 132 41 260Q41 374 119 451Q187 519 334 519L686 519L686 460L538 460Z"},
+	'τ': {673, 34, -39, 622, 519, "M93 460L93 378L34 378L34 519L622 519L622 460L415 460L415 169Q415 59 475 59Q529 59 578 107L578 16Q425 -39 329 22Q242 77 242 170L242 460L93 460Z"},
+	'υ': {694, 34, -1, 654, 519, "M355 -1Q108 0 108 170L108 460L34 460L34 519L281 519L281 169Q281 59 355 59Q403 59 435 116Q465 171 465 282Q465 375 445 424Q419 485 404 498L404 519L467 519Q553 487 604 423Q654 360 654 274Q654 198 630 155Q594 92 561 66Q521 33 468 16Q415 -1 355 -1Z"},
+	'φ': {905, 47, -208, 857, 547, "M539 52Q554 55 563 59Q611 81 638 116Q668 156 668 282Q668 378 648 424Q624 478 591 478Q539 478 539 359L539 52ZM539 -1L539 -208L366 -208L365 -1Q262 5 233 16Q173 40 140 66Q107 92 71 155Q47 198 47 274Q47 360 98 423Q148 487 234 519L297 519L297 498Q282 485 256 424Q236 374 236 282Q236 171 266 116Q294 66 338 59Q359 56 366 55L366 359Q366 484 492 519Q593 547 670 519Q768 481 807 423Q857 348 857 274Q857 198 833 155Q797 92 764 66Q735 43 671 16Q641 3 539 -1Z"},
+	'χ': {658, 12, -222, 636, 533, "M345 10L260 -156L318 -156L318 -208L12 -208L12 -156L68 -156L264 225L196 406Q176 458 167 466Q146 481 138 481Q109 481 95 467Q81 452 78 419L32 419L32 518Q57 525 81 529Q105 533 128 533Q157 533 192 511Q223 491 246 428L298 291L388 466L338 466L338 519L636 519L636 466L580 466L378 75L443 -95Q463 -148 472 -155Q493 -170 500 -170Q530 -170 543 -156Q558 -141 561 -108L606 -108L606 -207Q582 -214 558 -218Q534 -222 511 -222Q481 -222 447 -200Q416 -180 393 -117L345 10Z"},
+	'ψ': {941, 42, -208, 897, 519, "M557 519L557 55Q562 56 584 62Q605 67 632 116Q662 171 662 282Q662 378 698 423Q749 487 834 519L897 519L897 498Q872 478 857 424Q851 403 851 282Q851 198 827 155Q791 92 758 66Q722 37 665 16Q636 5 557 -1L557 -208L383 -208L383 -1Q302 6 275 16Q214 40 182 66Q149 92 113 155Q88 198 88 282Q88 410 83 424Q57 485 42 498L42 519L105 519Q200 484 241 423Q277 370 277 282Q277 170 308 116Q335 66 355 62Q369 58 383 55L383 519L557 519Z"},
+	'ω': {952, 40, -3, 911, 519, "M133 66Q100 92 64 155Q40 198 40 274Q40 360 90 423Q141 487 227 519L290 519L290 498Q274 485 249 424Q229 374 229 282Q229 171 259 116Q291 59 331 59Q389 59 389 189L389 359L562 359L562 189Q562 59 620 59Q659 59 691 116Q722 171 722 282Q722 374 701 424Q676 485 661 498L661 519L724 519Q809 487 860 423Q911 360 911 274Q911 198 886 155Q850 92 817 66Q789 43 724 16Q685 0 596 -1Q525 -2 475 70Q426 0 354 -1Q273 -3 226 16Q162 43 133 66Z"},
+	'ϑ': {849, 34, -17, 809, 772, "M498 407Q496 560 486 636Q477 713 457 713Q412 713 414 603Q417 407 498 407ZM691 407L809 407L809 348L691 348Q682 182 613 88Q536 -17 398 -17Q260 -17 183 88Q108 190 108 460L34 460L34 519L281 519Q281 221 320 113Q347 41 398 41Q453 41 476 113Q496 177 498 348Q303 348 302 598Q301 772 457 771Q550 771 625 667Q684 583 691 407Z"},
+	'ϕ': {941, 41, -208, 898, 760, "M557 760L557 532Q749 532 820 461Q898 383 898 260Q898 132 820 59Q744 -13 557 -13L557 -208L383 -208L383 -13Q196 -13 119 59Q41 132 41 260Q41 383 119 461Q190 532 383 532L383 760L557 760ZM557 47Q623 47 662 102Q706 164 706 260Q706 378 660 426Q614 472 557 472L557 47ZM383 47L383 472Q325 472 280 426Q234 378 234 260Q234 164 277 102Q316 47 383 47Z"},
+	'ϵ': {609, 41, -14, 563, 533, "M41 259Q41 389 116 461Q191 533 325 533Q463 533 547 498L547 354L492 354Q484 420 456 449Q429 478 367 478Q295 478 266 432Q237 385 234 291L432 291L432 232L234 232Q239 132 264 92Q294 41 361 41Q413 41 445 73Q476 104 481 156L563 156Q527 -14 332 -14Q193 -14 117 58Q41 129 41 259Z"},
+}
+
+// sansGlyphs are the glyphs of DejaVu Sans.
+var sansGlyphs = map[rune]*glyph{
+	'0': {636, 66, -14, 570, 742, "M318 664Q242 664 203 589Q165 514 165 364Q165 214 203 139Q242 64 318 64Q395 64 433 139Q471 214 471 364Q471 514 433 589Q395 664 318 664ZM318 742Q440 742 505 645Q570 548 570 364Q570 180 505 83Q440 -14 318 -14Q195 -14 131 83Q66 180 66 364Q66 548 131 645Q195 742 318 742Z"},
+	'1': {636, 110, 0, 544, 729, "M124 83L285 83L285 639L110 604L110 694L284 729L383 729L383 83L544 83L544 0L124 0L124 83Z"},
+	'2': {636, 73, 0, 536, 742, "M192 83L536 83L536 0L73 0L73 83Q129 141 226 239Q323 337 348 365Q396 418 414 455Q433 492 433 528Q433 586 392 623Q352 659 286 659Q240 659 188 643Q137 627 78 594L78 694Q138 718 189 730Q241 742 284 742Q397 742 465 686Q532 629 532 534Q532 489 515 449Q499 409 454 354Q442 340 376 272Q311 205 192 83Z"},
+	'3': {636, 76, -14, 556, 742, "M406 393Q477 378 516 330Q556 282 556 212Q556 104 482 45Q408 -14 271 -14Q225 -14 177 -5Q128 4 76 22L76 117Q117 93 166 81Q215 69 268 69Q361 69 409 105Q458 142 458 212Q458 276 413 313Q368 349 287 349L202 349L202 430L291 430Q364 430 402 459Q441 488 441 543Q441 599 401 629Q361 659 287 659Q247 659 200 650Q154 642 98 623L98 711Q154 727 203 734Q252 742 296 742Q408 742 474 691Q539 640 539 553Q539 493 504 451Q470 409 406 393Z"},
+	'4': {636, 49, 0, 580, 729, "M378 643L129 254L378 254L378 643ZM352 729L476 729L476 254L580 254L580 172L476 172L476 0L378 0L378 172L49 172L49 267L352 729Z"},
+	'5': {636, 77, -14, 549, 729, "M108 729L495 729L495 646L198 646L198 467Q220 475 241 478Q263 482 284 482Q406 482 478 415Q549 348 549 234Q549 116 476 51Q402 -14 269 -14Q223 -14 176 -6Q128 1 77 17L77 116Q121 92 168 81Q215 69 267 69Q352 69 401 113Q450 158 450 234Q450 310 401 354Q352 399 267 399Q228 399 188 390Q149 381 108 363L108 729Z"},
+	'6': {636, 70, -14, 573, 742, "M330 404Q264 404 225 358Q186 313 186 234Q186 155 225 110Q264 64 330 64Q396 64 435 110Q474 155 474 234Q474 313 435 358Q396 404 330 404ZM526 713L526 623Q489 641 451 650Q413 659 376 659Q278 659 227 593Q175 527 168 394Q197 437 240 459Q284 482 336 482Q446 482 510 415Q573 349 573 234Q573 122 507 54Q440 -14 330 -14Q204 -14 137 83Q70 180 70 364Q70 537 152 639Q234 742 372 742Q409 742 447 735Q485 728 526 713Z"},
+	'7': {636, 82, 0, 551, 729, "M82 729L551 729L551 687L286 0L183 0L432 646L82 646L82 729Z"},
+	'8': {636, 68, -14, 568, 742, "M318 346Q248 346 207 309Q167 271 167 205Q167 139 207 102Q248 64 318 64Q388 64 429 102Q469 140 469 205Q469 271 429 309Q389 346 318 346ZM219 388Q156 404 120 447Q85 491 85 553Q85 641 147 691Q209 742 318 742Q427 742 489 691Q551 641 551 553Q551 491 515 447Q480 404 417 388Q488 372 528 323Q568 275 568 205Q568 99 503 42Q438 -14 318 -14Q197 -14 133 42Q68 99 68 205Q68 275 108 323Q148 372 219 388ZM183 544Q183 487 219 456Q254 424 318 424Q381 424 417 456Q453 487 453 544Q453 601 417 632Q381 664 318 664Q254 664 219 632Q183 601 183 544Z"},
+	'9': {636, 63, -14, 566, 742, "M110 15L110 105Q147 87 185 78Q223 69 260 69Q357 69 409 135Q460 200 468 334Q439 292 396 270Q353 247 300 247Q190 247 127 313Q63 379 63 494Q63 606 129 674Q196 742 306 742Q433 742 499 645Q566 548 566 364Q566 191 484 89Q402 -14 264 -14Q227 -14 189 -7Q151 0 110 15ZM306 324Q373 324 411 370Q450 415 450 494Q450 573 411 618Q373 664 306 664Q240 664 201 618Q162 573 162 494Q162 415 201 370Q240 324 306 324Z"},
+	'A': {684, 8, 0, 676, 729, "M342 632L208 269L476 269L342 632ZM286 729L398 729L676 0L573 0L507 187L178 187L112 0L8 0L286 729Z"},
+	'B': {686, 98, 0, 615, 729, "M197 348L197 81L355 81Q435 81 473 114Q511 147 511 215Q511 283 473 316Q435 348 355 348L197 348ZM197 648L197 428L343 428Q415 428 450 455Q486 482 486 538Q486 593 450 621Q415 648 343 648L197 648ZM98 729L350 729Q463 729 524 682Q585 635 585 549Q585 482 554 442Q522 403 462 393Q535 377 575 328Q615 278 615 204Q615 106 549 53Q482 0 360 0L98 0L98 729Z"},
+	'C': {698, 56, -14, 644, 742, "M644 673L644 569Q594 615 538 638Q481 661 418 661Q293 661 227 585Q160 508 160 364Q160 220 227 143Q293 67 418 67Q481 67 538 90Q594 113 644 159L644 56Q592 21 534 3Q477 -14 412 -14Q247 -14 151 87Q56 188 56 364Q56 540 151 641Q247 742 412 742Q478 742 535 725Q593 708 644 673Z"},
+	'D': {770, 98, 0, 711, 729, "M197 648L197 81L316 81Q467 81 537 149Q607 218 607 365Q607 512 537 580Q467 648 316 648L197 648ZM98 729L301 729Q513 729 612 641Q711 553 711 365Q711 177 611 88Q512 0 301 0L98 0L98 729Z"},
+	'E': {632, 98, 0, 568, 729, "M98 729L559 729L559 646L197 646L197 430L544 430L544 347L197 347L197 83L568 83L568 0L98 0L98 729Z"},
+	'F': {575, 98, 0, 517, 729, "M98 729L517 729L517 646L197 646L197 431L486 431L486 348L197 348L197 0L98 0L98 729Z"},
+	'G': {775, 56, -14, 693, 742, "M595 104L595 300L434 300L434 381L693 381L693 68Q636 27 567 7Q498 -14 420 -14Q249 -14 153 86Q56 186 56 364Q56 542 153 642Q249 742 420 742Q491 742 555 725Q620 707 674 673L674 568Q619 614 558 638Q496 661 428 661Q294 661 227 586Q160 512 160 364Q160 216 227 142Q294 67 428 67Q480 67 521 76Q563 85 595 104Z"},
+	'H': {752, 98, 0, 654, 729, "M98 729L197 729L197 430L555 430L555 729L654 729L654 0L555 0L555 347L197 347L197 0L98 0L98 729Z"},
+	'I': {295, 98, 0, 197, 729, "M98 729L197 729L197 0L98 0L98 729Z"},
+	'J': {295, -52, -200, 197, 729, "M98 729L197 729L197 51Q197 -81 147 -141Q97 -200 -14 -200L-52 -200L-52 -117L-21 -117Q44 -117 71 -81Q98 -44 98 51L98 729Z"},
+	'K': {656, 98, 0, 677, 729, "M98 729L197 729L197 421L524 729L651 729L289 389L677 0L547 0L197 351L197 0L98 0L98 729Z"},
+	'L': {557, 98, 0, 552, 729, "M98 729L197 729L197 83L552 83L552 0L98 0L98 729Z"},
+	'M': {863, 98, 0, 765, 729, "M98 729L245 729L431 233L618 729L765 729L765 0L669 0L669 640L481 140L382 140L194 640L194 0L98 0L98 729Z"},
+	'N': {748, 98, 0, 650, 729, "M98 729L231 729L554 119L554 729L650 729L650 0L517 0L194 610L194 0L98 0L98 729Z"},
+	'O': {787, 56, -14, 731, 742, "M394 662Q287 662 223 582Q160 502 160 364Q160 226 223 146Q287 66 394 66Q501 66 564 146Q627 226 627 364Q627 502 564 582Q501 662 394 662ZM394 742Q547 742 639 639Q731 537 731 364Q731 191 639 89Q547 -14 394 -14Q240 -14 148 88Q56 191 56 364Q56 537 148 639Q240 742 394 742Z"},
+	'P': {603, 98, 0, 569, 729, "M197 648L197 374L321 374Q390 374 427 410Q465 445 465 511Q465 577 427 612Q390 648 321 648L197 648ZM98 729L321 729Q443 729 506 674Q569 618 569 511Q569 403 506 348Q443 293 321 293L197 293L197 0L98 0L98 729Z"},
+	'Q': {787, 56, -129, 731, 742, "M394 662Q287 662 223 582Q160 502 160 364Q160 226 223 146Q287 66 394 66Q501 66 564 146Q627 226 627 364Q627 502 564 582Q501 662 394 662ZM532 13L662 -129L543 -129L435 -12Q419 -13 410 -14Q402 -14 394 -14Q240 -14 148 89Q56 191 56 364Q56 537 148 639Q240 742 394 742Q547 742 639 639Q731 537 731 364Q731 237 680 146Q629 56 532 13Z"},
+	'R': {695, 98, 0, 666, 729, "M444 342Q476 331 506 296Q536 261 566 199L666 0L560 0L467 187Q431 260 397 284Q363 308 304 308L197 308L197 0L98 0L98 729L321 729Q446 729 507 677Q569 625 569 519Q569 450 537 405Q505 359 444 342ZM197 648L197 389L321 389Q392 389 428 422Q465 455 465 519Q465 583 428 615Q392 648 321 648L197 648Z"},
+	'S': {635, 66, -14, 579, 742, "M535 705L535 609Q479 636 429 649Q379 662 333 662Q252 662 209 631Q165 600 165 542Q165 494 194 469Q223 444 304 429L364 417Q474 396 527 343Q579 290 579 201Q579 95 508 41Q437 -14 300 -14Q248 -14 190 -2Q131 9 69 32L69 134Q129 100 187 83Q244 66 300 66Q384 66 430 99Q476 132 476 194Q476 248 443 278Q410 308 335 323L275 335Q165 357 115 404Q66 451 66 534Q66 631 134 687Q202 742 322 742Q373 742 426 733Q479 724 535 705Z"},
+	'T': {611, -3, 0, 614, 729, "M-3 729L614 729L614 646L355 646L355 0L256 0L256 646L-3 646L-3 729Z"},
+	'U': {732, 87, -14, 645, 729, "M87 729L186 729L186 286Q186 169 229 117Q271 66 366 66Q461 66 503 117Q546 169 546 286L546 729L645 729L645 274Q645 131 574 59Q504 -14 366 -14Q228 -14 157 59Q87 131 87 274L87 729Z"},
+	'V': {684, 8, 0, 676, 729, "M286 0L8 729L111 729L342 115L573 729L676 729L398 0L286 0Z"},
+	'W': {989, 33, 0, 956, 729, "M33 729L133 729L286 113L439 729L550 729L703 113L856 729L956 729L773 0L649 0L495 633L340 0L216 0L33 729Z"},
+	'X': {685, 30, 0, 654, 729, "M63 729L169 729L350 458L532 729L638 729L404 379L654 0L548 0L343 310L136 0L30 0L290 389L63 729Z"},
+	'Y': {611, -2, 0, 613, 729, "M-2 729L104 729L306 429L507 729L613 729L355 347L355 0L256 0L256 347L-2 729Z"},
+	'Z': {685, 45, 0, 640, 729, "M56 729L629 729L629 654L168 83L640 83L640 0L45 0L45 75L506 646L56 646L56 729Z"},
+	'a': {613, 60, -14, 522, 560, "M343 275Q234 275 192 250Q150 225 150 165Q150 117 181 89Q213 61 267 61Q342 61 387 114Q432 167 432 255L432 275L343 275ZM522 312L522 0L432 0L432 83Q401 33 355 10Q310 -14 243 -14Q159 -14 110 33Q60 80 60 159Q60 251 122 298Q184 345 306 345L432 345L432 354Q432 416 391 450Q351 484 277 484Q230 484 186 473Q141 461 100 439L100 522Q149 541 196 551Q242 560 286 560Q405 560 463 499Q522 437 522 312Z"},
+	'b': {635, 91, -14, 580, 760, "M487 273Q487 372 446 428Q405 485 334 485Q263 485 222 428Q181 372 181 273Q181 174 222 117Q263 61 334 61Q405 61 446 117Q487 174 487 273ZM181 464Q209 513 253 536Q296 560 356 560Q456 560 518 481Q580 402 580 273Q580 144 518 65Q456 -14 356 -14Q296 -14 253 10Q209 33 181 82L181 0L91 0L91 760L181 760L181 464Z"},
+	'c': {550, 55, -14, 488, 560, "M488 526L488 442Q450 463 411 473Q373 484 334 484Q247 484 198 428Q150 373 150 273Q150 173 198 117Q247 62 334 62Q373 62 411 73Q450 83 488 104L488 21Q450 3 410 -5Q370 -14 324 -14Q201 -14 128 63Q55 141 55 273Q55 407 129 483Q202 560 330 560Q372 560 411 552Q451 543 488 526Z"},
+	'd': {635, 55, -14, 544, 760, "M454 464L454 760L544 760L544 0L454 0L454 82Q426 33 383 10Q339 -14 279 -14Q180 -14 117 65Q55 144 55 273Q55 402 117 481Q180 560 279 560Q339 560 383 536Q426 513 454 464ZM148 273Q148 174 189 117Q229 61 301 61Q372 61 413 117Q454 174 454 273Q454 372 413 428Q372 485 301 485Q229 485 189 428Q148 372 148 273Z"},
+	'e': {615, 55, -14, 562, 560, "M562 296L562 252L149 252Q155 159 205 111Q255 62 344 62Q396 62 445 75Q493 87 541 113L541 28Q493 7 442 -3Q391 -14 339 -14Q208 -14 132 62Q55 138 55 268Q55 402 128 481Q200 560 323 560Q434 560 498 489Q562 418 562 296ZM472 322Q471 396 431 440Q391 484 324 484Q249 484 204 441Q159 399 152 322L472 322Z"},
+	'f': {352, 23, 0, 371, 760, "M371 760L371 685L285 685Q237 685 218 666Q199 646 199 595L199 547L347 547L347 477L199 477L199 0L109 0L109 477L23 477L23 547L109 547L109 585Q109 676 151 718Q194 760 286 760L371 760Z"},
+	'g': {635, 55, -208, 544, 560, "M454 280Q454 377 414 431Q374 485 301 485Q229 485 188 431Q148 377 148 280Q148 183 188 129Q229 75 301 75Q374 75 414 129Q454 183 454 280ZM544 68Q544 -72 482 -140Q420 -208 292 -208Q245 -208 203 -201Q161 -194 121 -179L121 -92Q161 -113 199 -124Q238 -134 278 -134Q366 -134 410 -88Q454 -42 454 52L454 96Q426 48 383 24Q339 0 279 0Q178 0 117 77Q55 153 55 280Q55 407 117 483Q178 560 279 560Q339 560 383 536Q426 512 454 464L454 547L544 547L544 68Z"},
+	'h': {634, 91, 0, 549, 760, "M549 330L549 0L459 0L459 327Q459 405 429 443Q398 482 338 482Q265 482 223 436Q181 389 181 309L181 0L91 0L91 760L181 760L181 462Q213 511 257 536Q301 560 358 560Q452 560 500 502Q549 443 549 330Z"},
+	'i': {278, 94, 0, 184, 760, "M94 547L184 547L184 0L94 0L94 547ZM94 760L184 760L184 646L94 646L94 760Z"},
+	'j': {278, -18, -208, 184, 760, "M94 547L184 547L184 -10Q184 -114 144 -161Q104 -208 16 -208L-18 -208L-18 -132L6 -132Q57 -132 76 -108Q94 -84 94 -10L94 547ZM94 760L184 760L184 646L94 646L94 760Z"},
+	'k': {579, 91, 0, 576, 760, "M91 760L181 760L181 311L449 547L564 547L274 291L576 0L459 0L181 267L181 0L91 0L91 760Z"},
+	'l': {278, 94, 0, 184, 760, "M94 760L184 760L184 0L94 0L94 760Z"},
+	'm': {974, 91, 0, 889, 560, "M520 442Q554 502 601 531Q647 560 711 560Q796 560 843 500Q889 440 889 330L889 0L799 0L799 327Q799 406 771 444Q743 482 686 482Q616 482 576 436Q535 389 535 309L535 0L445 0L445 327Q445 406 417 444Q389 482 331 482Q262 482 222 435Q181 389 181 309L181 0L91 0L91 547L181 547L181 462Q212 512 255 536Q298 560 357 560Q417 560 458 530Q500 500 520 442Z"},
+	'n': {634, 91, 0, 549, 560, "M549 330L549 0L459 0L459 327Q459 405 429 443Q398 482 338 482Q265 482 223 436Q181 389 181 309L181 0L91 0L91 547L181 547L181 462Q213 511 257 536Q301 560 358 560Q452 560 500 502Q549 443 549 330Z"},
+	'o': {612, 55, -14, 557, 560, "M306 484Q234 484 192 427Q150 371 150 273Q150 175 192 118Q233 62 306 62Q378 62 420 119Q462 175 462 273Q462 370 420 427Q378 484 306 484ZM306 560Q423 560 490 484Q557 408 557 273Q557 139 490 62Q423 -14 306 -14Q188 -14 122 62Q55 139 55 273Q55 408 122 484Q188 560 306 560Z"},
+	'p': {635, 91, -208, 580, 560, "M181 82L181 -208L91 -208L91 547L181 547L181 464Q209 513 253 536Q296 560 356 560Q456 560 518 481Q580 402 580 273Q580 144 518 65Q456 -14 356 -14Q296 -14 253 10Q209 33 181 82ZM487 273Q487 372 446 428Q405 485 334 485Q263 485 222 428Q181 372 181 273Q181 174 222 117Q263 61 334 61Q405 61 446 117Q487 174 487 273Z"},
+	'q': {635, 55, -208, 544, 560, "M148 273Q148 174 189 117Q229 61 301 61Q372 61 413 117Q454 174 454 273Q454 372 413 428Q372 485 301 485Q229 485 189 428Q148 372 148 273ZM454 82Q426 33 383 10Q339 -14 279 -14Q180 -14 117 65Q55 144 55 273Q55 402 117 481Q180 560 279 560Q339 560 383 536Q426 513 454 464L454 547L544 547L544 -208L454 -208L454 82Z"},
+	'r': {411, 91, 0, 411, 560, "M411 463Q396 472 378 476Q360 480 339 480Q263 480 222 430Q181 381 181 288L181 0L91 0L91 547L181 547L181 462Q209 512 255 536Q300 560 365 560Q375 560 386 559Q397 558 411 555L411 463Z"},
+	's': {521, 54, -14, 472, 560, "M443 531L443 446Q405 465 364 475Q323 485 279 485Q212 485 178 464Q145 444 145 403Q145 372 169 354Q193 336 265 320L296 313Q392 292 432 255Q472 218 472 151Q472 75 412 30Q352 -14 246 -14Q202 -14 155 -6Q107 3 54 20L54 113Q104 87 152 74Q201 61 248 61Q312 61 346 83Q380 104 380 144Q380 181 355 200Q331 220 247 238L216 245Q132 263 95 299Q58 335 58 399Q58 476 113 518Q167 560 268 560Q318 560 362 553Q406 545 443 531Z"},
+	't': {392, 27, 0, 368, 702, "M183 702L183 547L368 547L368 477L183 477L183 180Q183 113 201 94Q220 75 276 75L368 75L368 0L276 0Q172 0 132 39Q93 78 93 180L93 477L27 477L27 547L93 547L93 702L183 702Z"},
+	'u': {634, 85, -14, 543, 560, "M85 216L85 547L175 547L175 219Q175 142 205 103Q235 64 296 64Q369 64 411 110Q453 157 453 237L453 547L543 547L543 0L453 0L453 84Q420 34 377 10Q334 -14 277 -14Q183 -14 134 44Q85 103 85 216ZM311 560L311 560Z"},
+	'v': {592, 30, 0, 562, 547, "M30 547L125 547L296 88L467 547L562 547L357 0L235 0L30 547Z"},
+	'w': {818, 42, 0, 776, 547, "M42 547L132 547L244 120L356 547L462 547L574 120L686 547L776 547L633 0L527 0L409 448L291 0L185 0L42 547Z"},
+	'x': {592, 29, 0, 559, 547, "M549 547L351 281L559 0L453 0L294 215L135 0L29 0L241 286L47 547L153 547L298 352L443 547L549 547Z"},
+	'y': {592, 30, -208, 562, 547, "M322 -51Q284 -148 248 -178Q211 -208 151 -208L79 -208L79 -133L132 -133Q169 -133 189 -115Q210 -98 235 -32L251 9L30 547L125 547L296 119L467 547L562 547L322 -51Z"},
+	'z': {525, 43, 0, 482, 547, "M55 547L482 547L482 465L144 72L482 72L482 0L43 0L43 82L381 475L55 475L55 547Z"},
+	'ɛ': {541, 65, -14, 473, 561, "M193 295Q138 307 109 338Q80 370 80 415Q80 484 135 522Q190 561 286 561Q323 561 365 555Q406 549 454 537L454 456Q407 470 368 476Q328 482 293 482Q235 482 202 460Q167 438 167 406Q167 372 201 350Q233 329 290 329L366 329L366 254L293 254Q227 254 191 229Q153 202 153 159Q153 115 194 88Q236 61 310 61Q355 61 396 69Q438 79 473 97L473 13Q429 0 388 -7Q346 -14 307 -14Q191 -14 128 30Q65 75 65 159Q65 212 99 248Q132 283 193 295Z"},
+	'Α': {684, 8, 0, 676, 729, "M342 632L208 269L476 269L342 632ZM286 729L398 729L676 0L573 0L507 187L178 187L112 0L8 0L286 729Z"},
+	'Β': {686, 98, 0, 615, 729, "M197 348L197 81L355 81Q435 81 473 114Q511 147 511 215Q511 283 473 316Q435 348 355 348L197 348ZM197 648L197 428L343 428Q415 428 450 455Q486 482 486 538Q486 593 450 621Q415 648 343 648L197 648ZM98 729L350 729Q463 729 524 682Q585 635 585 549Q585 482 554 442Q522 403 462 393Q535 377 575 328Q615 278 615 204Q615 106 549 53Q482 0 360 0L98 0L98 729Z"},
+	'Γ': {557, 98, 0, 552, 729, "M98 0L98 729L552 729L552 646L197 646L197 0L98 0Z"},
+	'Δ': {684, 8, 0, 676, 729, "M342 632L142 82L542 82L342 632ZM8 0L286 729L398 729L676 0L8 0Z"},
+	'Ε': {632, 98, 0, 568, 729, "M98 729L559 729L559 646L197 646L197 430L544 430L544 347L197 347L197 83L568 83L568 0L98 0L98 729Z"},
+	'Ζ': {685, 45, 0, 640, 729, "M56 729L629 729L629 654L168 83L640 83L640 0L45 0L45 75L506 646L56 646L56 729Z"},
+	'Η': {752, 98, 0, 654, 729, "M98 729L197 729L197 430L555 430L555 729L654 729L654 0L555 0L555 347L197 347L197 0L98 0L98 729Z"},
+	'Θ': {787, 56, -14, 731, 742, "M221 430L566 430L566 347L221 347L221 430ZM394 662Q287 662 224 582Q160 502 160 364Q160 226 223 146Q287 66 394 66Q501 66 564 146Q627 226 627 364Q627 502 564 582Q501 662 394 662ZM394 742Q547 742 639 639Q731 537 731 364Q731 191 639 89Q547 -14 394 -14Q240 -14 148 88Q56 191 56 364Q56 537 148 640Q240 742 394 742Z"},
+	'Ι': {295, 98, 0, 197, 729, "M98 729L197 729L197 0L98 0L98 729Z"},
+	'Κ': {656, 98, 0, 677, 729, "M98 729L197 729L197 421L524 729L651 729L289 389L677 0L547 0L197 351L197 0L98 0L98 729Z"},
+	'Λ': {684, 8, 0, 676, 729, "M112 0L8 0L286 729L398 729L676 0L573 0L342 632L112 0Z"},
+	'Μ': {863, 98, 0, 765, 729, "M98 729L245 729L431 233L618 729L765 729L765 0L669 0L669 640L481 140L382 140L194 640L194 0L98 0L98 729Z"},
+	'Ν': {748, 98, 0, 650, 729, "M98 729L231 729L554 119L554 729L650 729L650 0L517 0L194 610L194 0L98 0L98 729Z"},
+	'Ξ': {632, 98, 0, 548, 729, "M149 430L497 430L497 347L149 347L149 430ZM98 729L548 729L548 646L98 646L98 729ZM98 83L548 83L548 0L98 0L98 83Z"},
+	'Ο': {787, 56, -14, 731, 742, "M394 662Q287 662 223 582Q160 502 160 364Q160 226 223 146Q287 66 394 66Q501 66 564 146Q627 226 627 364Q627 502 564 582Q501 662 394 662ZM394 742Q547 742 639 639Q731 537 731 364Q731 191 639 89Q547 -14 394 -14Q240 -14 148 88Q56 191 56 364Q56 537 148 639Q240 742 394 742Z"},
+	'Π': {752, 98, 0, 654, 729, "M654 729L654 0L555 0L555 646L197 646L197 0L98 0L98 729L654 729Z"},
+	'Ρ': {603, 98, 0, 569, 729, "M197 648L197 374L321 374Q390 374 427 410Q465 445 465 511Q465 577 427 612Q390 648 321 648L197 648ZM98 729L321 729Q443 729 506 674Q569 618 569 511Q569 403 506 348Q443 293 321 293L197 293L197 0L98 0L98 729Z"},
+	'Σ': {632, 98, 0, 568, 729, "M211 83L568 83L568 0L98 0L98 83L332 388L98 646L98 729L559 729L559 646L211 646L445 390L211 83Z"},
+	'Τ': {611, -3, 0, 614, 729, "M-3 729L614 729L614 646L355 646L355 0L256 0L256 646L-3 646L-3 729Z"},
+	'Υ': {611, -2, 0, 613, 729, "M-2 729L104 729L306 429L507 729L613 729L355 347L355 0L256 0L256 347L-2 729Z"},
+	'Φ': {787, 56, 0, 731, 729, "M345 569Q271 559 224 517Q160 460 160 364Q160 268 224 211Q271 169 345 159L345 569ZM443 159Q517 169 564 211Q627 268 627 364Q627 460 564 517Q517 559 443 569L443 159ZM345 77Q226 88 148 153Q56 231 56 364Q56 496 148 575Q225 641 345 651L345 729L443 729L443 651Q563 640 639 575Q731 496 731 364Q731 232 639 153Q563 88 443 77L443 0L345 0L345 77Z"},
+	'Χ': {685, 30, 0, 654, 729, "M63 729L169 729L350 458L532 729L638 729L404 379L654 0L548 0L343 310L136 0L30 0L290 389L63 729Z"},
+	'Ψ': {787, 56, 0, 732, 729, "M345 0Q345 65 345 151Q240 151 148 254Q59 354 56 529L56 729L160 729L160 529Q160 392 224 312Q277 243 345 236L345 729L443 729L443 236Q511 243 564 312Q628 392 628 529L628 729L732 729L732 529Q729 354 640 254Q548 151 443 151Q443 116 443 0L345 0Z"},
+	'Ω': {764, 38, 0, 726, 738, "M726 87L726 0L433 0L433 87Q520 134 568 215Q616 296 616 395Q616 512 552 583Q487 654 382 654Q276 654 212 583Q147 512 147 395Q147 296 195 215Q244 134 331 87L331 0L38 0L38 87L194 87Q117 155 81 228Q46 300 46 390Q46 545 140 642Q233 738 382 738Q530 738 624 642Q718 546 718 395Q718 300 683 228Q648 156 570 87L726 87Z"},
+	'Ϊ': {295, 3, 0, 293, 913, "M194 913L293 913L293 814L194 814L194 913ZM3 913L102 913L102 814L3 814L3 913ZM98 729L197 729L197 0L98 0L98 729Z"},
+	'Ϋ': {611, -2, 0, 613, 913, "M351 913L450 913L450 814L351 814L351 913ZM160 913L259 913L259 814L160 814L160 913ZM-2 729L104 729L306 429L507 729L613 729L355 347L355 0L256 0L256 347L-2 729Z"},
+	'ά': {659, 55, -12, 611, 800, "M413 254L392 367Q370 483 283 483Q217 483 188 429Q150 362 150 273Q150 166 187 114Q224 62 283 62Q348 62 383 166L413 254ZM463 404L512 547L592 547L492 250L511 144Q516 120 533 100Q553 76 568 76L611 76L611 0L558 0Q512 0 471 41Q451 62 442 104Q420 50 374 8Q353 -12 283 -12Q168 -12 112 62Q55 139 55 273Q55 417 117 484Q186 559 283 559Q435 559 463 404ZM372 800L469 800L310 616L235 616L372 800Z"},
+	'έ': {541, 65, -14, 473, 800, "M357 800L454 800L295 616L220 616L357 800ZM193 295Q138 307 109 338Q80 370 80 415Q80 484 135 522Q190 561 286 561Q323 561 365 555Q406 549 454 537L454 456Q407 470 368 476Q328 482 293 482Q235 482 202 460Q167 438 167 406Q167 372 201 350Q233 329 290 329L366 329L366 254L293 254Q227 254 191 229Q153 202 153 159Q153 115 194 88Q236 61 310 61Q355 61 396 69Q438 79 473 97L473 13Q429 0 388 -7Q346 -14 307 -14Q191 -14 128 30Q65 75 65 159Q65 212 99 248Q132 283 193 295Z"},
+	'ή': {634, 91, -208, 549, 800, "M415 800L512 800L353 616L278 616L415 800ZM549 330L549 -208L459 -208L459 327Q459 405 429 443Q398 482 338 482Q265 482 223 436Q181 389 181 309L181 0L91 0L91 547L181 547L181 462Q213 511 257 536Q301 560 358 560Q452 560 500 502Q549 443 549 330Z"},
+	'ί': {338, 81, 0, 324, 800, "M173 547L173 193Q173 122 190 100Q208 76 260 76L304 76L304 0L250 0Q162 0 122 47Q82 95 82 198L81 547L173 547ZM227 800L324 800L165 616L90 616L227 800Z"},
+	'ΰ': {579, 73, -15, 521, 978, "M165 547L165 193Q165 127 189 96Q216 62 269 62Q342 62 393 164Q422 221 429 313Q433 362 418 425Q405 483 353 547L444 547L444 547Q478 503 500 443Q521 387 521 312Q521 188 458 97Q382 -15 258 -14Q170 -14 121 44Q73 102 73 198L73 547L165 547ZM309 758L408 758L408 659L309 659L309 758ZM118 758L217 758L217 659L118 659L118 758ZM331 978L428 978L269 794L194 794L331 978Z"},
+	'α': {659, 55, -12, 611, 559, "M413 254L392 367Q370 483 283 483Q217 483 188 429Q150 362 150 273Q150 166 187 114Q224 62 283 62Q348 62 383 166L413 254ZM463 404L512 547L592 547L492 250L511 144Q516 120 533 100Q553 76 568 76L611 76L611 0L558 0Q512 0 471 41Q451 62 442 104Q420 50 374 8Q353 -12 283 -12Q168 -12 112 62Q55 139 55 273Q55 417 117 484Q186 559 283 559Q435 559 463 404Z"},
+	'β': {638, 94, -208, 566, 766, "M184 34L184 -208L94 -208L94 543Q94 766 302 766Q514 766 514 578Q514 444 430 396Q566 352 566 221Q566 -10 331 -10Q228 -10 184 34ZM184 151Q238 66 334 66Q475 66 475 220Q475 357 249 347L249 430Q426 430 426 586Q426 693 312 693Q184 693 184 541L184 151Z"},
+	'γ': {592, 16, -208, 562, 547, "M176 461L296 123L467 547L562 547L341 0L341 -208L251 -208L251 0L107 408Q86 470 40 470L16 470L16 547L50 547Q146 547 176 461Z"},
+	'δ': {612, 55, -14, 557, 742, "M490 633Q440 667 324 667Q200 667 200 610Q200 565 329 542Q431 524 488 469Q557 403 557 268Q557 139 490 63Q423 -14 306 -14Q189 -14 122 63Q55 139 55 273Q55 373 122 453Q148 484 185 502Q108 541 108 609Q108 742 324 742Q432 742 490 708L490 633ZM252 478Q219 463 192 428Q150 373 150 273Q150 174 191 118Q233 62 306 62Q378 62 420 119Q462 175 462 268Q462 365 417 412Q370 462 309 468Q277 471 252 478Z"},
+	'ε': {541, 65, -14, 473, 561, "M193 295Q138 307 109 338Q80 370 80 415Q80 484 135 522Q190 561 286 561Q323 561 365 555Q406 549 454 537L454 456Q407 470 368 476Q328 482 293 482Q235 482 202 460Q167 438 167 406Q167 372 201 350Q233 329 290 329L366 329L366 254L293 254Q227 254 191 229Q153 202 153 159Q153 115 194 88Q236 61 310 61Q355 61 396 69Q438 79 473 97L473 13Q429 0 388 -7Q346 -14 307 -14Q191 -14 128 30Q65 75 65 159Q65 212 99 248Q132 283 193 295Z"},
+	'ζ': {544, 52, -210, 496, 760, "M349 62Q413 62 452 25Q493 -14 493 -72Q493 -128 457 -167Q417 -210 338 -210Q338 -173 338 -136Q372 -139 392 -117Q408 -100 408 -82Q408 -56 392 -35Q377 -14 349 -14Q53 -14 52 261Q52 501 331 669L71 669L71 760L496 760L496 669Q143 492 143 261Q143 62 349 62Z"},
+	'η': {634, 91, -208, 549, 560, "M549 330L549 -208L459 -208L459 327Q459 405 429 443Q398 482 338 482Q265 482 223 436Q181 389 181 309L181 0L91 0L91 547L181 547L181 462Q213 511 257 536Q301 560 358 560Q452 560 500 502Q549 443 549 330Z"},
+	'θ': {612, 55, -11, 557, 768, "M461 347L150 347Q158 201 191 139Q233 63 306 63Q379 63 420 139Q456 207 461 347ZM460 430Q446 568 420 617Q378 694 306 694Q231 694 192 618Q161 557 151 430L460 430ZM306 768Q423 768 490 664Q557 561 557 378Q557 196 490 93Q423 -11 306 -11Q188 -11 122 93Q55 196 55 378Q55 561 122 664Q188 768 306 768Z"},
+	'ι': {338, 81, 0, 304, 547, "M173 547L173 193Q173 122 190 100Q208 76 260 76L304 76L304 0L250 0Q162 0 122 47Q82 95 82 198L81 547L173 547Z"},
+	'κ': {589, 93, 0, 565, 547, "M93 547L186 547L186 320L422 547L531 547L316 341L565 0L455 0L253 282L186 219L186 0L93 0L93 547Z"},
+	'λ': {592, 30, 0, 562, 760, "M310 674L562 0L467 0L313 404L125 0L30 0L270 524L233 621Q210 683 158 683L111 683L111 760L168 759Q279 757 310 674Z"},
+	'μ': {636, 85, -208, 612, 547, "M85 -208L85 547L175 547L175 207Q175 136 208 100Q242 64 308 64Q380 64 417 105Q453 146 453 228L453 547L543 547L543 126Q543 97 552 83Q560 69 578 69Q583 69 590 72Q598 74 612 80L612 8Q592 -3 574 -9Q556 -14 539 -14Q505 -14 485 5Q465 24 458 63Q434 24 398 5Q363 -14 315 -14Q265 -14 230 5Q195 24 175 62L175 -208L85 -208Z"},
+	'ν': {559, 36, 0, 512, 547, "M203 0L36 547L133 547L274 88Q333 148 381 232Q418 295 420 350Q421 376 410 425Q396 483 344 547L435 547L435 547Q469 503 491 443Q512 387 512 349Q512 253 445 168Q359 57 299 0L203 0Z"},
+	'ξ': {558, 52, -210, 500, 760, "M356 62Q421 62 459 25Q500 -14 500 -72Q500 -128 464 -167Q425 -210 346 -210Q346 -173 346 -136Q379 -139 400 -117Q416 -100 416 -82Q416 -56 400 -35Q385 -14 356 -14Q52 -14 52 199Q52 346 215 389Q78 406 78 533Q78 629 186 669L84 669L84 760L469 760L469 669Q162 669 162 527Q162 434 420 430L420 347Q142 357 143 199Q143 67 356 62Z"},
+	'ο': {612, 55, -14, 557, 560, "M306 484Q234 484 192 427Q150 371 150 273Q150 175 192 118Q233 62 306 62Q378 62 420 119Q462 175 462 273Q462 370 420 427Q378 484 306 484ZM306 560Q423 560 490 484Q557 408 557 273Q557 139 490 62Q423 -14 306 -14Q188 -14 122 62Q55 139 55 273Q55 408 122 484Q188 560 306 560Z"},
+	'π': {602, 36, -19, 574, 547, "M36 547L560 547L560 457L491 457L491 121Q491 86 503 71Q515 55 542 55Q549 55 560 56Q571 58 574 58L574 -7Q557 -13 539 -16Q521 -19 503 -19Q444 -19 422 13Q399 45 399 131L399 457L198 457L198 0L106 0L106 457L36 457L36 547Z"},
+	'ρ': {635, 91, -208, 580, 560, "M135 449Q165 499 238 543Q267 560 356 560Q456 560 518 481Q580 402 580 273Q580 144 518 65Q456 -14 356 -14Q296 -14 253 10Q209 33 181 82L181 -208L91 -208L91 268Q91 381 135 449ZM487 273Q487 372 446 428Q405 485 334 485Q263 485 222 428Q181 372 181 273Q181 174 222 117Q263 61 334 61Q405 61 446 117Q487 174 487 273Z"},
+	'ς': {587, 55, -210, 488, 560, "M332 -14Q201 -14 128 63Q55 141 55 273Q55 407 129 483Q202 560 330 560Q372 560 411 552Q451 543 488 526L488 442Q450 463 411 473Q373 484 334 484Q247 484 198 428Q150 373 150 273Q150 173 198 117Q247 62 332 62Q396 62 435 25Q476 -14 476 -72Q476 -128 440 -167Q401 -210 321 -210Q321 -173 321 -136Q355 -139 375 -117Q391 -100 391 -82Q391 -56 375 -35Q360 -14 332 -14Z"},
+	'σ': {634, 55, -14, 604, 547, "M306 476Q232 476 192 422Q150 366 150 273Q150 175 192 118Q233 62 306 62Q378 62 420 119Q462 175 462 273Q462 362 420 422Q381 476 306 476ZM306 547L604 547L604 457L504 457Q557 381 557 273Q557 139 490 63Q423 -14 306 -14Q188 -14 122 63Q55 139 55 273Q55 408 122 484Q177 547 306 547Z"},
+	'τ': {602, 49, 0, 553, 547, "M362 100Q380 76 433 76L476 76L476 0L422 0Q334 0 294 47Q254 95 254 198L254 457L49 457L49 547L553 547L553 457L346 457L346 193Q346 122 362 100Z"},
+	'υ': {579, 73, -15, 521, 547, "M165 547L165 193Q165 127 189 96Q216 62 269 62Q342 62 393 164Q422 221 429 313Q433 362 418 425Q405 483 353 547L444 547L444 547Q478 503 500 443Q521 387 521 312Q521 188 458 97Q382 -15 258 -14Q170 -14 121 44Q73 102 73 198L73 547L165 547Z"},
+	'φ': {660, 55, -208, 602, 551, "M405 474Q373 474 373 403L373 68Q419 68 466 119Q507 165 507 273Q507 375 465 430Q431 474 405 474ZM405 551Q473 551 535 486Q602 417 602 273Q602 141 535 63Q472 -12 373 -12L373 -208L284 -208L284 -12Q187 -12 121 63Q55 139 55 273Q55 413 121 484Q171 537 252 551L252 471Q220 460 191 422Q149 366 149 273Q149 174 191 118Q229 68 284 68L284 403Q284 551 405 551Z"},
+	'χ': {578, 29, -208, 549, 547, "M357 -122L285 65L135 -208L29 -208L241 178L152 408Q128 470 53 470L29 470L29 547L63 546Q189 542 221 461L293 274L443 547L549 547L337 161L426 -69Q450 -131 525 -131L549 -131L549 -208L515 -207Q389 -204 357 -122Z"},
+	'ψ': {660, 55, -208, 602, 547, "M284 -12Q171 6 119 53Q55 111 55 230L55 547L146 547L146 233Q146 144 187 107Q223 75 284 68L284 547L373 547L373 68Q437 75 470 107Q511 148 511 234L511 547L602 547L602 230Q602 107 538 54Q480 5 373 -12L373 -208L284 -208L284 -12Z"},
+	'ω': {837, 66, -14, 769, 547, "M269 -14Q66 -14 66 276Q66 391 142 547L238 547Q168 391 168 273Q168 62 277 62Q376 62 376 325L459 325Q459 62 558 62Q667 62 667 273Q667 391 597 547L693 547Q769 391 769 276Q769 -14 566 -14Q434 -14 417 132Q397 -14 269 -14Z"},
+	'ϑ': {619, 55, -11, 557, 768, "M460 430Q460 550 436 611Q404 694 331 694Q291 694 261 661Q232 627 232 583Q232 500 291 457Q327 430 460 430ZM461 347Q311 347 247 378Q144 430 144 581Q144 659 195 713Q246 768 331 768Q438 768 497 667Q557 566 557 378Q557 189 488 89Q418 -11 302 -11Q186 -11 120 96Q55 203 55 430L147 430L147 352Q147 220 190 141Q233 63 307 63Q381 63 421 141Q461 220 461 347Z"},
+	'ϕ': {660, 55, -208, 602, 760, "M373 760L373 558Q470 558 535 483Q602 407 602 273Q602 139 535 63Q470 -12 373 -12L373 -208L284 -208L284 -12Q187 -12 121 63Q55 139 55 273Q55 407 121 483Q187 558 284 558L284 760L373 760ZM373 68Q428 68 466 118Q507 174 507 273Q508 371 466 428Q428 478 373 478L373 68ZM284 68L284 478Q229 478 191 428Q149 371 149 273Q150 174 191 118Q229 68 284 68Z"},
+	'ϵ': {615, 55, -14, 480, 560, "M480 460Q462 466 444 471Q396 484 344 484Q255 484 205 436Q159 391 150 308L480 308L480 238L150 238Q159 155 205 110Q255 62 344 62Q407 62 444 75L480 87L480 5L442 -3Q391 -14 339 -14Q208 -14 132 62Q55 138 55 273Q55 408 132 484Q208 560 339 560Q388 560 442 549Q461 545 480 540L480 460Z"},
+}
+
+// monoGlyphs are the glyphs of DejaVu Sans Mono.
+var monoGlyphs = map[rune]*glyph{
+	' ':  {602, 0, 0, 0, 0, ""},
+	'!':  {602, 252, 0, 351, 729, "M252 729L351 729L351 409L341 235L262 235L252 409L252 729ZM252 124L351 124L351 0L252 0L252 124Z"},
+	'"':  {602, 165, 458, 437, 729, "M437 729L437 458L352 458L352 729L437 729ZM250 729L250 458L165 458L165 729L250 729Z"},
+	'#':  {602, 1, 0, 600, 718, "M334 718L283 515L403 515L454 718L532 718L481 515L600 515L600 440L463 440L422 277L544 277L544 202L403 202L352 0L274 0L325 202L205 202L154 0L76 0L127 202L1 202L1 277L146 277L187 440L57 440L57 515L205 515L256 718L334 718ZM385 440L265 440L224 277L344 277L385 440Z"},
+	'$':  {602, 93, -147, 544, 760, "M338 283L338 71Q392 73 422 101Q452 129 452 178Q452 224 425 249Q397 274 338 283ZM289 376L289 578Q238 576 210 548Q181 521 181 475Q181 433 208 409Q234 384 289 376ZM338 -147L289 -147L289 0Q239 2 190 14Q141 25 93 45L93 133Q142 103 191 86Q241 70 289 69L289 293Q191 308 142 353Q93 397 93 470Q93 547 144 593Q196 638 289 645L289 760L338 760L338 645Q377 643 417 635Q456 628 497 615L497 531Q456 552 416 563Q377 575 338 577L338 366Q438 351 491 304Q544 256 544 181Q544 106 487 56Q430 5 338 1L338 -147Z"},
+	'%':  {602, 16, 0, 586, 699, "M340 156Q340 118 365 92Q391 66 429 66Q467 66 493 92Q519 118 519 156Q519 193 493 220Q466 246 429 246Q391 246 365 220Q340 194 340 156ZM274 156Q274 222 319 267Q364 312 429 312Q460 312 489 300Q517 289 539 266Q562 243 574 215Q586 187 586 156Q586 91 541 45Q495 0 429 0Q363 0 318 45Q274 89 274 156ZM59 227L42 274L551 478L571 431L59 227ZM82 543Q82 504 108 479Q133 453 172 453Q209 453 236 479Q262 505 262 543Q262 581 236 607Q209 633 172 633Q134 633 108 607Q82 581 82 543ZM16 543Q16 609 61 654Q106 699 172 699Q203 699 232 688Q260 676 282 654Q304 632 316 603Q328 575 328 543Q328 478 283 432Q237 387 172 387Q106 387 61 432Q16 477 16 543Z"},
+	'&':  {602, 28, -14, 596, 742, "M267 443L470 170Q489 194 499 230Q508 267 508 318Q508 333 506 368L506 371L586 371L586 352Q586 273 568 214Q550 155 513 112L596 0L492 0L454 53Q414 19 368 2Q323 -14 271 -14Q166 -14 97 52Q28 117 28 217Q28 284 62 341Q95 398 163 447Q139 482 127 516Q115 549 115 584Q115 657 164 700Q212 742 297 742Q329 742 361 736Q393 730 427 719L427 630Q398 649 367 658Q337 667 303 667Q256 667 229 645Q201 623 201 585Q201 556 215 525Q229 493 267 443ZM203 392Q158 356 136 317Q114 277 114 232Q114 158 163 110Q211 61 287 61Q308 61 330 67Q353 73 374 84Q387 91 396 97Q404 103 412 109L203 392Z"},
+	'\'': {602, 258, 458, 343, 729, "M343 729L343 458L258 458L258 729L343 729Z"},
+	'(':  {602, 208, -132, 432, 759, "M432 759Q367 647 335 537Q303 426 303 314Q303 202 335 91Q367 -20 432 -132L354 -132Q280 -16 244 94Q208 205 208 314Q208 423 244 533Q280 644 354 759L432 759Z"},
+	')':  {602, 170, -132, 394, 759, "M170 759L248 759Q322 644 358 533Q394 423 394 314Q394 204 358 94Q322 -17 248 -132L170 -132Q235 -19 267 92Q299 203 299 314Q299 425 267 536Q235 647 170 759Z"},
+	'*':  {602, 81, 286, 521, 742, "M521 609L346 514L521 419L493 371L329 470L329 286L273 286L273 470L109 371L81 419L256 514L81 609L109 657L273 558L273 742L329 742L329 558L493 657L521 609Z"},
+	'+':  {602, 43, 55, 559, 572, "M342 572L342 355L559 355L559 272L342 272L342 55L260 55L260 272L43 272L43 355L260 355L260 572L342 572Z"},
+	',':  {602, 197, -140, 368, 148, "M245 148L368 148L368 47L272 -140L197 -140L245 47L245 148Z"},
+	'-':  {602, 174, 234, 428, 314, "M174 314L428 314L428 234L174 234L174 314Z"},
+	'.':  {602, 239, 0, 362, 149, "M239 149L362 149L362 0L239 0L239 149Z"},
+	'/':  {602, 50, -93, 527, 729, "M434 729L527 729L143 -93L50 -93L434 729Z"},
+	'0':  {602, 65, -14, 537, 742, "M236 366Q236 393 255 413Q273 432 300 432Q327 432 347 413Q366 393 366 366Q366 339 347 320Q328 301 300 301Q272 301 254 319Q236 338 236 366ZM301 664Q232 664 198 590Q164 516 164 364Q164 212 198 138Q232 64 301 64Q370 64 404 138Q438 212 438 364Q438 516 404 590Q370 664 301 664ZM301 742Q417 742 477 646Q537 551 537 364Q537 177 477 82Q417 -14 301 -14Q184 -14 125 82Q65 177 65 364Q65 551 125 646Q184 742 301 742Z"},
+	'1':  {602, 120, 0, 534, 729, "M132 83L285 83L285 640L120 603L120 693L284 729L383 729L383 83L534 83L534 0L132 0L132 83Z"},
+	'2':  {602, 74, 0, 517, 742, "M182 83L517 83L517 0L74 0L74 83Q166 179 234 253Q302 327 328 357Q377 417 394 453Q411 490 411 529Q411 590 375 625Q339 659 277 659Q232 659 184 643Q135 627 80 594L80 694Q130 718 179 730Q228 742 275 742Q382 742 447 685Q512 628 512 536Q512 489 490 442Q469 396 420 339Q393 307 341 251Q289 195 182 83Z"},
+	'3':  {602, 67, -14, 527, 742, "M379 390Q451 371 489 323Q527 274 527 201Q527 101 459 43Q392 -14 272 -14Q222 -14 169 -5Q117 4 67 22L67 120Q117 94 165 82Q213 69 261 69Q342 69 386 105Q429 142 429 211Q429 274 386 312Q342 349 268 349L193 349L193 430L268 430Q336 430 374 460Q412 490 412 543Q412 599 377 629Q341 659 276 659Q232 659 186 649Q140 640 89 620L89 711Q148 727 194 734Q240 742 276 742Q382 742 446 689Q510 635 510 547Q510 487 476 447Q443 407 379 390Z"},
+	'4':  {602, 50, 0, 554, 729, "M359 639L129 254L359 254L359 639ZM343 729L457 729L457 254L554 254L554 174L457 174L457 0L359 0L359 174L50 174L50 267L343 729Z"},
+	'5':  {602, 70, -14, 522, 729, "M101 729L470 729L470 646L191 646L191 467Q212 475 233 478Q254 482 276 482Q389 482 456 415Q522 348 522 234Q522 119 452 52Q383 -14 262 -14Q204 -14 156 -6Q108 1 70 17L70 117Q115 93 160 81Q206 69 253 69Q334 69 379 112Q423 155 423 234Q423 312 377 355Q332 399 250 399Q210 399 173 390Q135 381 101 363L101 729Z"},
+	'6':  {602, 65, -14, 537, 742, "M484 713L484 622Q453 640 418 650Q384 659 346 659Q252 659 204 589Q156 518 156 381Q179 430 221 456Q262 482 316 482Q421 482 479 417Q537 353 537 234Q537 116 478 51Q418 -14 310 -14Q183 -14 124 77Q65 168 65 364Q65 548 136 645Q207 742 342 742Q378 742 414 735Q450 727 484 713ZM308 405Q245 405 209 359Q173 314 173 234Q173 154 209 108Q245 63 308 63Q374 63 407 106Q440 149 440 234Q440 319 407 362Q374 405 308 405Z"},
+	'7':  {602, 68, 0, 527, 729, "M68 729L527 729L527 687L266 0L163 0L417 646L68 646L68 729Z"},
+	'8':  {602, 64, -14, 538, 742, "M301 346Q235 346 199 309Q163 272 163 205Q163 138 199 100Q236 63 301 63Q367 63 403 100Q439 137 439 205Q439 272 403 309Q366 346 301 346ZM215 387Q152 403 116 447Q81 491 81 553Q81 640 140 691Q199 742 301 742Q403 742 462 691Q521 640 521 553Q521 491 486 447Q450 403 387 387Q460 371 499 322Q538 273 538 196Q538 97 475 42Q412 -14 301 -14Q189 -14 127 41Q64 97 64 195Q64 273 103 322Q142 371 215 387ZM179 544Q179 485 210 455Q242 424 301 424Q360 424 392 455Q423 485 423 544Q423 604 392 635Q361 666 301 666Q242 666 210 635Q179 603 179 544Z"},
+	'9':  {602, 62, -14, 534, 742, "M291 323Q354 323 390 369Q426 414 426 494Q426 574 390 620Q354 665 291 665Q226 665 192 622Q159 579 159 494Q159 409 192 366Q225 323 291 323ZM115 15L115 106Q146 88 181 78Q215 69 253 69Q347 69 395 139Q443 210 443 347Q420 298 378 272Q337 246 283 246Q178 246 120 311Q62 376 62 495Q62 613 121 677Q181 742 289 742Q416 742 475 651Q534 560 534 364Q534 180 463 83Q392 -14 257 -14Q221 -14 185 -7Q149 1 115 15Z"},
+	':':  {602, 239, 0, 362, 519, "M239 519L362 519L362 371L239 371L239 519ZM239 149L362 149L362 0L239 0L239 149Z"},
+	';':  {602, 197, -140, 368, 519, "M245 148L368 148L368 47L272 -140L197 -140L245 47L245 148ZM239 519L362 519L362 371L239 371L239 519Z"},
+	'<':  {602, 43, 69, 559, 558, "M559 469L144 313L559 158L559 69L43 273L43 354L559 558L559 469Z"},
+	'=':  {602, 43, 172, 559, 454, "M43 256L559 256L559 172L43 172L43 256ZM43 454L559 454L559 371L43 371L43 454Z"},
+	'>':  {602, 43, 69, 559, 558, "M43 469L43 558L559 354L559 273L43 69L43 158L458 313L43 469Z"},
+	'?':  {602, 119, 0, 508, 742, "M334 196L241 196L241 271Q241 319 256 352Q271 386 312 426L356 469Q386 498 398 520Q409 541 409 565Q409 608 377 635Q345 662 292 662Q254 662 210 645Q167 628 119 595L119 687Q165 715 212 729Q258 742 309 742Q400 742 454 695Q508 648 508 570Q508 533 491 501Q475 469 429 424L386 382Q353 350 343 329Q334 309 334 279L334 256L334 196ZM238 124L337 124L337 0L238 0L238 124Z"},
+	'@':  {602, 13, -156, 575, 681, "M507 266Q507 329 476 367Q444 405 392 405Q340 405 308 367Q277 329 277 266Q277 203 308 165Q340 127 392 127Q444 127 476 165Q507 203 507 266ZM575 66L505 66L505 120Q487 89 455 73Q423 56 383 56Q304 56 253 115Q201 174 201 266Q201 358 253 417Q304 476 383 476Q422 476 455 459Q487 442 505 412L505 443Q505 519 462 565Q419 612 348 612Q228 612 157 518Q86 425 86 265Q86 104 167 9Q247 -86 381 -86Q407 -86 434 -81Q460 -76 488 -66L511 -132Q480 -144 450 -150Q420 -156 392 -156Q218 -156 115 -42Q13 72 13 265Q13 456 105 568Q197 681 351 681Q453 681 514 616Q575 551 575 442L575 66Z"},
+	'A':  {602, 18, 0, 584, 729, "M301 642L197 269L405 269L301 642ZM241 729L361 729L584 0L482 0L428 190L173 190L120 0L18 0L241 729Z"},
+	'B':  {602, 81, 0, 555, 729, "M180 348L180 81L297 81Q383 81 419 111Q456 141 456 210Q456 281 417 315Q379 348 297 348L180 348ZM180 648L180 428L295 428Q366 428 398 456Q430 483 430 544Q430 599 399 624Q367 648 295 648L180 648ZM81 729L297 729Q409 729 469 681Q530 632 530 544Q530 477 498 438Q466 400 402 390Q474 379 514 329Q555 278 555 200Q555 101 490 51Q425 0 297 0L81 0L81 729Z"},
+	'C':  {602, 68, -14, 524, 742, "M524 26Q486 6 447 -4Q407 -14 363 -14Q223 -14 145 85Q68 184 68 364Q68 542 146 642Q224 742 363 742Q407 742 447 732Q486 722 524 702L524 601Q488 631 446 646Q405 662 363 662Q267 662 219 588Q171 514 171 364Q171 214 219 140Q267 66 363 66Q406 66 447 82Q488 97 524 127L524 26Z"},
+	'D':  {602, 67, 0, 540, 729, "M213 81Q337 81 387 142Q436 204 436 364Q436 525 387 587Q338 648 213 648L166 648L166 81L213 81ZM215 729Q382 729 461 640Q540 551 540 364Q540 177 461 89Q382 0 215 0L67 0L67 729L215 729Z"},
+	'E':  {602, 96, 0, 538, 729, "M96 729L529 729L529 646L195 646L195 430L514 430L514 347L195 347L195 83L538 83L538 0L96 0L96 729Z"},
+	'F':  {602, 114, 0, 543, 729, "M114 729L543 729L543 646L213 646L213 431L512 431L512 348L213 348L213 0L114 0L114 729Z"},
+	'G':  {602, 50, -14, 539, 742, "M539 60Q500 23 450 5Q400 -14 343 -14Q204 -14 127 85Q50 185 50 364Q50 542 128 642Q206 742 345 742Q391 742 433 729Q475 716 514 690L514 589Q475 626 433 644Q392 662 345 662Q249 662 201 588Q153 513 153 364Q153 212 199 139Q246 66 343 66Q375 66 400 73Q425 81 445 97L445 293L339 293L339 374L539 374L539 60Z"},
+	'H':  {602, 67, 0, 535, 729, "M67 729L166 729L166 430L436 430L436 729L535 729L535 0L436 0L436 347L166 347L166 0L67 0L67 729Z"},
+	'I':  {602, 98, 0, 503, 729, "M98 729L503 729L503 646L350 646L350 83L503 83L503 0L98 0L98 83L251 83L251 646L98 646L98 729Z"},
+	'J':  {602, 53, -14, 467, 729, "M53 30L53 145Q98 105 145 86Q192 66 243 66Q313 66 341 102Q368 139 368 238L368 646L182 646L182 729L467 729L467 238Q467 100 415 43Q364 -14 243 -14Q196 -14 150 -3Q104 7 53 30Z"},
+	'K':  {602, 67, 0, 598, 729, "M67 729L166 729L166 405L474 729L590 729L306 431L598 0L479 0L241 365L166 285L166 0L67 0L67 729Z"},
+	'L':  {602, 105, 0, 556, 729, "M105 729L204 729L204 83L556 83L556 0L105 0L105 729Z"},
+	'M':  {602, 42, 0, 559, 729, "M42 729L174 729L300 358L427 729L559 729L559 0L468 0L468 644L338 260L263 260L133 644L133 0L42 0L42 729Z"},
+	'N':  {602, 68, 0, 534, 729, "M68 729L193 729L439 129L439 729L534 729L534 0L409 0L163 600L163 0L68 0L68 729Z"},
+	'O':  {602, 57, -14, 545, 742, "M442 364Q442 524 409 593Q376 662 301 662Q226 662 193 593Q160 524 160 364Q160 204 193 135Q226 66 301 66Q376 66 409 135Q442 203 442 364ZM545 364Q545 173 485 80Q424 -14 301 -14Q177 -14 117 79Q57 172 57 364Q57 555 117 648Q178 742 301 742Q424 742 485 648Q545 555 545 364Z"},
+	'P':  {602, 96, 0, 557, 729, "M195 648L195 374L309 374Q377 374 416 410Q454 446 454 511Q454 576 416 612Q378 648 309 648L195 648ZM96 729L309 729Q431 729 494 674Q557 618 557 511Q557 403 494 348Q432 293 309 293L195 293L195 0L96 0L96 729Z"},
+	'Q':  {602, 57, -132, 545, 742, "M320 -13Q316 -13 310 -14Q304 -14 300 -14Q178 -14 117 80Q57 173 57 364Q57 555 117 648Q178 742 301 742Q424 742 485 648Q545 555 545 364Q545 220 511 133Q478 45 410 10L508 -83L434 -132L320 -13ZM442 364Q442 524 409 593Q376 662 301 662Q226 662 193 593Q160 524 160 364Q160 204 193 135Q226 66 301 66Q376 66 409 135Q442 203 442 364Z"},
+	'R':  {602, 70, 0, 602, 729, "M371 344Q409 334 436 307Q463 280 503 199L602 0L496 0L409 184Q372 263 342 285Q312 308 263 308L169 308L169 0L70 0L70 729L273 729Q393 729 457 675Q521 621 521 518Q521 446 482 400Q442 354 371 344ZM169 648L169 389L277 389Q348 389 382 421Q417 453 417 518Q417 581 380 615Q343 648 273 648L169 648Z"},
+	'S':  {602, 68, -14, 536, 742, "M494 704L494 604Q449 633 404 647Q359 662 313 662Q243 662 203 630Q162 597 162 542Q162 494 189 468Q215 443 288 426L340 414Q442 390 489 339Q536 288 536 199Q536 95 472 41Q407 -14 284 -14Q233 -14 181 -3Q129 8 77 30L77 135Q133 99 183 83Q233 66 284 66Q359 66 400 99Q442 133 442 193Q442 248 413 276Q385 305 314 321L261 333Q160 356 114 402Q68 449 68 527Q68 625 134 683Q199 742 308 742Q350 742 396 733Q443 723 494 704Z"},
+	'T':  {602, 23, 0, 579, 729, "M23 729L579 729L579 646L351 646L351 0L252 0L252 646L23 646L23 729Z"},
+	'U':  {602, 72, -14, 530, 729, "M72 280L72 729L171 729L171 235Q171 182 174 159Q177 136 184 124Q200 95 229 81Q259 66 301 66Q343 66 373 81Q402 95 418 124Q425 136 428 159Q431 181 431 234L431 729L530 729L530 280Q530 168 516 121Q502 74 468 43Q436 14 394 0Q353 -14 301 -14Q250 -14 208 0Q167 14 134 43Q100 73 86 121Q72 169 72 280Z"},
+	'V':  {602, 28, 0, 574, 729, "M301 83L472 729L574 729L361 0L241 0L28 729L130 729L301 83Z"},
+	'W':  {602, 0, 0, 602, 729, "M0 729L96 729L166 137L249 529L352 529L436 136L506 729L602 729L493 0L400 0L301 433L202 0L109 0L0 729Z"},
+	'X':  {602, 9, 0, 593, 729, "M42 729L148 729L308 454L471 729L577 729L359 386L593 0L487 0L308 314L115 0L9 0L253 386L42 729Z"},
+	'Y':  {602, 18, 0, 584, 729, "M18 729L123 729L301 407L478 729L584 729L350 327L350 0L251 0L251 327L18 729Z"},
+	'Z':  {602, 76, 0, 571, 729, "M87 729L560 729L560 654L179 83L571 83L571 0L76 0L76 75L447 646L87 646L87 729Z"},
+	'[':  {602, 226, -132, 433, 760, "M226 760L433 760L433 690L316 690L316 -62L433 -62L433 -132L226 -132L226 760Z"},
+	'\\': {602, 50, -93, 527, 729, "M143 729L527 -93L434 -93L50 729L143 729Z"},
+	']':  {602, 169, -132, 376, 760, "M376 760L376 -132L169 -132L169 -62L286 -62L286 690L169 690L169 760L376 760Z"},
+	'^':  {602, 35, 457, 567, 729, "M344 729L567 457L480 457L301 650L122 457L35 457L258 729L344 729Z"},
+	'_':  {602, 0, -236, 602, -197, "M602 -197L602 -236L0 -236L0 -197L602 -197Z"},
+	'`':  {602, 136, 616, 370, 800, "M233 800L370 616L295 616L136 800L233 800Z"},
+	'a':  {602, 65, -14, 517, 560, "M343 275L313 275Q234 275 195 247Q155 220 155 165Q155 116 185 88Q214 61 267 61Q341 61 384 113Q426 164 427 255L427 275L343 275ZM517 312L517 0L427 0L427 81Q398 32 354 9Q311 -14 248 -14Q165 -14 115 33Q65 80 65 159Q65 250 126 298Q188 345 306 345L427 345L427 359Q426 425 394 454Q361 484 289 484Q243 484 196 471Q149 458 105 432L105 522Q155 541 200 551Q246 560 289 560Q357 560 405 540Q453 520 483 480Q501 456 509 420Q517 384 517 312Z"},
+	'b':  {602, 94, -14, 543, 760, "M448 273Q448 377 415 431Q382 484 317 484Q251 484 218 430Q184 377 184 273Q184 169 218 116Q251 62 317 62Q382 62 415 115Q448 168 448 273ZM184 477Q206 517 243 539Q281 560 331 560Q430 560 486 484Q543 408 543 275Q543 140 486 63Q429 -14 330 -14Q281 -14 244 7Q207 28 184 69L184 0L94 0L94 760L184 760L184 477Z"},
+	'c':  {602, 95, -14, 518, 560, "M518 28Q482 7 444 -4Q405 -14 365 -14Q238 -14 167 62Q95 138 95 273Q95 408 167 484Q238 560 365 560Q405 560 442 550Q480 540 518 518L518 424Q482 456 447 470Q411 484 365 484Q281 484 235 429Q190 375 190 273Q190 172 236 117Q281 62 365 62Q412 62 449 76Q486 91 518 121L518 28Z"},
+	'd':  {602, 60, -14, 509, 760, "M419 477L419 760L509 760L509 0L419 0L419 69Q396 28 359 7Q322 -14 273 -14Q174 -14 117 63Q60 140 60 275Q60 408 117 484Q174 560 273 560Q322 560 360 539Q397 518 419 477ZM155 273Q155 168 188 115Q221 62 286 62Q351 62 385 116Q419 169 419 273Q419 377 385 430Q351 484 286 484Q221 484 188 431Q155 377 155 273Z"},
+	'e':  {602, 60, -14, 543, 560, "M543 296L543 252L154 252L154 249Q154 160 200 111Q247 62 332 62Q375 62 422 76Q469 89 522 117L522 28Q471 7 423 -4Q375 -14 331 -14Q204 -14 132 62Q60 139 60 273Q60 404 130 482Q201 560 318 560Q422 560 483 489Q543 418 543 296ZM453 322Q451 401 416 443Q380 484 314 484Q249 484 207 441Q165 398 157 322L453 322Z"},
+	'f':  {602, 95, 0, 519, 760, "M519 760L519 685L417 685Q369 685 350 665Q331 646 331 595L331 547L519 547L519 477L331 477L331 0L241 0L241 477L95 477L95 547L241 547L241 585Q241 675 282 717Q324 760 411 760L519 760Z"},
+	'g':  {602, 60, -215, 509, 560, "M419 278Q419 379 386 431Q353 484 290 484Q224 484 189 431Q155 379 155 278Q155 177 190 124Q225 71 291 71Q353 71 386 124Q419 177 419 278ZM509 35Q509 -88 451 -151Q393 -215 280 -215Q243 -215 202 -208Q162 -201 121 -188L121 -99Q169 -122 208 -132Q247 -143 280 -143Q353 -143 386 -104Q419 -64 419 22L419 26L419 87Q397 41 360 19Q323 -4 270 -4Q174 -4 117 73Q60 149 60 278Q60 407 117 483Q174 560 270 560Q323 560 359 539Q396 518 419 474L419 545L509 545L509 35Z"},
+	'h':  {602, 95, 0, 513, 760, "M513 339L513 0L423 0L423 339Q423 413 397 447Q371 482 316 482Q253 482 219 437Q185 393 185 309L185 0L95 0L95 760L185 760L185 465Q209 512 250 536Q291 560 347 560Q431 560 472 505Q513 450 513 339Z"},
+	'i':  {602, 87, 0, 533, 760, "M125 547L355 547L355 70L533 70L533 0L87 0L87 70L265 70L265 477L125 477L125 547ZM265 760L355 760L355 646L265 646L265 760Z"},
+	'j':  {602, 91, -208, 383, 760, "M293 -10L293 477L138 477L138 547L383 547L383 -10Q383 -105 339 -156Q295 -208 215 -208L91 -208L91 -132L205 -132Q249 -132 271 -101Q293 -71 293 -10ZM293 760L383 760L383 646L293 646L293 760Z"},
+	'k':  {602, 115, 0, 587, 760, "M115 760L208 760L208 320L444 547L553 547L338 341L587 0L477 0L275 282L208 219L208 0L115 0L115 760Z"},
+	'l':  {602, 78, 0, 505, 765, "M312 198Q312 138 334 107Q356 76 400 76L505 76L505 0L391 0Q311 0 266 52Q222 104 222 198L222 695L78 695L78 765L312 765L312 198Z"},
+	'm':  {602, 53, 0, 554, 560, "M330 491Q347 526 372 543Q398 560 434 560Q500 560 527 509Q554 458 554 317L554 0L472 0L472 313Q472 429 459 457Q446 485 412 485Q373 485 359 455Q344 425 344 313L344 0L262 0L262 313Q262 430 248 458Q234 485 198 485Q163 485 149 455Q135 425 135 313L135 0L53 0L53 547L135 547L135 500Q151 529 175 545Q199 560 230 560Q267 560 292 543Q316 526 330 491Z"},
+	'n':  {602, 95, 0, 513, 560, "M513 339L513 0L423 0L423 339Q423 413 397 447Q371 482 316 482Q253 482 219 437Q185 393 185 309L185 0L95 0L95 547L185 547L185 465Q209 512 250 536Q291 560 347 560Q431 560 472 505Q513 450 513 339Z"},
+	'o':  {602, 67, -14, 535, 560, "M301 484Q232 484 197 431Q162 377 162 273Q162 169 197 115Q232 62 301 62Q370 62 405 115Q440 169 440 273Q440 377 405 431Q370 484 301 484ZM301 560Q415 560 475 486Q535 413 535 273Q535 133 475 59Q415 -14 301 -14Q187 -14 127 59Q67 133 67 273Q67 413 127 486Q187 560 301 560Z"},
+	'p':  {602, 93, -208, 541, 560, "M183 69L183 -208L93 -208L93 547L183 547L183 477Q206 518 243 539Q280 560 329 560Q428 560 485 483Q541 407 541 271Q541 138 484 62Q428 -14 329 -14Q279 -14 242 7Q205 28 183 69ZM447 273Q447 377 414 431Q381 484 316 484Q250 484 217 430Q183 377 183 273Q183 169 217 116Q250 62 316 62Q381 62 414 115Q447 168 447 273Z"},
+	'q':  {602, 67, -210, 515, 558, "M162 271Q162 167 195 113Q228 60 293 60Q358 60 391 114Q425 167 425 271Q425 375 391 428Q358 482 293 482Q228 482 195 429Q162 375 162 271ZM425 68Q403 27 365 6Q328 -16 279 -16Q181 -16 124 60Q67 136 67 269Q67 405 124 481Q180 558 279 558Q328 558 365 537Q402 516 425 475L425 545L515 545L515 -210L425 -210L425 68Z"},
+	'r':  {602, 177, 0, 564, 560, "M564 434Q535 457 505 467Q476 477 440 477Q356 477 312 424Q267 372 267 272L267 0L177 0L177 547L267 547L267 440Q290 498 336 529Q383 560 447 560Q480 560 509 552Q538 543 564 526L564 434Z"},
+	's':  {602, 104, -14, 503, 560, "M475 528L475 440Q437 462 397 474Q358 485 318 485Q257 485 227 465Q197 445 197 405Q197 368 219 350Q242 332 331 315L367 308Q434 295 469 257Q503 219 503 158Q503 77 445 31Q388 -14 285 -14Q245 -14 200 -6Q156 3 104 20L104 113Q154 87 200 74Q246 61 287 61Q347 61 379 85Q412 109 412 153Q412 215 292 239L289 240L255 247Q177 262 142 298Q106 334 106 396Q106 475 159 517Q212 560 311 560Q355 560 396 552Q436 544 475 528Z"},
+	't':  {602, 64, 0, 504, 702, "M300 702L300 547L504 547L504 477L300 477L300 180Q300 120 323 96Q346 72 403 72L504 72L504 0L394 0Q293 0 251 41Q210 81 210 180L210 477L64 477L64 547L210 547L210 702L300 702Z"},
+	'u':  {602, 95, -14, 513, 546, "M95 207L95 546L185 546L185 207Q185 133 211 99Q237 64 292 64Q355 64 389 109Q423 153 423 237L423 546L513 546L513 0L423 0L423 82Q399 35 358 10Q316 -14 261 -14Q177 -14 136 41Q95 96 95 207Z"},
+	'v':  {602, 49, 0, 553, 547, "M49 547L142 547L301 88L460 547L553 547L359 0L243 0L49 547Z"},
+	'w':  {602, 0, 0, 602, 547, "M0 547L89 547L184 105L262 387L339 387L418 105L513 547L602 547L474 0L388 0L301 300L214 0L128 0L0 547Z"},
+	'x':  {602, 37, 0, 565, 547, "M546 547L350 285L565 0L461 0L301 219L141 0L37 0L252 285L56 547L156 547L301 349L445 547L546 547Z"},
+	'y':  {602, 51, -208, 563, 547, "M419 176Q396 119 362 25Q313 -104 297 -132Q274 -170 241 -189Q207 -208 162 -208L90 -208L90 -133L143 -133Q183 -133 205 -110Q228 -87 262 9L51 547L146 547L308 119L468 547L563 547L419 176Z"},
+	'z':  {602, 99, 0, 508, 548, "M111 548L508 548L508 466L194 73L508 73L508 0L99 0L99 83L413 476L111 476L111 548Z"},
+	'{':  {602, 108, -163, 494, 760, "M494 -93L494 -163L463 -163Q341 -163 300 -127Q259 -91 259 17L259 134Q259 208 233 236Q207 264 138 264L108 264L108 334L138 334Q207 334 233 362Q259 390 259 463L259 580Q259 688 300 724Q341 760 463 760L494 760L494 690L460 690Q392 690 371 669Q350 647 350 578L350 457Q350 380 328 346Q306 311 252 299Q306 286 328 251Q350 216 350 140L350 19Q350 -51 371 -72Q392 -93 460 -93L494 -93Z"},
+	'|':  {602, 259, -236, 343, 764, "M343 764L343 -236L259 -236L259 764L343 764Z"},
+	'}':  {602, 108, -163, 494, 760, "M108 -93L141 -93Q210 -93 231 -71Q252 -50 252 19L252 140Q252 216 274 251Q296 286 350 299Q296 311 274 346Q252 380 252 457L252 578Q252 647 231 668Q210 690 141 690L108 690L108 760L138 760Q260 760 301 724Q342 688 342 580L342 463Q342 390 368 362Q394 334 463 334L494 334L494 264L463 264Q394 264 368 236Q342 208 342 134L342 17Q342 -91 301 -127Q260 -163 138 -163L108 -163L108 -93Z"},
+	'~':  {602, 43, 240, 559, 381, "M559 381L559 296Q522 267 488 254Q453 240 414 240Q370 240 315 265Q304 270 299 272Q261 288 236 294Q211 300 186 300Q147 300 113 285Q79 271 43 240L43 325Q81 354 117 368Q152 381 193 381Q219 381 243 375Q268 370 304 355Q310 353 320 348Q376 321 422 321Q456 321 490 336Q523 351 559 381Z"},
+}
@@ -0,0 +1,855 @@
+// Package svg renders syntax trees as standalone SVG images.  Expressions
+// are laid out in boxes with the spacing and the proportions of TeX, see
+// box.go, and drawn with the outlines of the DejaVu fonts, which are bundled
+// in glyphs.go so that the images do not depend on the fonts of the viewer.
+// Letters are slanted as DejaVu has no italic serif font, fonts are taken
+// from the bold, sans-serif and monospace faces, and the double-struck,
+// script and fraktur letters from the symbols of DejaVu Sans when it has
+// them.  Brackets, radical signs and braces are stroked paths that grow with
+// what they enclose.
+//
+// An Image gives the size of the SVG in ems along with its depth below the
+// baseline, to place it inline with text.
+package svg
+
+//go:generate go run gen.go
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Options control the layout and the color of an image.
+type Options struct {
+	// Inline lays out the expression in text style, with smaller
+	// fractions and the limits of sums beside them, instead of the display
+	// style of ASCIIMathML.
+	Inline bool
+
+	// Color is the color of the expression, e.g. "blue".  By default it is
+	// the currentColor of the SVG.
+	Color string
+}
+
+// An Image is an SVG image with its size in ems.  Its baseline is Depth
+// above its bottom, e.g. it is placed inline with the CSS style
+// "vertical-align: -<Depth>em", which the SVG element also sets.
+type Image struct {
+	SVG                  string
+	Width, Height, Depth float64
+}
+
+// Node returns the SVG image of a syntax tree with the default options.
+func Node(node ast.Node) *Image {
+	return Options{}.Node(node)
+}
+
+// Expr parses an ASCIIMath expression and returns its SVG image with the
+// default options.
+func Expr(input string) (*Image, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return Node(node), nil
+}
+
+// Node returns the SVG image of a syntax tree.
+func (o Options) Node(node ast.Node) *Image {
+	b := context{size: 1, display: !o.Inline}.layout(node)
+	color := "currentColor"
+	if o.Color != "" {
+		color = escaper.Replace(o.Color)
+	}
+	img := &Image{Width: em(b.width), Height: em(b.height), Depth: em(b.depth)}
+	var w strings.Builder
+	fmt.Fprintf(&w, `<svg xmlns="http://www.w3.org/2000/svg" width="%sem" height="%sem" viewBox="0 %s %s %s" style="vertical-align: -%sem; overflow: visible" fill="%s" color="%s" stroke-linejoin="round">`,
+		ems(img.Width), ems(img.Height+img.Depth), num(-b.height), num(b.width), num(b.height+b.depth), ems(img.Depth), color, color)
+	b.write(&w, 0, 0)
+	w.WriteString("</svg>")
+	img.SVG = w.String()
+	return img
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func em(v float64) float64 {
+	return math.Round(v) / 1000
+}
+
+func ems(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Lengths in thousandths of an em.
+const (
+	axis      = 313 // the height of the middle of the minus sign
+	xHeight   = 519
+	thickness = 50 // of fraction rules and strokes
+	thin      = 167
+	medium    = 222
+	thick     = 278
+	slant     = 0.2 // of the synthesised italic letters
+)
+
+// A context holds the style in which a node is laid out.
+type context struct {
+	size    float64 // relative to the font size of the image
+	display bool
+	font    map[rune]*glyph // the glyphs of a mathvariant, or nil
+	codes   []string        // the letters of a mathvariant, or nil
+	variant bool            // letters are upright
+}
+
+// smaller returns the context of scripts.
+func (c context) smaller() context {
+	c.size = math.Max(c.size*0.7, 0.5)
+	c.display = false
+	return c
+}
+
+// fraction returns the context of numerators and denominators.
+func (c context) fraction() context {
+	if c.display {
+		c.display = false
+		return c
+	}
+	return c.smaller()
+}
+
+func (c context) layout(node ast.Node) *box {
+	switch n := node.(type) {
+	case *ast.Row:
+		b, _ := c.row(n.Items)
+		return b
+	case *ast.Constant:
+		return c.symbol(n.Token.Symbol)
+	case *ast.Error:
+		return c.chars(n.Token.Symbol.Output(), false)
+	case *ast.Placeholder:
+		return c.chars("□", false)
+	case *ast.Text:
+		return c.chars(n.Value, false)
+	case *ast.Unary:
+		return c.unary(n)
+	case *ast.Binary:
+		return c.binary(n)
+	case *ast.Style:
+		return c.style(n)
+	case *ast.Frac:
+		return c.frac(operand(n.Num), operand(n.Den))
+	case *ast.FunctionApplication:
+		if class(n.Func) == op && !isFenced(n.Arg) {
+			return hbox(c.layout(n.Func), kern(thin*c.size), c.layout(n.Arg))
+		}
+		return hbox(c.layout(n.Func), c.layout(n.Arg))
+	case *ast.Script:
+		return c.script(n)
+	case *ast.Fenced:
+		close := ""
+		if n.Close.IsValid() && !n.Close.Symbol.Invisible() {
+			close = n.Close.Symbol.Output()
+		}
+		open := ""
+		if !n.Open.Symbol.Invisible() {
+			open = n.Open.Symbol.Output()
+		}
+		return c.fenced(open, c.layout(n.Body), close)
+	case *ast.Matrix:
+		return c.matrix(n)
+	case *ast.EquationArray:
+		return c.equationArray(n)
+	}
+	panic(fmt.Sprintf("unexpected node type %T", node))
+}
+
+// Classes of items in a row, which give the spaces between them.
+const (
+	ord = iota
+	op
+	bin
+	rel
+	punct
+)
+
+// binaries and relations are the outputs of the binary operators and of the
+// relations and arrows of AMsymbols.
+var binaries = map[string]bool{
+	"+": true, "-": true, "±": true, "∓": true, "⋅": true, "∗": true,
+	"⋆": true, "×": true, "÷": true, "∘": true, "⊕": true, "⊗": true,
+	"⊙": true, "∧": true, "∨": true, "∩": true, "∪": true, "\\": true,
+	"⋉": true, "⋊": true, "⋈": true,
+}
+
+var relations = map[string]bool{
+	"=": true, "<": true, ">": true, "≠": true, ":=": true, "≤": true,
+	"≥": true, "≺": true, "≻": true, "⪯": true, "⪰": true, "∈": true,
+	"∉": true, "⊂": true, "⊃": true, "⊆": true, "⊇": true, "≡": true,
+	"≅": true, "≈": true, "∝": true, "⇒": true, "⇔": true, "⊢": true,
+	"⊨": true, "↑": true, "↓": true, "→": true, "↣": true, "↠": true,
+	"⤖": true, "↦": true, "←": true, "↔": true, "⇐": true,
+}
+
+func class(node ast.Node) int {
+	switch n := node.(type) {
+	case *ast.Script:
+		if class(n.Base) == op {
+			return op
+		}
+	case *ast.Binary:
+		switch n.Op.Input() {
+		case "stackrel", "overset", "underset":
+			return class(operand(n.Arg2))
+		}
+	case *ast.Constant:
+		sym := n.Token.Symbol
+		out := sym.Output()
+		switch {
+		case out == "," || out == ";":
+			return punct
+		case binaries[out]:
+			return bin
+		case relations[out]:
+			return rel
+		case sym.IsFunc() && utf8.RuneCountInString(out) > 1, sym.Type() == scanner.UNDEROVER, out == "∫", out == "∮":
+			return op
+		}
+	}
+	return ord
+}
+
+// space returns the space between items of classes left and right.  As in
+// TeX, only thin spaces are kept in scripts.
+func (c context) space(left, right int) float64 {
+	var s float64
+	switch {
+	case left == rel && right == rel, right == punct:
+	case left == rel || right == rel:
+		s = thick
+	case left == bin || right == bin:
+		s = medium
+	case left == punct:
+		s = thin
+	case left == op || right == op:
+		return thin * c.size
+	}
+	if c.size < 1 {
+		return 0
+	}
+	return s
+}
+
+// row lays out nodes side by side and returns the x coordinates where they
+// start.  Operators in front of their operand are signs, without spaces
+// around them.
+func (c context) row(nodes []ast.Node) (*box, []float64) {
+	b := &box{}
+	xs := make([]float64, len(nodes)+1)
+	prev := -1
+	for i, node := range nodes {
+		child := c.layout(node)
+		cl := class(node)
+		if cl == bin && (prev == -1 || prev == bin || prev == rel || prev == punct || prev == op) {
+			cl = ord
+		}
+		if prev != -1 && (child.width > 0 || cl != ord) {
+			b.width += c.space(prev, cl)
+		}
+		xs[i] = b.width
+		b.add(b.width, 0, child)
+		b.width += child.width
+		b.height = math.Max(b.height, child.height)
+		b.depth = math.Max(b.depth, child.depth)
+		if child.width > 0 || cl != ord {
+			prev = cl
+		}
+	}
+	xs[len(nodes)] = b.width
+	if len(nodes) == 1 {
+		b.italic = b.items[0].box.italic
+	}
+	return b, xs
+}
+
+// isFenced returns true if node is between visible brackets, which separate
+// a function from its argument.
+func isFenced(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Fenced:
+		return !n.Invisible()
+	case *ast.Matrix:
+		return true
+	}
+	return false
+}
+
+// operand returns the node inside the brackets of an argument, a script or
+// an operand of /, which are removed as in ASCIIMathML.
+func operand(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		return fenced.Body
+	}
+	return node
+}
+
+// symbol lays out a constant symbol.  Single letters are slanted, the
+// hyphen is drawn as a minus sign, and large operators are enlarged in
+// display style.
+func (c context) symbol(sym *scanner.Symbol) *box {
+	out := sym.Output()
+	switch {
+	case c.display && (out == "∫" || out == "∮"):
+		return c.large(out, 1.6)
+	case c.display && sym.Type() == scanner.UNDEROVER && utf8.RuneCountInString(out) == 1:
+		return c.large(out, 1.4)
+	case sym.Type() == scanner.SPACE:
+		// Words like "if" have a space of 1ex on each side as in
+		// ASCIIMathML.
+		return hbox(kern(xHeight*c.size), c.chars(out, false), kern(xHeight*c.size))
+	case out == "-":
+		out = "−"
+	}
+	return c.chars(out, sym.Tag() == "mi" && isLetter(out))
+}
+
+// isLetter returns true if s is a Latin letter or a small Greek letter,
+// which are slanted in math.
+func isLetter(s string) bool {
+	r, size := utf8.DecodeRuneInString(s)
+	if size != len(s) {
+		return false
+	}
+	return r < 0x80 && unicode.IsLetter(r) || r >= 'α' && r <= 'ω' || r == 'ϕ' || r == 'ϵ' || r == 'ϑ'
+}
+
+// large returns a large operator scaled by factor and centered on the axis.
+func (c context) large(s string, factor float64) *box {
+	r, _ := utf8.DecodeRuneInString(s)
+	g := serifGlyphs[r]
+	size := c.size * factor
+	dy := axis*c.size - float64(g.ymin+g.ymax)/2*size
+	b := &box{width: float64(g.advance) * size, height: float64(g.ymax)*size + dy, depth: -float64(g.ymin)*size - dy}
+	b.items = append(b.items, item{y: -dy, glyph: g, scale: size, stretch: size})
+	return b
+}
+
+// chars lays out the characters of s, with the letters of the mathvariant
+// of c.
+func (c context) chars(s string, italic bool) *box {
+	var boxes []*box
+	for _, r := range s {
+		if code := c.code(r); code != 0 {
+			r = code
+		}
+		boxes = append(boxes, c.char(r, italic && !c.variant))
+	}
+	return hbox(boxes...)
+}
+
+// code returns the double-struck, script or fraktur letter for r if c has
+// such a mathvariant and there is a glyph for it.
+func (c context) code(r rune) rune {
+	var s string
+	switch {
+	case c.codes == nil:
+	case r >= 'A' && r <= 'Z':
+		s = c.codes[r-'A']
+	case r >= 'a' && r <= 'z':
+		s = c.codes[r-'a'+26]
+	}
+	code, _ := utf8.DecodeRuneInString(s)
+	if serifGlyphs[code] == nil {
+		return 0
+	}
+	return code
+}
+
+func (c context) char(r rune, italic bool) *box {
+	if sub, ok := substitutes[r]; ok {
+		r = sub
+	}
+	g := c.font[r]
+	if g == nil {
+		g = serifGlyphs[r]
+	}
+	s := c.size
+	if g == nil {
+		// A box drawn in place of a character without a glyph.
+		b := &box{width: 600 * s, height: 700 * s}
+		b.stroke(thickness*s, moveTo(100*s, 0), lineTo(500*s, 0), lineTo(500*s, -700*s), lineTo(100*s, -700*s), segment{'Z', nil})
+		return b
+	}
+	b := &box{width: float64(g.advance) * s, height: math.Max(float64(g.ymax), 0) * s, depth: math.Max(-float64(g.ymin), 0) * s}
+	it := item{glyph: g, scale: s, stretch: s}
+	if italic {
+		it.slant = slant
+		b.italic = true
+		b.width += slant * b.height / 2
+	}
+	b.items = append(b.items, it)
+	return b
+}
+
+// mark returns a box fitting the ink of the glyph for r, stretched
+// horizontally to at least width, with its bottom on the baseline.
+func (c context) mark(r rune, width float64) *box {
+	g := serifGlyphs[r]
+	s := c.size
+	sx := s
+	if ink := float64(g.xmax-g.xmin) * s; ink < width {
+		sx = s * width / ink
+	}
+	b := &box{width: float64(g.xmax-g.xmin) * sx, height: float64(g.ymax-g.ymin) * s}
+	b.items = append(b.items, item{x: -float64(g.xmin) * sx, y: float64(g.ymin) * s, glyph: g, scale: sx, stretch: s})
+	return b
+}
+
+// over puts mark centered above b, gap above its height.
+func over(b, mark *box, gap float64) *box {
+	width := math.Max(b.width, mark.width)
+	r := &box{width: width, height: b.height + gap + mark.height + mark.depth, depth: b.depth}
+	r.add((width-b.width)/2, 0, b)
+	r.add((width-mark.width)/2, -(b.height + gap + mark.depth), mark)
+	return r
+}
+
+// under puts mark centered under b, gap below its depth.
+func under(b, mark *box, gap float64) *box {
+	width := math.Max(b.width, mark.width)
+	r := &box{width: width, height: b.height, depth: b.depth + gap + mark.height + mark.depth}
+	r.add((width-b.width)/2, 0, b)
+	r.add((width-mark.width)/2, b.depth+gap+mark.height, mark)
+	return r
+}
+
+func (c context) unary(n *ast.Unary) *box {
+	sym := n.Op.Symbol
+	if _, ok := n.Arg.(*ast.Placeholder); ok && sym.IsFunc() {
+		return c.symbol(sym)
+	}
+	arg := c.layout(operand(n.Arg))
+	s := c.size
+	switch lr := sym.RewriteLeftRight(); {
+	case sym.Input() == "sqrt":
+		b, _ := c.radical(arg)
+		return b
+	case lr != [2]string{}:
+		return c.fenced(lr[0], arg, lr[1])
+	case sym.Input() == "cancel":
+		b := hbox(arg)
+		b.stroke(0.8*thickness*s, moveTo(0, arg.depth), lineTo(arg.width, -arg.height))
+		return b
+	}
+	switch sym.Input() {
+	case "hat":
+		return over(arg, c.mark('ˆ', arg.width*0.8), 80*s)
+	case "tilde":
+		return over(arg, c.mark('˜', arg.width*0.8), 80*s)
+	case "dot":
+		return over(arg, c.mark('˙', 0), 80*s)
+	case "ddot":
+		return over(arg, c.mark('¨', 0), 80*s)
+	case "bar":
+		return over(arg, rule(arg.width, thickness*s, thickness*s/2), 100*s)
+	case "ul":
+		return under(arg, rule(arg.width, thickness*s, -thickness*s/2), 100*s)
+	case "vec":
+		w, h := math.Max(arg.width, 500*s), 100*s
+		mark := &box{width: w, height: 2 * h}
+		mark.stroke(thickness*s, moveTo(0, -h), lineTo(w, -h), moveTo(w-1.5*h, -2*h), lineTo(w, -h), lineTo(w-1.5*h, 0))
+		return over(arg, mark, 80*s)
+	case "overarc":
+		w, h := arg.width, 150*s
+		mark := &box{width: w, height: h}
+		mark.stroke(0.8*thickness*s, moveTo(0, 0), quadTo(w/2, -2*h, w, 0))
+		return over(arg, mark, 80*s)
+	case "obrace":
+		return over(arg, c.brace(arg.width, false), 80*s)
+	case "ubrace":
+		return under(arg, c.brace(arg.width, true), 80*s)
+	}
+	return hbox(c.chars(sym.Input(), false), c.fenced("(", arg, ")"))
+}
+
+// brace returns a horizontal brace with its tip up, or down if it goes under
+// its argument.
+func (c context) brace(width float64, down bool) *box {
+	h := 200 * c.size
+	r := math.Min(h, width/4)
+	b := &box{width: width, height: h}
+	y := func(v float64) float64 { return -v }
+	if down {
+		b.height, b.depth = 0, h
+		y = func(v float64) float64 { return v }
+	}
+	m := width / 2
+	b.stroke(thickness*c.size,
+		moveTo(0, 0), quadTo(0, y(h/2), r, y(h/2)), lineTo(m-r, y(h/2)), quadTo(m, y(h/2), m, y(h)),
+		quadTo(m, y(h/2), m+r, y(h/2)), lineTo(width-r, y(h/2)), quadTo(width, y(h/2), width, 0))
+	return b
+}
+
+// radical draws a radical sign in front of b and a rule above it, and
+// returns the width of the sign.
+func (c context) radical(b *box) (*box, float64) {
+	s := c.size
+	t, gap := thickness*s, 100*s
+	height, depth := math.Max(b.height, 700*s), math.Max(b.depth, 150*s)
+	top := -(height + gap + t/2)
+	total := depth - top
+	w := 500*s + 0.08*total
+	r := &box{width: w + b.width + 80*s, height: height + gap + t, depth: depth}
+	r.stroke(t, moveTo(0.06*w, depth-0.42*total), lineTo(0.2*w, depth-0.5*total), lineTo(0.5*w, depth), lineTo(w, top), lineTo(r.width, top))
+	r.add(w, 0, b)
+	return r, w
+}
+
+// root draws a radical with its index above the start of the sign.
+func (c context) root(index, b *box) *box {
+	r, w := c.radical(b)
+	total := r.height + r.depth
+	x := 0.55*w - index.width
+	y := r.depth - 0.5*total - 80*c.size - index.depth
+	dx := math.Max(0, -x)
+	out := &box{width: dx + r.width, height: math.Max(r.height, index.height-y), depth: r.depth}
+	out.add(dx, 0, r)
+	out.add(dx+x, y, index)
+	return out
+}
+
+// frac stacks num over den with a rule between them on the axis.
+func (c context) frac(num, den ast.Node) *box {
+	f := c.fraction()
+	n, d := f.layout(num), f.layout(den)
+	s := c.size
+	t, a, margin := thickness*s, axis*s, 60*s
+	gap, up, down := 100*s, 394*s, 345*s
+	if c.display {
+		gap, up, down = 150*s, 677*s, 686*s
+	}
+	width := math.Max(n.width, d.width) + 2*margin
+	nb := math.Max(a+t/2+gap+n.depth, up)
+	db := math.Max(d.height+gap+t/2-a, down)
+	b := &box{width: width + 2*margin, height: nb + n.height, depth: db + d.depth}
+	b.add(margin+(width-n.width)/2, -nb, n)
+	b.add(margin+(width-d.width)/2, db, d)
+	b.add(margin, 0, rule(width, t, a))
+	return b
+}
+
+func (c context) binary(n *ast.Binary) *box {
+	switch n.Op.Input() {
+	case "frac":
+		return c.frac(operand(n.Arg1), operand(n.Arg2))
+	case "root":
+		return c.root(c.smaller().smaller().layout(operand(n.Arg1)), c.layout(operand(n.Arg2)))
+	case "stackrel", "overset":
+		return over(c.layout(operand(n.Arg2)), c.smaller().layout(operand(n.Arg1)), 100*c.size)
+	case "underset":
+		return under(c.layout(operand(n.Arg2)), c.smaller().layout(operand(n.Arg1)), 100*c.size)
+	}
+	a1, a2 := c.layout(operand(n.Arg1)), c.layout(operand(n.Arg2))
+	return hbox(c.chars(n.Op.Input(), false), c.fenced("(", a1, ")"), c.fenced("(", a2, ")"))
+}
+
+// fonts are the glyphs of the values of mathvariant which have a face of
+// their own.
+var fonts = map[string]map[rune]*glyph{
+	"bold":       boldGlyphs,
+	"sans-serif": sansGlyphs,
+	"monospace":  monoGlyphs,
+}
+
+// style lays out the argument of a font symbol with its glyphs, and puts the
+// argument of color, id and class in a group with the matching attributes.
+func (c context) style(n *ast.Style) *box {
+	attr := ast.StyleAttr(n.Op)
+	if attr == "mathvariant" {
+		c.variant = true
+		c.font = fonts[n.Value()]
+		c.codes = n.Op.Symbol.Codes()
+		return c.layout(operand(n.Arg))
+	}
+	b := hbox(c.layout(operand(n.Arg)))
+	value := escaper.Replace(n.Value())
+	switch attr {
+	case "mathcolor":
+		b.attrs = ` fill="` + value + `" color="` + value + `"`
+	case "id", "class":
+		b.attrs = " " + attr + `="` + value + `"`
+	}
+	return b
+}
+
+func (c context) script(n *ast.Script) *box {
+	base := c.layout(n.Base)
+	var sub, sup *box
+	if n.Sub != nil {
+		sub = c.smaller().layout(operand(n.Sub))
+	}
+	if n.Sup != nil {
+		sup = c.smaller().layout(operand(n.Sup))
+	}
+	s := c.size
+	if n.UnderOver(c.display) {
+		b := base
+		if sub != nil {
+			b = under(b, sub, 100*s)
+		}
+		if sup != nil {
+			b = over(b, sup, 100*s)
+		}
+		return b
+	}
+	u := math.Max(400*s, base.height-250*s)
+	v := math.Max(150*s, base.depth+50*s)
+	if sub != nil {
+		v = math.Max(v, sub.height-450*s)
+	}
+	if sub != nil && sup != nil {
+		if gap := (u - sup.depth) - (sub.height - v); gap < 100*s {
+			v += 100*s - gap
+		}
+	}
+	b := &box{width: base.width, height: base.height, depth: base.depth}
+	b.add(0, 0, base)
+	var width float64
+	if sup != nil {
+		b.add(base.width, -u, sup)
+		b.height = math.Max(b.height, u+sup.height)
+		width = sup.width
+	}
+	if sub != nil {
+		x := base.width
+		if base.italic {
+			x -= slant * base.height / 2
+		}
+		b.add(x, v, sub)
+		b.depth = math.Max(b.depth, v+sub.depth)
+		width = math.Max(width, sub.width+x-base.width)
+	}
+	b.width += width + 50*s
+	return b
+}
+
+// fenced puts body between brackets as tall as it.  An empty bracket is
+// omitted.
+func (c context) fenced(open string, body *box, close string) *box {
+	var boxes []*box
+	if open != "" {
+		boxes = append(boxes, c.bracket(open, body))
+	}
+	boxes = append(boxes, body)
+	if close != "" {
+		boxes = append(boxes, c.bracket(close, body))
+	}
+	return hbox(boxes...)
+}
+
+// brackets are the paths of the brackets drawn taller than their glyph, in
+// a square from 0, 0 at the top left to 1, 1.  Closing brackets are drawn
+// mirrored.
+var brackets = map[rune][]segment{
+	'(': {moveTo(0.85, 0), curveTo(0.15, 0.2, 0.15, 0.8, 0.85, 1)},
+	'[': {moveTo(0.8, 0), lineTo(0.3, 0), lineTo(0.3, 1), lineTo(0.8, 1)},
+	'{': {moveTo(0.85, 0), quadTo(0.45, 0, 0.45, 0.1), lineTo(0.45, 0.4), quadTo(0.45, 0.5, 0.1, 0.5), quadTo(0.45, 0.5, 0.45, 0.6), lineTo(0.45, 0.9), quadTo(0.45, 1, 0.85, 1)},
+	'|': {moveTo(0.5, 0), lineTo(0.5, 1)},
+	'∥': {moveTo(0.3, 0), lineTo(0.3, 1), moveTo(0.7, 0), lineTo(0.7, 1)},
+	'⌊': {moveTo(0.3, 0), lineTo(0.3, 1), lineTo(0.8, 1)},
+	'⌈': {moveTo(0.8, 0), lineTo(0.3, 0), lineTo(0.3, 1)},
+	'⟨': {moveTo(0.8, 0), lineTo(0.2, 0.5), lineTo(0.8, 1)},
+}
+
+var mirrors = map[rune]rune{
+	')': '(', ']': '[', '}': '{', '⌋': '⌊', '⌉': '⌈', '⟩': '⟨',
+}
+
+// substitutes are the characters drawn with the glyph of another, as DejaVu
+// Serif only has the mathematical angle brackets.
+var substitutes = map[rune]rune{
+	'\u2329': '⟨', '\u232A': '⟩',
+}
+
+// bracket returns the bracket s as tall as body, centered on the axis.
+func (c context) bracket(s string, body *box) *box {
+	r, size := utf8.DecodeRuneInString(s)
+	if sub, ok := substitutes[r]; ok {
+		r = sub
+	}
+	g := serifGlyphs[r]
+	if size != len(s) || g == nil {
+		return c.chars(s, false)
+	}
+	a := axis * c.size
+	half := math.Max(body.height-a, body.depth+a)
+	if half <= float64(g.ymax-g.ymin)/2*c.size {
+		return c.char(r, false)
+	}
+	half += 100 * c.size
+	top, bottom := -(a + half), half-a
+	mirror := false
+	if m, ok := mirrors[r]; ok {
+		r, mirror = m, true
+	}
+	path, ok := brackets[r]
+	if !ok {
+		// Other brackets are stretched glyphs.
+		stretch := 2 * half / float64(g.ymax-g.ymin)
+		b := &box{width: float64(g.advance) * c.size, height: a + half, depth: half - a}
+		b.items = append(b.items, item{y: top + float64(g.ymax)*stretch, glyph: g, scale: c.size, stretch: stretch})
+		return b
+	}
+	w := math.Min(300+0.05*half/c.size, 500) * c.size
+	if r == '|' || r == '∥' {
+		w = 300 * c.size
+	}
+	b := &box{width: w, height: a + half, depth: half - a}
+	var segs []segment
+	for _, seg := range path {
+		pts := make([]float64, len(seg.pts))
+		for i := 0; i < len(pts); i += 2 {
+			x := seg.pts[i]
+			if mirror {
+				x = 1 - x
+			}
+			pts[i], pts[i+1] = x*w, top+seg.pts[i+1]*(bottom-top)
+		}
+		segs = append(segs, segment{seg.cmd, pts})
+	}
+	b.stroke(1.4*thickness*c.size, segs...)
+	return b
+}
+
+// table stacks rows with their left ends at xs, centered on the axis.  Rows
+// are at least as tall as a line of text.
+func (c context) table(rows []*box, xs []float64) *box {
+	s := c.size
+	b := &box{}
+	var ys []float64
+	y := 0.0
+	for i, r := range rows {
+		if i > 0 {
+			y += math.Max(r.depth, 300*s) + 100*s
+		}
+		y += math.Max(r.height, 800*s)
+		ys = append(ys, y)
+		b.width = math.Max(b.width, xs[i]+r.width)
+	}
+	if len(rows) > 0 {
+		y += math.Max(rows[len(rows)-1].depth, 300*s)
+	}
+	top := axis*s + y/2
+	b.height, b.depth = top, y-top
+	for i, r := range rows {
+		b.add(xs[i], ys[i]-top, r)
+	}
+	return b
+}
+
+// matrix lays out the cells of a matrix in a grid between tall brackets.  As
+// in ASCIIMathML, a cell made of a single "|" draws a line between the
+// columns around it.
+func (c context) matrix(n *ast.Matrix) *box {
+	m := c
+	m.display = false
+	var lines []bool
+	var cells [][]*box
+	for i, r := range n.Rows {
+		var boxes []*box
+		for j, cell := range r.Cells {
+			if j > 0 && j < len(r.Cells)-1 && isColumnLine(cell) {
+				if i == 0 {
+					lines[len(lines)-1] = true
+				}
+				continue
+			}
+			boxes = append(boxes, m.layout(cell))
+			if i == 0 {
+				lines = append(lines, false)
+			}
+		}
+		cells = append(cells, boxes)
+	}
+	widths := make([]float64, len(lines))
+	for _, r := range cells {
+		for j, cell := range r {
+			if j < len(widths) {
+				widths[j] = math.Max(widths[j], cell.width)
+			}
+		}
+	}
+	left := n.Close.Symbol.Invisible()
+	gap := 800 * c.size
+	if left {
+		gap = 1000 * c.size
+	}
+	var rows []*box
+	for _, r := range cells {
+		row := &box{}
+		x := 0.0
+		for j, width := range widths {
+			if j < len(r) {
+				cell := r[j]
+				pad := (width - cell.width) / 2
+				if left {
+					pad = 0
+				}
+				row.add(x+pad, 0, cell)
+				row.height = math.Max(row.height, cell.height)
+				row.depth = math.Max(row.depth, cell.depth)
+			}
+			x += width + gap
+		}
+		row.width = x - gap
+		rows = append(rows, row)
+	}
+	t := c.table(rows, make([]float64, len(rows)))
+	// Column lines run through the whole table.
+	x := 0.0
+	for j := 0; j < len(widths)-1; j++ {
+		x += widths[j] + gap
+		if lines[j] {
+			t.add(x-gap/2-thickness*c.size/4, 0, rule(thickness*c.size/2, t.height+t.depth, (t.height-t.depth)/2))
+		}
+	}
+	close := ""
+	if !n.Close.Symbol.Invisible() {
+		close = n.Close.Symbol.Output()
+	}
+	return c.fenced(n.Open.Symbol.Output(), t, close)
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	k, ok := cell.Items[0].(*ast.Constant)
+	return ok && k.Token.Type() == scanner.LEFTRIGHT
+}
+
+// equationArray stacks the lines of n, with their alignment points lined
+// up.
+func (c context) equationArray(n *ast.EquationArray) *box {
+	var rows []*box
+	var splits []float64
+	split := 0.0
+	for _, line := range n.Lines {
+		items := append(append([]ast.Node(nil), line.Left.Items...), line.Right.Items...)
+		b, xs := c.row(items)
+		rows = append(rows, b)
+		splits = append(splits, xs[len(line.Left.Items)])
+		split = math.Max(split, xs[len(line.Left.Items)])
+	}
+	for i := range splits {
+		splits[i] = split - splits[i]
+	}
+	return c.table(rows, splits)
+}
@@ -0,0 +1,132 @@
+package svg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestExpr(t *testing.T) {
+	tests := []struct {
+		name                 string
+		input                string
+		width, height, depth float64
+		contains             []string
+	}{
+		{
+			name:   "letter",
+			input:  "x",
+			width:  0.616,
+			height: 0.519,
+			contains: []string{
+				`<svg xmlns="http://www.w3.org/2000/svg" width="0.616em" height="0.519em" viewBox="0 -519 615.9 519" style="vertical-align: -0em; overflow: visible"`,
+				`transform="matrix(1 0 0.2 -1 0 0)"`,
+			},
+		},
+		{
+			name:     "number",
+			input:    "2",
+			width:    0.636,
+			height:   0.742,
+			contains: []string{`transform="matrix(1 0 0 -1 0 0)"`},
+		},
+		{
+			name:     "superscript",
+			input:    "x^2",
+			width:    1.111,
+			height:   0.919,
+			contains: []string{`transform="matrix(0.7 0 0 -0.7 615.9 -400)"`},
+		},
+		{
+			name:     "fraction",
+			input:    "frac(1)(2)",
+			width:    0.876,
+			height:   1.419,
+			depth:    0.686,
+			contains: []string{`<rect x="60" y="-338" width="756" height="50"/>`},
+		},
+		{
+			name:     "stretched brackets",
+			input:    "(frac(a)(b))",
+			contains: []string{`fill="none" stroke="currentColor" stroke-width="70"`},
+		},
+		{
+			name:     "radical",
+			input:    "sqrt x",
+			contains: []string{`fill="none" stroke="currentColor" stroke-width="50"`},
+		},
+		{
+			name:     "large operator",
+			input:    "sum_(i=1)^n i",
+			contains: []string{`transform="matrix(1.4 0 0 -1.4 153.9 55.9)"`},
+		},
+		{
+			name:     "color",
+			input:    "color(red)(x)",
+			contains: []string{`<g fill="red" color="red"><path`},
+		},
+		{
+			name:     "bold",
+			input:    "bb x",
+			width:    0.596,
+			height:   0.519,
+			contains: []string{`transform="matrix(1 0 0 -1 0 0)"`},
+		},
+		{
+			name:     "double-struck",
+			input:    "bbb R",
+			contains: []string{`width="0.831em"`},
+		},
+		{
+			name:     "script letter without a glyph",
+			input:    "cc A",
+			contains: []string{`width="0.722em"`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			img, err := Expr(test.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.width != 0 && (img.Width != test.width || img.Height != test.height || img.Depth != test.depth) {
+				t.Errorf("got size %v %v %v, want %v %v %v", img.Width, img.Height, img.Depth, test.width, test.height, test.depth)
+			}
+			for _, s := range test.contains {
+				if !strings.Contains(img.SVG, s) {
+					t.Errorf("%s does not contain %s", img.SVG, s)
+				}
+			}
+		})
+	}
+}
+
+func TestOptions_Node(t *testing.T) {
+	node, err := parser.Parse("sum_(i=1)^n i")
+	if err != nil {
+		t.Fatal(err)
+	}
+	display, inline := Node(node), Options{Inline: true, Color: "blue"}.Node(node)
+	if inline.Height+inline.Depth >= display.Height+display.Depth {
+		t.Errorf("inline %+v is not shorter than display %+v", inline, display)
+	}
+	if !strings.Contains(inline.SVG, `fill="blue" color="blue"`) {
+		t.Errorf("%s is not blue", inline.SVG)
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	node, err := parser.ParseBlock("f(x) = (x+1)^2\n= x^2 + 2x + 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := Node(node)
+	// The two lines are centered on the axis.
+	if img.Height-img.Depth < 0.62 || img.Height-img.Depth > 0.63 {
+		t.Errorf("got height %v and depth %v", img.Height, img.Depth)
+	}
+	if got := strings.Count(img.SVG, "<path"); got != 19 {
+		t.Errorf("got %d paths, want 19", got)
+	}
+}
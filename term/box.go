@@ -0,0 +1,148 @@
+package term
+
+import (
+	"strings"
+	"unicode"
+)
+
+// A box is a rectangle of cells with a baseline, the row which lines up with
+// the baseline of the boxes next to it.  Each cell holds a character and the
+// combining marks following it.
+type box struct {
+	rows [][]string
+	base int
+}
+
+func newBox(width, height, base int) *box {
+	rows := make([][]string, height)
+	for i := range rows {
+		rows[i] = make([]string, width)
+		for j := range rows[i] {
+			rows[i][j] = " "
+		}
+	}
+	return &box{rows: rows, base: base}
+}
+
+// text returns a box made of a single line of text.
+func text(s string) *box {
+	var row []string
+	for _, r := range s {
+		if unicode.Is(unicode.Mn, r) && len(row) > 0 {
+			row[len(row)-1] += string(r)
+		} else {
+			row = append(row, string(r))
+		}
+	}
+	return &box{rows: [][]string{row}}
+}
+
+func (b *box) width() int {
+	if len(b.rows) == 0 {
+		return 0
+	}
+	return len(b.rows[0])
+}
+
+func (b *box) height() int {
+	return len(b.rows)
+}
+
+// put copies c into b with its top left corner at column x and row y.
+func (b *box) put(x, y int, c *box) {
+	for i, row := range c.rows {
+		copy(b.rows[y+i][x:], row)
+	}
+}
+
+// hcat puts boxes side by side, lining up their baselines.
+func hcat(boxes ...*box) *box {
+	above, below, width := 0, 0, 0
+	for _, c := range boxes {
+		if c.base > above {
+			above = c.base
+		}
+		if d := c.height() - c.base; d > below {
+			below = d
+		}
+		width += c.width()
+	}
+	b := newBox(width, above+below, above)
+	x := 0
+	for _, c := range boxes {
+		b.put(x, above-c.base, c)
+		x += c.width()
+	}
+	return b
+}
+
+// vcat stacks boxes, centered, with the baseline of the box at index base.
+// If that box is empty and at the bottom, the baseline is the last row.
+func vcat(base int, boxes ...*box) *box {
+	width, height := 0, 0
+	for _, c := range boxes {
+		if c.width() > width {
+			width = c.width()
+		}
+		height += c.height()
+	}
+	b := newBox(width, height, 0)
+	y := 0
+	for i, c := range boxes {
+		if i == base {
+			b.base = y + c.base
+		}
+		b.put((width-c.width())/2, y, c)
+		y += c.height()
+	}
+	if b.base >= height && height > 0 {
+		b.base = height - 1
+	}
+	return b
+}
+
+// space returns a blank line of the given width.
+func space(width int) *box {
+	return newBox(width, 1, 0)
+}
+
+// line returns a row of width copies of r.
+func line(r rune, width int) *box {
+	return text(strings.Repeat(string(r), width))
+}
+
+// column returns a box one character wide made of top, middle characters,
+// bottom and with mid at the baseline if it is not 0.
+func column(height, base int, top, middle, bottom, mid rune) *box {
+	b := newBox(1, height, base)
+	for i := range b.rows {
+		r := middle
+		switch {
+		case i == height-1:
+			r = bottom
+		case i == 0:
+			r = top
+		case i == base && mid != 0:
+			r = mid
+		}
+		b.rows[i][0] = string(r)
+	}
+	return b
+}
+
+// baseRow returns the baseline row of b as a string.
+func (b *box) baseRow() string {
+	if len(b.rows) == 0 {
+		return ""
+	}
+	return strings.Join(b.rows[b.base], "")
+}
+
+// String returns the rows of b, without trailing spaces.
+func (b *box) String() string {
+	lines := make([]string, len(b.rows))
+	for i, row := range b.rows {
+		lines[i] = strings.TrimRight(strings.Join(row, ""), " ")
+	}
+	return strings.Join(lines, "\n")
+}
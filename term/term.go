@@ -0,0 +1,584 @@
+// Package term renders syntax trees as text laid out in two dimensions, for
+// terminals.  Fractions are stacked with a rule between numerator and
+// denominator, superscripts are raised and subscripts lowered, sums and
+// limits have their scripts above and below them, roots are drawn with
+// box-drawing characters, and brackets grow to the height of what they
+// enclose:
+//
+//	        n
+//	 a     ___   2
+//	─── +  ╲    i
+//	 b     ╱
+//	       ‾‾‾
+//	      i = 1
+//
+// Items are laid out in boxes lined up at their baseline, see box.go.  The
+// letters in the argument of a font symbol are replaced with Mathematical
+// Alphanumeric Symbols as in the linear package, and EquationArray nodes are
+// stacked with their alignment points lined up.
+package term
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Node returns the text for a syntax tree, as several lines separated by
+// "\n" without trailing spaces.
+func Node(node ast.Node) string {
+	return layout(node).String()
+}
+
+// Expr parses an ASCIIMath expression and returns its text.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+func layout(node ast.Node) *box {
+	switch n := node.(type) {
+	case *ast.Row:
+		return row(n.Items)
+	case *ast.Constant:
+		return symbol(n.Token.Symbol)
+	case *ast.Error:
+		return text(n.Token.Symbol.Output())
+	case *ast.Placeholder:
+		return text("□")
+	case *ast.Text:
+		return text(n.Value)
+	case *ast.Unary:
+		return unary(n)
+	case *ast.Binary:
+		return binary(n)
+	case *ast.Style:
+		return style(n)
+	case *ast.Frac:
+		return frac(layout(operand(n.Num)), layout(operand(n.Den)))
+	case *ast.FunctionApplication:
+		return row([]ast.Node{n.Func, n.Arg})
+	case *ast.Script:
+		return script(n)
+	case *ast.Fenced:
+		close := ""
+		if n.Close.IsValid() && !n.Close.Symbol.Invisible() {
+			close = n.Close.Symbol.Output()
+		}
+		open := ""
+		if !n.Open.Symbol.Invisible() {
+			open = n.Open.Symbol.Output()
+		}
+		return fenced(open, layout(n.Body), close)
+	case *ast.Matrix:
+		return matrix(n)
+	case *ast.EquationArray:
+		return equationArray(n)
+	}
+	panic(fmt.Sprintf("unexpected node type %T", node))
+}
+
+// symbol lays out a constant symbol.  Large operators are drawn on three
+// lines.
+func symbol(sym *scanner.Symbol) *box {
+	switch sym.Input() {
+	case "sum":
+		return vcat(1, text("___"), text("╲  "), text("╱  "), text("‾‾‾"))
+	case "prod":
+		return vcat(1, text("┬─┬"), text("│ │"), text("│ │"))
+	case "int":
+		return vcat(1, text("⌠"), text("⎮"), text("⌡"))
+	}
+	return text(sym.Output())
+}
+
+// row lays out items side by side, with spaces around operators and
+// relations and between items that would otherwise run together.
+func row(items []ast.Node) *box {
+	var boxes []*box
+	var prev ast.Node
+	var prevBox *box
+	space := false
+	for _, item := range items {
+		b := layout(item)
+		if b.width() == 0 {
+			continue
+		}
+		switch {
+		case isSpaced(item) && !isUnarySign(prev, item):
+			if len(boxes) > 0 {
+				boxes = append(boxes, text(" "))
+			}
+			space = true
+		case isComma(item):
+			space = true
+		default:
+			if space || prevBox != nil && (!joins(prevBox.baseRow(), b.baseRow()) || isOperator(prev) && !isFenced(item)) {
+				boxes = append(boxes, text(" "))
+			}
+			space = false
+		}
+		boxes = append(boxes, b)
+		prev, prevBox = item, b
+	}
+	return hcat(boxes...)
+}
+
+// spacedOutputs are the outputs of the operators, relations and arrows that
+// are written with spaces around them.
+var spacedOutputs = map[string]bool{
+	"+": true, "-": true, "=": true, "<": true, ">": true, "⋅": true,
+	"×": true, "÷": true, "±": true, "∘": true, "⊕": true, "⊗": true,
+	"⊙": true, "∧": true, "∨": true, "∩": true, "∪": true, "\\": true,
+	"≠": true, ":=": true, "≤": true, "≥": true, "≺": true, "≻": true,
+	"⪯": true, "⪰": true, "∈": true, "∉": true, "⊂": true, "⊃": true,
+	"⊆": true, "⊇": true, "≡": true, "≅": true, "≈": true, "∝": true,
+	"⇒": true, "⇔": true, "⊢": true, "⊨": true, "↑": true, "↓": true,
+	"→": true, "↣": true, "↠": true, "⤖": true, "↦": true, "←": true,
+	"↔": true, "⇐": true,
+}
+
+func isSpaced(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	if !ok {
+		return false
+	}
+	return c.Token.Type() == scanner.SPACE || c.Token.Type() == scanner.CONST && spacedOutputs[c.Token.Symbol.Output()]
+}
+
+func isComma(node ast.Node) bool {
+	c, ok := node.(*ast.Constant)
+	return ok && c.Token.Input() == ","
+}
+
+// isUnarySign returns true if op is a sign in front of an operand, as in -x
+// or a = -b.
+func isUnarySign(prev, op ast.Node) bool {
+	switch op.(*ast.Constant).Token.Input() {
+	case "-", "+", "+-":
+		return prev == nil || isSpaced(prev) || isComma(prev)
+	}
+	return false
+}
+
+// isOperator returns true if node is a function or a large operator, with or
+// without scripts, which is separated from its operand.
+func isOperator(node ast.Node) bool {
+	if script, ok := node.(*ast.Script); ok {
+		node = script.Base
+	}
+	c, ok := node.(*ast.Constant)
+	if !ok {
+		return false
+	}
+	sym := c.Token.Symbol
+	return sym.IsFunc() && len(sym.Output()) > 1 || sym.Type() == scanner.UNDEROVER || sym.Input() == "int" || sym.Input() == "oint"
+}
+
+func isFenced(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Fenced:
+		return !n.Invisible()
+	case *ast.Matrix:
+		return true
+	}
+	return false
+}
+
+// joins returns true if right can follow left without a space, e.g. in 2x,
+// xy or f(x) but not in sin x or 2 3.
+func joins(left, right string) bool {
+	left, right = strings.TrimRight(left, " "), strings.TrimLeft(right, " ")
+	last, _ := utf8.DecodeLastRuneInString(left)
+	first, _ := utf8.DecodeRuneInString(right)
+	switch {
+	case !isAlnum(last) || !isAlnum(first):
+		return true
+	case unicode.IsDigit(last) && unicode.IsLetter(first):
+		return true
+	}
+	return utf8.RuneCountInString(left) == 1 && utf8.RuneCountInString(right) == 1 && unicode.IsLetter(last) && unicode.IsLetter(first)
+}
+
+func isAlnum(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// operand returns the node inside the brackets of an argument, a script or
+// an operand of /, which are removed as in ASCIIMathML.
+func operand(node ast.Node) ast.Node {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		return fenced.Body
+	}
+	return node
+}
+
+// frac stacks num over den with a rule between them, on the baseline.
+func frac(num, den *box) *box {
+	width := num.width()
+	if den.width() > width {
+		width = den.width()
+	}
+	return vcat(1, num, line('─', width+2), den)
+}
+
+func unary(n *ast.Unary) *box {
+	sym := n.Op.Symbol
+	if _, ok := n.Arg.(*ast.Placeholder); ok && sym.IsFunc() {
+		return text(sym.Output())
+	}
+	arg := layout(operand(n.Arg))
+	switch lr := sym.RewriteLeftRight(); {
+	case sym.Input() == "sqrt":
+		return sqrt(arg)
+	case lr != [2]string{}:
+		return fenced(lr[0], arg, lr[1])
+	case sym.Input() == "cancel":
+		for _, row := range arg.rows {
+			for i := range row {
+				if row[i] != " " {
+					row[i] += "̶"
+				}
+			}
+		}
+		return arg
+	}
+	width := arg.width()
+	switch sym.Input() {
+	case "hat":
+		return vcat(1, text("^"), arg)
+	case "vec":
+		return vcat(1, text("→"), arg)
+	case "dot":
+		return vcat(1, text("."), arg)
+	case "ddot":
+		return vcat(1, text(".."), arg)
+	case "tilde":
+		return vcat(1, text("~"), arg)
+	case "bar":
+		return vcat(1, line('_', width), arg)
+	case "overarc":
+		return vcat(1, brace('╭', '─', '─', '╮', width), arg)
+	case "ul":
+		return vcat(0, arg, line('‾', width))
+	case "ubrace":
+		return vcat(0, arg, brace('└', '─', '┬', '┘', width))
+	case "obrace":
+		return vcat(1, brace('┌', '─', '┴', '┐', width), arg)
+	}
+	return hcat(text(sym.Input()), fenced("(", arg, ")"))
+}
+
+// brace returns a horizontal brace of the given width, drawn with its ends,
+// its middle and a tip at its center.
+func brace(left, middle, tip, right rune, width int) *box {
+	if width < 3 {
+		return line(middle, width)
+	}
+	b := line(middle, width)
+	b.rows[0][0] = string(left)
+	b.rows[0][width/2] = string(tip)
+	b.rows[0][width-1] = string(right)
+	return b
+}
+
+// sqrt draws a radical sign in front of arg and a line above it.
+func sqrt(arg *box) *box {
+	height := arg.height()
+	if height == 0 {
+		arg = text("□")
+		height = 1
+	}
+	b := newBox(arg.width()+1, height+1, arg.base+1)
+	b.put(1, 0, line('_', arg.width()))
+	b.put(0, 1, column(height, 0, '│', '│', '√', 0))
+	b.put(1, 1, arg)
+	return b
+}
+
+// root draws a root with its index above the start of the radical sign.
+func root(index, arg *box) *box {
+	r := sqrt(arg)
+	top := index.height() - 1
+	if top < 0 {
+		top = 0
+	}
+	b := newBox(index.width()+r.width(), top+r.height(), top+r.base)
+	b.put(0, 0, index)
+	b.put(index.width(), top, r)
+	return b
+}
+
+func binary(n *ast.Binary) *box {
+	a1, a2 := layout(operand(n.Arg1)), layout(operand(n.Arg2))
+	switch n.Op.Input() {
+	case "frac":
+		return frac(a1, a2)
+	case "root":
+		return root(a1, a2)
+	case "stackrel", "overset":
+		return vcat(1, a1, a2)
+	case "underset":
+		return vcat(0, a2, a1)
+	}
+	return hcat(text(n.Op.Input()), fenced("(", a1, ")"), fenced("(", a2, ")"))
+}
+
+// alphabets gives the first capital letter, small letter and digit of the
+// Mathematical Alphanumeric Symbols for the values of mathvariant.  Fonts
+// with holes in their alphabet use the codes of their symbol instead.
+var alphabets = map[string][3]rune{
+	"bold":          {0x1D400, 0x1D41A, 0x1D7CE},
+	"double-struck": {0, 0, 0x1D7D8},
+	"sans-serif":    {0x1D5A0, 0x1D5BA, 0x1D7E2},
+	"monospace":     {0x1D670, 0x1D68A, 0x1D7F6},
+}
+
+func style(n *ast.Style) *box {
+	b := layout(operand(n.Arg))
+	if ast.StyleAttr(n.Op) != "mathvariant" {
+		return b
+	}
+	codes := n.Op.Symbol.Codes()
+	alphabet := alphabets[n.Value()]
+	for _, row := range b.rows {
+		for i, cell := range row {
+			r, size := utf8.DecodeRuneInString(cell)
+			switch {
+			case r >= 'A' && r <= 'Z' && codes != nil:
+				row[i] = codes[r-'A'] + cell[size:]
+			case r >= 'a' && r <= 'z' && codes != nil:
+				row[i] = codes[r-'a'+26] + cell[size:]
+			case r >= 'A' && r <= 'Z' && alphabet[0] != 0:
+				row[i] = string(alphabet[0]+r-'A') + cell[size:]
+			case r >= 'a' && r <= 'z' && alphabet[1] != 0:
+				row[i] = string(alphabet[1]+r-'a') + cell[size:]
+			case r >= '0' && r <= '9' && alphabet[2] != 0:
+				row[i] = string(alphabet[2]+r-'0') + cell[size:]
+			}
+		}
+	}
+	return b
+}
+
+func script(n *ast.Script) *box {
+	base := layout(n.Base)
+	var sub, sup *box
+	if n.Sub != nil {
+		sub = layout(operand(n.Sub))
+	}
+	if n.Sup != nil {
+		sup = layout(operand(n.Sup))
+	}
+	if n.UnderOver(true) {
+		switch {
+		case sub != nil && sup != nil:
+			return vcat(1, sup, base, sub)
+		case sub != nil:
+			return vcat(0, base, sub)
+		}
+		return vcat(1, sup, base)
+	}
+	width := base.width()
+	scripts := 0
+	if sub != nil {
+		scripts = sub.width()
+	}
+	if sup != nil && sup.width() > scripts {
+		scripts = sup.width()
+	}
+	top := 0
+	if sup != nil {
+		top = sup.height()
+	}
+	height := top + base.height()
+	if sub != nil {
+		height += sub.height()
+	}
+	b := newBox(width+scripts, height, top+base.base)
+	if sup != nil {
+		b.put(width, 0, sup)
+	}
+	b.put(0, top, base)
+	if sub != nil {
+		b.put(width, top+base.height(), sub)
+	}
+	return b
+}
+
+// fenced puts body between brackets as tall as it.  An empty bracket is
+// omitted.
+func fenced(open string, body *box, close string) *box {
+	var boxes []*box
+	if open != "" {
+		boxes = append(boxes, bracket(open, body))
+	}
+	boxes = append(boxes, body)
+	if close != "" {
+		boxes = append(boxes, bracket(close, body))
+	}
+	return hcat(boxes...)
+}
+
+// tallBrackets gives the top, middle and bottom characters of brackets
+// taller than a line, and the character at their baseline if they have one.
+var tallBrackets = map[string][4]rune{
+	"(": {'⎛', '⎜', '⎝'},
+	")": {'⎞', '⎟', '⎠'},
+	"[": {'⎡', '⎢', '⎣'},
+	"]": {'⎤', '⎥', '⎦'},
+	"{": {'⎧', '⎪', '⎩', '⎨'},
+	"}": {'⎫', '⎪', '⎭', '⎬'},
+	"|": {'│', '│', '│'},
+	"∥": {'║', '║', '║'},
+	"⌊": {'⎢', '⎢', '⎣'},
+	"⌋": {'⎥', '⎥', '⎦'},
+	"⌈": {'⎡', '⎢', '⎢'},
+	"⌉": {'⎤', '⎥', '⎥'},
+}
+
+// bracket returns a bracket as tall as body.
+func bracket(s string, body *box) *box {
+	height := body.height()
+	chars, ok := tallBrackets[s]
+	if height <= 1 {
+		return text(s)
+	}
+	if !ok {
+		b := newBox(utf8.RuneCountInString(s), height, body.base)
+		b.put(0, body.base, text(s))
+		return b
+	}
+	return column(height, body.base, chars[0], chars[1], chars[2], chars[3])
+}
+
+// matrix lays out the cells of a matrix in a grid between tall brackets.  As
+// in ASCIIMathML, a cell made of a single "|" draws a line between the
+// columns around it.
+func matrix(n *ast.Matrix) *box {
+	var lines []bool
+	var cells [][]*box
+	for i, r := range n.Rows {
+		var boxes []*box
+		for j, cell := range r.Cells {
+			if j > 0 && j < len(r.Cells)-1 && isColumnLine(cell) {
+				if i == 0 {
+					lines[len(lines)-1] = true
+				}
+				continue
+			}
+			boxes = append(boxes, layout(cell))
+			if i == 0 {
+				lines = append(lines, false)
+			}
+		}
+		cells = append(cells, boxes)
+	}
+	widths := make([]int, len(lines))
+	for _, r := range cells {
+		for j, c := range r {
+			if j < len(widths) && c.width() > widths[j] {
+				widths[j] = c.width()
+			}
+		}
+	}
+	left := n.Close.Symbol.Invisible()
+	var rows []*box
+	for _, r := range cells {
+		var boxes []*box
+		for j, width := range widths {
+			if j > 0 {
+				if lines[j-1] {
+					boxes = append(boxes, space(3))
+				} else {
+					boxes = append(boxes, space(2))
+				}
+			}
+			c := space(width)
+			if j < len(r) {
+				c = r[j]
+			}
+			pad := (width - c.width()) / 2
+			if left {
+				pad = 0
+			}
+			boxes = append(boxes, space(pad), c, space(width-c.width()-pad))
+		}
+		rows = append(rows, hcat(boxes...))
+	}
+	table := vcat(0, rows...)
+	table.base = (table.height() - 1) / 2
+	// Column lines run through the whole table.
+	for j, x := 0, 0; j < len(widths)-1; j++ {
+		x += widths[j]
+		if !lines[j] {
+			x += 2
+			continue
+		}
+		for _, row := range table.rows {
+			row[x+1] = "│"
+		}
+		x += 3
+	}
+	close := ""
+	if !n.Close.Symbol.Invisible() {
+		close = n.Close.Symbol.Output()
+	}
+	return fenced(n.Open.Symbol.Output(), table, close)
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
+
+// equationArray stacks the lines of n, with their left hand sides aligned to
+// the right so that the alignment points line up.
+func equationArray(n *ast.EquationArray) *box {
+	var lefts, rights []*box
+	width := 0
+	for _, line := range n.Lines {
+		left := row(line.Left.Items)
+		if left.width() > width {
+			width = left.width()
+		}
+		lefts = append(lefts, left)
+		rights = append(rights, row(line.Right.Items))
+	}
+	var lines []*box
+	for i, left := range lefts {
+		boxes := []*box{space(width - left.width()), left}
+		if rights[i].width() > 0 {
+			boxes = append(boxes, text(" "), rights[i])
+		}
+		lines = append(lines, hcat(boxes...))
+	}
+	b := newBox(0, 0, 0)
+	for _, line := range lines {
+		b = vstack(b, line)
+	}
+	return b
+}
+
+// vstack puts bottom under top, aligned to the left.
+func vstack(top, bottom *box) *box {
+	width := top.width()
+	if bottom.width() > width {
+		width = bottom.width()
+	}
+	b := newBox(width, top.height()+bottom.height(), top.base)
+	b.put(0, 0, top)
+	b.put(0, top.height(), bottom)
+	return b
+}
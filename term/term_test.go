@@ -0,0 +1,186 @@
+package term
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "single line",
+			input: "2 x y + 2 3 <= sin x",
+			want:  []string{"2xy + 2 3 ≤ sin x"},
+		},
+		{
+			name:  "scripts",
+			input: "x_1^2 + e^(x^2)",
+			want: []string{
+				"       2",
+				" 2    x",
+				"x  + e",
+				" 1",
+			},
+		},
+		{
+			name:  "fraction",
+			input: "(a+b)/c = 1",
+			want: []string{
+				" a + b",
+				"─────── = 1",
+				"   c",
+			},
+		},
+		{
+			name:  "sum",
+			input: "sum_(i=1)^n i^2",
+			want: []string{
+				"  n",
+				" ___   2",
+				" ╲    i",
+				" ╱",
+				" ‾‾‾",
+				"i = 1",
+			},
+		},
+		{
+			name:  "limit",
+			input: "lim_(x->0) f(x)",
+			want: []string{
+				" lim  f(x)",
+				"x → 0",
+			},
+		},
+		{
+			name:  "roots",
+			input: "sqrt(x+1) + root(3)(y)",
+			want: []string{
+				" _____   3 _",
+				"√x + 1 +  √y",
+			},
+		},
+		{
+			name:  "tall root",
+			input: "sqrt(a/b)",
+			want: []string{
+				" ___",
+				"│ a",
+				"│───",
+				"√ b",
+			},
+		},
+		{
+			name:  "tall brackets",
+			input: "(a/b)^2 + abs(1/x)",
+			want: []string{
+				"     2",
+				"⎛ a ⎞    │ 1 │",
+				"⎜───⎟  + │───│",
+				"⎝ b ⎠    │ x │",
+			},
+		},
+		{
+			name:  "matrix",
+			input: "[(a,b),(c,d)]",
+			want: []string{
+				"⎡a  b⎤",
+				"⎣c  d⎦",
+			},
+		},
+		{
+			name:  "column lines",
+			input: "((1,|,2),(3,|,4))",
+			want: []string{
+				"⎛1 │ 2⎞",
+				"⎝3 │ 4⎠",
+			},
+		},
+		{
+			name:  "cases",
+			input: "{(1, x > 0),(10, x <= 0):}",
+			want: []string{
+				"⎧1   x > 0",
+				"⎩10  x ≤ 0",
+			},
+		},
+		{
+			name:  "accents",
+			input: "hat x + bar(AB) + vec v",
+			want: []string{
+				"^   __   →",
+				"x + AB + v",
+			},
+		},
+		{
+			name:  "underbrace",
+			input: "ubrace(1+2+3)_3",
+			want: []string{
+				"1 + 2 + 3",
+				"└───┬───┘",
+				"    3",
+			},
+		},
+		{
+			name:  "fonts",
+			input: "bbb R + bb(x^2)",
+			want: []string{
+				"     𝟐",
+				"ℝ + 𝐱",
+			},
+		},
+		{
+			name:  "missing argument",
+			input: "frac(a)",
+			want: []string{
+				" a",
+				"───",
+				" □",
+			},
+		},
+		{
+			name:  "accent over an empty group",
+			input: "a tilde {::}",
+			want:  []string{"a~"},
+		},
+		{
+			name:  "accent over an unclosed empty group",
+			input: "ZZ bar overset dy {:",
+			want: []string{
+				"  __",
+				"ℤ dy",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, _ := parser.Parse(tt.input)
+			want := strings.Join(tt.want, "\n")
+			if got := Node(node); got != want {
+				t.Errorf("Node() =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("(a+b)^2 = a^2+2ab+b^2\nx & <= 1/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"       2    2          2",
+		"(a + b)  = a  + 2ab + b",
+		"            1",
+		"       x ≤ ───",
+		"            2",
+	}, "\n")
+	if got := Node(block); got != want {
+		t.Errorf("Node() =\n%s\nwant\n%s", got, want)
+	}
+}
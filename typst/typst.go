@@ -0,0 +1,510 @@
+// Package typst writes syntax trees as Typst math, the content of $...$ in a
+// Typst document.
+//
+// Symbols are written with their Typst names, e.g. arrow.r for ->, lt.eq for
+// <= and bb(R) for RR, fractions with frac(a, b) or a/b as in the input,
+// roots with sqrt and root(n, x), and scripts with _ and ^.  Big operators
+// such as sum and lim take their limits below and above them as in
+// ASCIIMathML, with limits() for other bases.  Matrices become mat() with
+// their delimiter and the columns lines of ASCIIMathML as augment lines, and
+// cases cases().  Brackets are written as they are, since Typst matches and
+// scales them itself, except abs, norm, floor and ceil which are functions.
+//
+// Style nodes become bold, bb, cal, frak, sans and mono, and colors a text
+// function with a fill.  Ids and classes are ignored.  EquationArray nodes
+// become lines separated by \ and aligned with &.
+package typst
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/arnodel/asciimath/ast"
+	"github.com/arnodel/asciimath/parser"
+	"github.com/arnodel/asciimath/scanner"
+)
+
+// Expr parses an ASCIIMath expression and returns its Typst math.
+func Expr(input string) (string, error) {
+	node, err := parser.Parse(input)
+	if err != nil {
+		return "", err
+	}
+	return Node(node), nil
+}
+
+// Node returns the Typst math for a syntax tree.
+func Node(node ast.Node) string {
+	return render(node, false)
+}
+
+// A printer writes Typst math for syntax trees, separating the words and
+// numbers that would otherwise run together, and the words that follow a
+// function call.
+type printer struct {
+	strings.Builder
+	// args is true in the argument of a function, where the commas and
+	// semicolons outside brackets must be escaped.
+	args bool
+}
+
+func render(node ast.Node, args bool) string {
+	p := printer{args: args}
+	p.node(node)
+	return p.String()
+}
+
+func (p *printer) write(s string) {
+	if s == "" {
+		return
+	}
+	last, _ := utf8.DecodeLastRuneInString(p.String())
+	first, _ := utf8.DecodeRuneInString(s)
+	if (isWordChar(last) || last == ')') && isWordChar(first) {
+		p.WriteByte(' ')
+	}
+	p.WriteString(s)
+}
+
+func isWordChar(r rune) bool {
+	return r == '.' || r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func (p *printer) node(node ast.Node) {
+	switch n := node.(type) {
+	case *ast.Row:
+		for _, item := range n.Items {
+			p.node(item)
+		}
+	case *ast.Constant:
+		p.symbol(n.Token.Symbol)
+	case *ast.Error:
+		p.symbol(n.Token.Symbol)
+	case *ast.Placeholder:
+	case *ast.Text:
+		p.write(quote(n.Value))
+	case *ast.Unary:
+		p.unary(n)
+	case *ast.Binary:
+		p.binary(n)
+	case *ast.Style:
+		p.style(n)
+	case *ast.Frac:
+		p.write(operand(n.Num) + "/" + operand(n.Den))
+	case *ast.FunctionApplication:
+		p.node(n.Func)
+		p.node(n.Arg)
+	case *ast.Script:
+		p.script(n)
+	case *ast.Fenced:
+		p.fenced(n)
+	case *ast.Matrix:
+		p.matrix(n)
+	case *ast.EquationArray:
+		lines := make([]string, len(n.Lines))
+		for i, line := range n.Lines {
+			lines[i] = strings.TrimLeft(render(line.Left, false)+" &"+render(line.Right, false), " ")
+		}
+		p.write(strings.Join(lines, ` \ `))
+	default:
+		panic(fmt.Sprintf("unexpected node type %T", node))
+	}
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func quote(s string) string {
+	return `"` + textEscaper.Replace(s) + `"`
+}
+
+// arg returns the argument of a function, without the brackets around it as
+// in ASCIIMathML.
+func arg(node ast.Node) string {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		node = fenced.Body
+	}
+	return render(node, true)
+}
+
+// operand returns a script or an operand of /, without the brackets around
+// it as in ASCIIMathML, and in round brackets if it is not a single item.
+// Typst does not show these round brackets.
+func operand(node ast.Node) string {
+	if fenced, ok := node.(*ast.Fenced); ok && fenced.Removable() {
+		node = fenced.Body
+	}
+	s := render(node, false)
+	if isAtom(node) {
+		return s
+	}
+	return "(" + s + ")"
+}
+
+// isAtom returns true if node is written as a single item, a symbol, a text
+// or a function call.
+func isAtom(node ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.Row:
+		return len(n.Items) == 1 && isAtom(n.Items[0])
+	case *ast.Constant:
+		return !strings.ContainsAny(render(n, false), " ")
+	case *ast.Text, *ast.Binary, *ast.Style, *ast.Matrix:
+		return true
+	case *ast.Unary:
+		_, ok := unaryFuncs[n.Op.Input()]
+		return ok || n.Op.Symbol.RewriteLeftRight() != [2]string{}
+	}
+	return false
+}
+
+// unaryFuncs maps unary symbols to the Typst functions for them.
+var unaryFuncs = map[string]string{
+	"sqrt": "sqrt", "hat": "hat", "tilde": "tilde", "vec": "arrow",
+	"dot": "dot", "ddot": "dot.double", "bar": "overline",
+	"ul": "underline", "ubrace": "underbrace", "obrace": "overbrace",
+	"cancel": "cancel", "abs": "abs", "Abs": "abs", "norm": "norm",
+	"floor": "floor", "ceil": "ceil",
+}
+
+func (p *printer) unary(n *ast.Unary) {
+	in := n.Op.Input()
+	switch {
+	case in == "overarc":
+		p.write("accent(" + arg(n.Arg) + ", paren.t)")
+	case unaryFuncs[in] != "":
+		p.write(unaryFuncs[in] + "(" + arg(n.Arg) + ")")
+	default:
+		p.symbol(n.Op.Symbol)
+		p.node(n.Arg)
+	}
+}
+
+func (p *printer) binary(n *ast.Binary) {
+	switch n.Op.Input() {
+	case "frac":
+		p.write("frac(" + arg(n.Arg1) + ", " + arg(n.Arg2) + ")")
+	case "root":
+		p.write("root(" + arg(n.Arg1) + ", " + arg(n.Arg2) + ")")
+	case "stackrel", "overset":
+		p.write("limits(" + arg(n.Arg2) + ")^" + operand(n.Arg1))
+	case "underset":
+		p.write("limits(" + arg(n.Arg2) + ")_" + operand(n.Arg1))
+	default:
+		p.symbol(n.Op.Symbol)
+		p.node(n.Arg1)
+		p.node(n.Arg2)
+	}
+}
+
+// fontFuncs maps the values of the mathvariant attribute to the Typst
+// functions for the same fonts.
+var fontFuncs = map[string]string{
+	"bold":          "bold",
+	"double-struck": "bb",
+	"script":        "cal",
+	"fraktur":       "frak",
+	"sans-serif":    "sans",
+	"monospace":     "mono",
+}
+
+// colors are the predefined colors of Typst.
+var colors = map[string]bool{
+	"black": true, "gray": true, "silver": true, "white": true,
+	"navy": true, "blue": true, "aqua": true, "teal": true,
+	"eastern": true, "purple": true, "fuchsia": true, "maroon": true,
+	"red": true, "orange": true, "yellow": true, "olive": true,
+	"green": true, "lime": true,
+}
+
+func (p *printer) style(n *ast.Style) {
+	switch ast.StyleAttr(n.Op) {
+	case "mathvariant":
+		p.write(fontFuncs[n.Value()] + "(" + arg(n.Arg) + ")")
+	case "mathcolor":
+		fill := strings.ToLower(n.Value())
+		if !colors[fill] {
+			fill = "rgb(" + strconv.Quote(n.Value()) + ")"
+		}
+		p.write("#text(fill: " + fill + ")[$" + arg(n.Arg) + "$]")
+	default:
+		if fenced, ok := n.Arg.(*ast.Fenced); ok && fenced.Removable() {
+			p.node(fenced.Body)
+		} else {
+			p.node(n.Arg)
+		}
+	}
+}
+
+// limitOps are the inputs of the symbols that Typst writes with their limits
+// below and above them in display style.
+var limitOps = map[string]bool{
+	"sum": true, "prod": true, "^^^": true, "vvv": true, "nnn": true,
+	"uuu": true, "lim": true, "min": true, "max": true, "det": true,
+	"gcd": true,
+}
+
+func (p *printer) script(n *ast.Script) {
+	if u, ok := n.Base.(*ast.Unary); ok && n.Limits != ast.NoLimits {
+		// The limits of ubrace and obrace are the annotations of
+		// underbrace and overbrace.
+		switch {
+		case u.Op.Input() == "ubrace" && n.Sub != nil && n.Sup == nil:
+			p.write("underbrace(" + arg(u.Arg) + ", " + arg(n.Sub) + ")")
+			return
+		case u.Op.Input() == "obrace" && n.Sup != nil && n.Sub == nil:
+			p.write("overbrace(" + arg(u.Arg) + ", " + arg(n.Sup) + ")")
+			return
+		}
+	}
+	base := render(n.Base, false)
+	c, isConst := n.Base.(*ast.Constant)
+	switch {
+	case isConst && (limitOps[c.Token.Input()] || c.Token.Input() == "Lim"):
+	case n.Limits != ast.NoLimits:
+		base = "limits(" + base + ")"
+	case !isAtom(n.Base):
+		if _, ok := n.Base.(*ast.Fenced); !ok || n.Base.(*ast.Fenced).Invisible() {
+			// Typst shows the brackets around a base, so the scripts
+			// of other bases are attached explicitly.
+			var attach []string
+			if n.Sub != nil {
+				attach = append(attach, "b: "+arg(n.Sub))
+			}
+			if n.Sup != nil {
+				attach = append(attach, "t: "+arg(n.Sup))
+			}
+			p.write("attach(" + render(n.Base, true) + ", " + strings.Join(attach, ", ") + ")")
+			return
+		}
+	}
+	p.write(base)
+	if n.Sub != nil {
+		p.WriteString("_" + operand(n.Sub))
+	}
+	if n.Sup != nil {
+		p.WriteString("^" + operand(n.Sup))
+	}
+}
+
+// brackets maps the outputs of brackets which Typst does not match and
+// scale itself to their names.
+var brackets = map[string]string{
+	"\u2329": "angle.l", "\u232A": "angle.r",
+	"⌊": "floor.l", "⌋": "floor.r", "⌈": "ceil.l", "⌉": "ceil.r",
+}
+
+func (p *printer) fenced(n *ast.Fenced) {
+	if n.Open.Type() == scanner.LEFTRIGHT && n.Close.Input() == n.Open.Input() {
+		p.write("abs(" + render(n.Body, true) + ")")
+		return
+	}
+	args := p.args
+	p.args = false
+	defer func() { p.args = args }()
+	if n.Invisible() {
+		p.node(n.Body)
+		return
+	}
+	open, close := bracket(n.Open), ""
+	scaled := brackets[n.Open.Symbol.Output()] != ""
+	if n.Close.IsValid() {
+		close = bracket(n.Close)
+		scaled = scaled || brackets[n.Close.Symbol.Output()] != ""
+	}
+	if scaled {
+		if close == "" {
+			close = "."
+		}
+		p.write("lr(" + open + " " + render(n.Body, true) + " " + close + ")")
+		return
+	}
+	p.write(open)
+	p.node(n.Body)
+	p.write(close)
+}
+
+// bracket returns the Typst for a bracket, or "" for the invisible brackets
+// {: and :}.
+func bracket(tok scanner.Token) string {
+	sym := tok.Symbol
+	switch {
+	case sym.Invisible():
+		return ""
+	case brackets[sym.Output()] != "":
+		return brackets[sym.Output()]
+	}
+	return sym.Output()
+}
+
+// delims maps the outputs of the brackets of matrices to the delimiters of
+// mat.
+var delims = map[string]string{
+	"(": "", "[": `"["`, "{": `"{"`, "|": `"|"`, "\u2329": `"⟨"`,
+}
+
+func (p *printer) matrix(n *ast.Matrix) {
+	var columnLines []string
+	col := 0
+	for j, cell := range n.Rows[0].Cells {
+		if j > 0 && j < len(n.Rows[0].Cells)-1 && isColumnLine(cell) {
+			columnLines = append(columnLines, strconv.Itoa(col))
+		} else {
+			col++
+		}
+	}
+	cases := n.Open.Input() == "{" && n.Close.IsValid() && n.Close.Symbol.Invisible()
+	var rows []string
+	for _, row := range n.Rows {
+		var cells []string
+		for j, cell := range row.Cells {
+			if j > 0 && j < len(row.Cells)-1 && isColumnLine(cell) {
+				continue
+			}
+			cells = append(cells, render(cell, true))
+		}
+		if cases {
+			rows = append(rows, strings.Join(cells, " & "))
+		} else {
+			rows = append(rows, strings.Join(cells, ", "))
+		}
+	}
+	if cases {
+		p.write("cases(" + strings.Join(rows, ", ") + ")")
+		return
+	}
+	var params []string
+	open, close := n.Open.Symbol.Output(), ""
+	if n.Close.IsValid() {
+		close = bracket(n.Close)
+	}
+	delim, ok := delims[open]
+	if !ok || close != closing[open] {
+		// Other brackets are written around a matrix without them.
+		delim = "#none"
+	}
+	if delim != "" {
+		params = append(params, "delim: "+delim)
+	}
+	switch len(columnLines) {
+	case 0:
+	case 1:
+		params = append(params, "augment: #"+columnLines[0])
+	default:
+		params = append(params, "augment: #(vline: ("+strings.Join(columnLines, ", ")+"))")
+	}
+	if len(params) > 0 {
+		rows = append(rows, strings.Join(params, ", "))
+	}
+	mat := "mat(" + strings.Join(rows, "; ") + ")"
+	if delim == "#none" {
+		mat = "lr(" + bracket(n.Open) + " " + mat + " " + close + ")"
+	}
+	p.write(mat)
+}
+
+// closing maps the brackets of matrices to the brackets that close them.
+var closing = map[string]string{
+	"(": ")", "[": "]", "{": "}", "|": "|", "\u2329": "angle.r",
+}
+
+func isColumnLine(cell *ast.Row) bool {
+	if len(cell.Items) != 1 {
+		return false
+	}
+	c, ok := cell.Items[0].(*ast.Constant)
+	return ok && c.Token.Type() == scanner.LEFTRIGHT
+}
+
+// names maps the inputs of symbols to their Typst, when it is not the name
+// for their output.
+var names = map[string]string{
+	"lamda": "lambda", "Lamda": "Lambda", "epsi": "epsilon",
+	"varepsilon": "epsilon.alt", "phi": "phi.alt", "varphi": "phi",
+	"vartheta": "theta.alt",
+	"CC":       "bb(C)", "NN": "bb(N)", "QQ": "bb(Q)", "RR": "bb(R)", "ZZ": "bb(Z)",
+	"and": `" and "`, "or": `" or "`, "if": `" if "`,
+	"Lim": `op("Lim", limits: #true)`, "lub": `op("lub")`, "glb": `op("glb")`,
+	"lcm": `op("lcm")`, "divide": "div", "//": `\/`, `\ `: "space",
+	"quad": "quad", "qquad": "wide", ":=": ":=", "...": "dots.h",
+}
+
+// outputNames maps the outputs of symbols to their Typst names.
+var outputNames = map[string]string{
+	"⋅": "dot.op", "∗": "ast.op", "⋆": "star.op", `\`: "backslash",
+	"×": "times", "⋉": "times.l", "⋊": "times.r", "⋈": "join", "÷": "div",
+	"∘": "compose", "⊕": "plus.circle", "⊗": "times.circle",
+	"⊙": "dot.circle", "∑": "sum", "∏": "product", "∧": "and",
+	"⋀": "and.big", "∨": "or", "⋁": "or.big", "∩": "sect", "⋂": "sect.big",
+	"∪": "union", "⋃": "union.big", "≠": "eq.not", "≤": "lt.eq",
+	"≥": "gt.eq", "≺": "prec", "≻": "succ", "⪯": "prec.eq", "⪰": "succ.eq",
+	"∈": "in", "∉": "in.not", "⊂": "subset", "⊃": "supset",
+	"⊆": "subset.eq", "⊇": "supset.eq", "≡": "equiv", "≅": "tilde.equiv",
+	"≈": "approx", "∝": "prop", "¬": "not", "⇒": "arrow.r.double",
+	"⇔": "arrow.l.r.double", "∀": "forall", "∃": "exists", "⊥": "bot",
+	"⊤": "top", "⊢": "tack.r", "⊨": "tack.r.double", "\u2329": "angle.l",
+	"\u232A": "angle.r", "∫": "integral", "∮": "integral.cont", "∂": "diff",
+	"∇": "nabla", "±": "plus.minus", "∅": "emptyset", "∞": "infinity",
+	"ℵ": "aleph", "∴": "therefore", "∵": "because", "∠": "angle",
+	"△": "triangle.stroked.t", "′": "prime", "⌢": "frown", "⋯": "dots.c",
+	"⋮": "dots.v", "⋱": "dots.down", "⋄": "diamond.stroked.small",
+	"□": "square.stroked", "⌊": "floor.l", "⌋": "floor.r",
+	"⌈": "ceil.l", "⌉": "ceil.r", "↑": "arrow.t", "↓": "arrow.b",
+	"→": "arrow.r", "↣": "arrow.r.tail", "↠": "arrow.r.twohead",
+	"⤖": "arrow.r.twohead.tail", "↦": "arrow.r.bar", "←": "arrow.l",
+	"↔": "arrow.l.r", "⇐": "arrow.l.double",
+}
+
+// ops are the operators of Typst with the same name as in ASCIIMath.
+var ops = map[string]bool{
+	"arccos": true, "arcsin": true, "arctan": true, "cos": true,
+	"cosh": true, "cot": true, "coth": true, "csc": true, "csch": true,
+	"det": true, "dim": true, "exp": true, "gcd": true, "lim": true,
+	"ln": true, "log": true, "max": true, "min": true, "mod": true,
+	"sec": true, "sech": true, "sin": true, "sinh": true, "tan": true,
+	"tanh": true,
+}
+
+var mathEscaper = strings.NewReplacer(
+	`\`, `\\`, "$", `\$`, "#", `\#`, `"`, `\"`, "_", `\_`, "^", `\^`,
+	"&", `\&`, "/", `\/`, "*", `\*`, "'", `\'`, "@", `\@`, "~", `\~`,
+)
+
+// symbol writes a symbol with its Typst name.
+func (p *printer) symbol(sym *scanner.Symbol) {
+	in, out := sym.Input(), sym.Output()
+	switch {
+	case names[in] != "":
+		p.write(names[in])
+	case sym.Type() == scanner.LEFTRIGHT:
+		// A "|" that does not start an absolute value is a divides sign.
+		p.write("divides")
+	case outputNames[out] != "":
+		p.write(outputNames[out])
+	case ops[in]:
+		p.write(in)
+	case len(in) > 1 && isWord(in) && utf8.RuneCountInString(out) == 1:
+		// Greek letters have the same names.
+		p.write(in)
+	case len(out) > 1 && isWord(out):
+		p.write(`op("` + out + `")`)
+	case p.args && (out == "," || out == ";"):
+		p.write(`\` + out)
+	case sym.Type() == scanner.RIGHTBRACKET || sym.Type() == scanner.LEFTBRACKET:
+		// Unmatched brackets are escaped.
+		p.write(`\` + out)
+	default:
+		p.write(mathEscaper.Replace(out))
+	}
+}
+
+func isWord(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
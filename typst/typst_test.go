@@ -0,0 +1,130 @@
+package typst
+
+import (
+	"testing"
+
+	"github.com/arnodel/asciimath/parser"
+)
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "symbol names",
+			input: "a <= b -> c xx d",
+			want:  `a lt.eq b arrow.r c times d`,
+		},
+		{
+			name:  "letters",
+			input: "2x + ab + alpha lamda + varphi",
+			want:  `2 x+a b+alpha lambda+phi`,
+		},
+		{
+			name:  "number sets",
+			input: "x in RR uu bbb Z",
+			want:  `x in bb(R) union bb(Z)`,
+		},
+		{
+			name:  "fractions",
+			input: "frac(a)(b) + (a+b)/c",
+			want:  `frac(a, b)+(a+b)/c`,
+		},
+		{
+			name:  "roots",
+			input: "sqrt(x+1) + root(3)(x)",
+			want:  `sqrt(x+1)+root(3, x)`,
+		},
+		{
+			name:  "scripts",
+			input: "x_i^2 + e^(i pi) + (a+b)^2 + {:a+b:}^2",
+			want:  `x_i^2+e^(i pi)+(a+b)^2+attach(a+b, t: 2)`,
+		},
+		{
+			name:  "limits",
+			input: "sum_(i=1)^n i + lim_(x->0) x + Lim_n x",
+			want:  `sum_(i=1)^n i+lim_(x arrow.r 0) x+op("Lim", limits: #true)_n x`,
+		},
+		{
+			name:  "integral",
+			input: "int_0^1 f(x) dx",
+			want:  `integral_0^1 f(x) d x`,
+		},
+		{
+			name:  "brackets",
+			input: "(a, b] + abs(x) + |y| + (: u, v :) + {: z :}",
+			want:  `(a,b]+abs(x)+abs(y)+lr(angle.l u\,v angle.r)+z`,
+		},
+		{
+			name:  "escaped arguments",
+			input: "frac(a, b)(c) + a//b",
+			want:  `frac(a\,b, c)+a\/b`,
+		},
+		{
+			name:  "matrices",
+			input: "[[1, 2], [3, 4]] + ((a, |, b), (c, |, d))",
+			want:  `mat(1, 2; 3, 4; delim: "[")+mat(a, b; c, d; augment: #1)`,
+		},
+		{
+			name:  "cases",
+			input: "{(1, x >= 0), (-1, x < 0):}",
+			want:  `cases(1 & x gt.eq 0, -1 & x<0)`,
+		},
+		{
+			name:  "accents",
+			input: "hat x + vec v + bar(x+y) + ubrace(1+2)_3",
+			want:  `hat(x)+arrow(v)+overline(x+y)+underbrace(1+2, 3)`,
+		},
+		{
+			name:  "styles",
+			input: `bb x + cc A + color(red)(y) + color(#ff0)(z) + id(a)(w)`,
+			want:  `bold(x)+cal(A)+#text(fill: red)[$y$]+#text(fill: rgb("#ff0"))[$z$]+w`,
+		},
+		{
+			name:  "text",
+			input: `"if " x = text(say "a")`,
+			want:  `"if "x="say \"a\""`,
+		},
+		{
+			name:  "unclosed brackets",
+			input: "(",
+			want:  `(`,
+		},
+		{
+			name:  "unclosed bracket after a function",
+			input: "a(x",
+			want:  `a(x`,
+		},
+		{
+			name:  "unclosed square bracket",
+			input: "[",
+			want:  `[`,
+		},
+		{
+			name:  "unclosed angle bracket",
+			input: "(: a",
+			want:  `lr(angle.l a .)`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node, _ := parser.Parse(test.input)
+			if got := Node(node); got != test.want {
+				t.Errorf("Node() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNode_equationArray(t *testing.T) {
+	block, err := parser.ParseBlock("(a+b)^2 = a^2+2ab+b^2\n= c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `(a+b)^2 &=a^2+2 a b+b^2 \ &=c`
+	if got := Node(block); got != want {
+		t.Errorf("Node() = %s, want %s", got, want)
+	}
+}